@@ -2,6 +2,7 @@ package activitypub
 
 import (
 	"math"
+	"time"
 
 	"github.com/owncast/owncast/activitypub/crypto"
 	"github.com/owncast/owncast/activitypub/inbox"
@@ -47,8 +48,14 @@ func getOutboundWorkerPoolSize() int {
 }
 
 // SendLive will send a "Go Live" message to followers.
-func SendLive() error {
-	return outbox.SendLive()
+func SendLive(message string) error {
+	return outbox.SendLive(message)
+}
+
+// SendScheduledStream will send an Event for an upcoming stream to
+// followers.
+func SendScheduledStream(stream models.ScheduledStream) error {
+	return outbox.SendScheduledStream(stream)
 }
 
 // SendPublicFederatedMessage will send an arbitrary provided message to followers.
@@ -66,6 +73,12 @@ func GetFollowerCount() (int64, error) {
 	return persistence.GetFollowerCount()
 }
 
+// GetNewFollowerCount will return the number of followers gained between
+// two times.
+func GetNewFollowerCount(since time.Time, until time.Time) (int64, error) {
+	return persistence.GetFollowerCountApprovedBetween(since, until)
+}
+
 // GetPendingFollowRequests will return the pending follow requests.
 func GetPendingFollowRequests() ([]models.Follower, error) {
 	return persistence.GetPendingFollowRequests()
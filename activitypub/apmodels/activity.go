@@ -158,3 +158,50 @@ func MakeNote(text string, noteIRI *url.URL, attributedToIRI *url.URL) vocab.Act
 
 	return note
 }
+
+// MakeEvent will return a new Event object for something happening at the
+// provided time.
+func MakeEvent(name string, text string, startTime time.Time, eventIRI *url.URL, attributedToIRI *url.URL) vocab.ActivityStreamsEvent {
+	event := streams.NewActivityStreamsEvent()
+
+	nameProperty := streams.NewActivityStreamsNameProperty()
+	nameProperty.AppendXMLSchemaString(name)
+	event.SetActivityStreamsName(nameProperty)
+
+	content := streams.NewActivityStreamsContentProperty()
+	content.AppendXMLSchemaString(text)
+	event.SetActivityStreamsContent(content)
+
+	start := streams.NewActivityStreamsStartTimeProperty()
+	start.Set(startTime)
+	event.SetActivityStreamsStartTime(start)
+
+	id := streams.NewJSONLDIdProperty()
+	id.Set(eventIRI)
+	event.SetJSONLDId(id)
+
+	published := streams.NewActivityStreamsPublishedProperty()
+	published.Set(time.Now())
+	event.SetActivityStreamsPublished(published)
+
+	attr := streams.NewActivityStreamsAttributedToProperty()
+	attr.AppendIRI(attributedToIRI)
+	event.SetActivityStreamsAttributedTo(attr)
+
+	return event
+}
+
+// MakeEventPublic sets the required properties to make this event seen as
+// public.
+func MakeEventPublic(event vocab.ActivityStreamsEvent) vocab.ActivityStreamsEvent {
+	public, _ := url.Parse(PUBLIC)
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(public)
+	event.SetActivityStreamsTo(to)
+
+	audience := streams.NewActivityStreamsAudienceProperty()
+	audience.AppendIRI(public)
+	event.SetActivityStreamsAudience(audience)
+
+	return event
+}
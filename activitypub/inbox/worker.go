@@ -1,7 +1,6 @@
 package inbox
 
 import (
-	"context"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
@@ -15,22 +14,40 @@ import (
 	"github.com/owncast/owncast/activitypub/apmodels"
 	"github.com/owncast/owncast/activitypub/persistence"
 	"github.com/owncast/owncast/activitypub/resolvers"
+	"github.com/owncast/owncast/metrics/collectors"
 	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/owncast/owncast/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 
 	log "github.com/sirupsen/logrus"
 )
 
 func handle(request apmodels.InboxRequest) {
+	// Handling is queued, so the span continues the trace of the request
+	// that delivered the activity.
+	ctx, span := tracing.StartFrom(request.Request.Context(), "activitypub.inbox.handle",
+		attribute.String("activitypub.account", request.ForLocalAccount),
+	)
+	defer span.End()
+
 	if verified, err := Verify(request.Request); err != nil {
-		log.Debugln("Error in attempting to verify request", err)
+		collectors.ActivityPubFailures.WithLabelValues(collectors.DirectionInbound, "verification").Inc()
+		span.SetStatus(codes.Error, "verification failed")
+		log.WithContext(ctx).Debugln("Error in attempting to verify request", err)
 		return
 	} else if !verified {
-		log.Debugln("Request failed verification", err)
+		collectors.ActivityPubFailures.WithLabelValues(collectors.DirectionInbound, "verification").Inc()
+		span.SetStatus(codes.Error, "verification failed")
+		log.WithContext(ctx).Debugln("Request failed verification", err)
 		return
 	}
 
-	if err := resolvers.Resolve(context.Background(), request.Body, handleUpdateRequest, handleFollowInboxRequest, handleLikeRequest, handleAnnounceRequest, handleUndoInboxRequest, handleCreateRequest); err != nil {
-		log.Debugln("resolver error:", err)
+	if err := resolvers.Resolve(ctx, request.Body, handleUpdateRequest, handleFollowInboxRequest, handleLikeRequest, handleAnnounceRequest, handleUndoInboxRequest, handleCreateRequest); err != nil {
+		collectors.ActivityPubFailures.WithLabelValues(collectors.DirectionInbound, "handling").Inc()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		log.WithContext(ctx).Debugln("resolver error:", err)
 	}
 }
 
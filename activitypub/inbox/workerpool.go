@@ -4,6 +4,7 @@ import (
 	"runtime"
 
 	"github.com/owncast/owncast/activitypub/apmodels"
+	"github.com/owncast/owncast/metrics/collectors"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -30,6 +31,7 @@ func InitInboxWorkerPool() {
 // AddToQueue will queue up an outbound http request.
 func AddToQueue(req apmodels.InboxRequest) {
 	log.Tracef("Queued request for ActivityPub inbox handler")
+	collectors.ActivityPubQueueDepth.WithLabelValues(collectors.DirectionInbound).Inc()
 	queue <- Job{req}
 }
 
@@ -37,6 +39,7 @@ func worker(workerID int, queue <-chan Job) {
 	log.Debugf("Started ActivityPub worker %d", workerID)
 
 	for job := range queue {
+		collectors.ActivityPubQueueDepth.WithLabelValues(collectors.DirectionInbound).Dec()
 		handle(job.request)
 
 		log.Tracef("Done with ActivityPub inbox handler using worker %d", workerID)
@@ -2,6 +2,7 @@ package outbox
 
 import (
 	"fmt"
+	"html"
 	"net/url"
 	"path/filepath"
 	"regexp"
@@ -16,6 +17,7 @@ import (
 	"github.com/owncast/owncast/activitypub/resolvers"
 	"github.com/owncast/owncast/activitypub/webfinger"
 	"github.com/owncast/owncast/activitypub/workerpool"
+	"github.com/owncast/owncast/models"
 	"github.com/owncast/owncast/persistence/configrepository"
 	"github.com/pkg/errors"
 
@@ -25,12 +27,11 @@ import (
 	"github.com/teris-io/shortid"
 )
 
-// SendLive will send all followers the message saying you started a live stream.
-func SendLive() error {
+// SendLive will send all followers the provided message saying you started a
+// live stream.
+func SendLive(textContent string) error {
 	configRepository := configrepository.Get()
 
-	textContent := configRepository.GetFederationGoLiveMessage()
-
 	// If the message is empty then do not send it.
 	if textContent == "" {
 		return nil
@@ -120,6 +121,63 @@ func SendLive() error {
 	return nil
 }
 
+// SendScheduledStream will send all followers an Event for an upcoming
+// stream.
+func SendScheduledStream(stream models.ScheduledStream) error {
+	configRepository := configrepository.Get()
+	localActor := apmodels.MakeLocalIRIForAccount(configRepository.GetDefaultFederationUsername())
+	serverURL := configRepository.GetServerURL()
+
+	textContent := fmt.Sprintf("<p>%s</p>", html.EscapeString(stream.Title))
+	if stream.Description != "" {
+		textContent += utils.RenderSimpleMarkdown(stream.Description)
+	}
+	textContent += fmt.Sprintf("<p><a href=\"%s\">%s</a></p>", serverURL, serverURL)
+
+	// The event ID is the scheduled stream ID so it can be looked up later.
+	event := apmodels.MakeEvent(stream.Title, textContent, stream.StartTime, apmodels.MakeLocalIRIForResource(stream.ID), localActor)
+
+	if streamURL, err := url.Parse(serverURL); err == nil && serverURL != "" {
+		urlProperty := streams.NewActivityStreamsUrlProperty()
+		urlProperty.AppendIRI(streamURL)
+		event.SetActivityStreamsUrl(urlProperty)
+	}
+
+	if thumbnailURL, err := url.Parse(stream.Thumbnail); err == nil && stream.Thumbnail != "" {
+		apImage := streams.NewActivityStreamsImage()
+		imageURL := streams.NewActivityStreamsUrlProperty()
+		imageURL.AppendIRI(thumbnailURL)
+		apImage.SetActivityStreamsUrl(imageURL)
+
+		imageProperty := streams.NewActivityStreamsImageProperty()
+		imageProperty.AppendActivityStreamsImage(apImage)
+		event.SetActivityStreamsImage(imageProperty)
+	}
+
+	activity := apmodels.CreateCreateActivity(shortid.MustGenerate(), localActor)
+	object := streams.NewActivityStreamsObjectProperty()
+	object.AppendActivityStreamsEvent(event)
+	activity.SetActivityStreamsObject(object)
+
+	// To the public if we're not treating ActivityPub as "private".
+	if !configRepository.GetFederationIsPrivate() {
+		event = apmodels.MakeEventPublic(event)
+		activity = apmodels.MakeActivityPublic(activity)
+	}
+
+	b, err := apmodels.Serialize(activity)
+	if err != nil {
+		log.Errorln("unable to serialize scheduled stream activity", err)
+		return errors.Wrap(err, "unable to serialize scheduled stream activity")
+	}
+
+	if err := SendToFollowers(b); err != nil {
+		return err
+	}
+
+	return Add(event, stream.ID, false)
+}
+
 // SendDirectMessageToAccount will send a direct message to a single account.
 func SendDirectMessageToAccount(textContent, account string) error {
 	links, err := webfinger.GetWebfingerLinks(account)
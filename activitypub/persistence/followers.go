@@ -2,6 +2,8 @@ package persistence
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/owncast/owncast/db"
 	"github.com/owncast/owncast/models"
@@ -36,6 +38,15 @@ func GetFollowerCount() (int64, error) {
 	return _datastore.GetQueries().GetFollowerCount(ctx)
 }
 
+// GetFollowerCountApprovedBetween will return the number of followers
+// approved between two times.
+func GetFollowerCountApprovedBetween(since time.Time, until time.Time) (int64, error) {
+	return _datastore.GetQueries().GetFollowerCountApprovedBetween(context.Background(), db.GetFollowerCountApprovedBetweenParams{
+		ApprovedAt:   sql.NullTime{Time: since, Valid: true},
+		ApprovedAt_2: sql.NullTime{Time: until, Valid: true},
+	})
+}
+
 // GetFederationFollowers will return a slice of the followers we keep track of locally.
 func GetFederationFollowers(limit int, offset int) ([]models.Follower, int, error) {
 	ctx := context.Background()
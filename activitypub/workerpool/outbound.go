@@ -2,13 +2,20 @@ package workerpool
 
 import (
 	"net/http"
+	"time"
 
+	"github.com/owncast/owncast/metrics/collectors"
+	"github.com/owncast/owncast/tracing"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
 // Job struct bundling the ActivityPub and the payload in one struct.
 type Job struct {
-	request *http.Request
+	request  *http.Request
+	queuedAt time.Time
 }
 
 var queue chan Job
@@ -25,11 +32,14 @@ func InitOutboundWorkerPool(workerPoolSize int) {
 
 // AddToOutboundQueue will queue up an outbound http request.
 func AddToOutboundQueue(req *http.Request) {
+	collectors.ActivityPubQueueDepth.WithLabelValues(collectors.DirectionOutbound).Inc()
+
+	job := Job{request: req, queuedAt: time.Now()}
 	select {
-	case queue <- Job{req}:
+	case queue <- job:
 	default:
 		log.Debugln("Outbound ActivityPub job queue is full")
-		queue <- Job{req} // will block until received by a worker at this point
+		queue <- job // will block until received by a worker at this point
 	}
 	log.Tracef("Queued request for ActivityPub destination %s", req.RequestURI)
 }
@@ -38,6 +48,8 @@ func worker(workerID int, queue <-chan Job) {
 	log.Debugf("Started ActivityPub worker %d", workerID)
 
 	for job := range queue {
+		collectors.ActivityPubQueueDepth.WithLabelValues(collectors.DirectionOutbound).Dec()
+
 		if err := sendActivityPubMessageToInbox(job); err != nil {
 			log.Errorf("ActivityPub destination %s failed to send Error: %s", job.request.RequestURI, err)
 		}
@@ -45,15 +57,33 @@ func worker(workerID int, queue <-chan Job) {
 	}
 }
 
-func sendActivityPubMessageToInbox(job Job) error {
+func sendActivityPubMessageToInbox(job Job) (err error) {
+	ctx, span := tracing.StartFrom(job.request.Context(), "activitypub.outbound.deliver",
+		semconv.ServerAddress(job.request.URL.Hostname()),
+		attribute.Int64("activitypub.queued_ms", time.Since(job.queuedAt).Milliseconds()),
+	)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	job.request = job.request.WithContext(ctx)
+	tracing.Inject(ctx, job.request)
+
 	client := &http.Client{}
 
 	resp, err := client.Do(job.request)
 	if err != nil {
+		collectors.ActivityPubFailures.WithLabelValues(collectors.DirectionOutbound, "request").Inc()
 		return err
 	}
 
 	defer resp.Body.Close()
 
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		collectors.ActivityPubFailures.WithLabelValues(collectors.DirectionOutbound, "status").Inc()
+		span.SetStatus(codes.Error, resp.Status)
+	}
+
 	return nil
 }
@@ -38,6 +38,15 @@ type Defaults struct {
 	WebServerPort        int
 
 	ChatEstablishedUserModeTimeDuration time.Duration
+	ChatMessageEditWindowDuration       time.Duration
+	ChatRetentionHours                  int
+
+	ScheduleReminderMinutes int
+
+	NotificationMinIntervalMinutes int
+	NotificationMinUptimeMinutes   int
+
+	AlertRules []models.AlertRule
 
 	YPEnabled bool
 }
@@ -86,6 +95,21 @@ func GetDefaults() Defaults {
 		RTMPServerPort: 1935,
 
 		ChatEstablishedUserModeTimeDuration: time.Minute * 15,
+		ChatMessageEditWindowDuration:       time.Minute * 5,
+		ChatRetentionHours:                  2,
+
+		ScheduleReminderMinutes: 15,
+
+		NotificationMinIntervalMinutes: 10,
+		NotificationMinUptimeMinutes:   2,
+
+		// Warn as soon as hardware utilization is high, like the alerts
+		// that came before these rules did.
+		AlertRules: []models.AlertRule{
+			{ID: "cpu", Metric: models.AlertMetricCPU, Comparison: models.AlertAbove, Threshold: 85, Severity: models.AlertSeverityWarning, Enabled: true},
+			{ID: "memory", Metric: models.AlertMetricMemory, Comparison: models.AlertAbove, Threshold: 85, Severity: models.AlertSeverityWarning, Enabled: true},
+			{ID: "disk", Metric: models.AlertMetricDisk, Comparison: models.AlertAbove, Threshold: 90, Severity: models.AlertSeverityWarning, Enabled: true},
+		},
 
 		StreamVariants: []models.StreamOutputVariant{
 			{
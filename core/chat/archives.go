@@ -0,0 +1,148 @@
+package chat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/chatarchiverepository"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/owncast/owncast/utils"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/teris-io/shortid"
+)
+
+// The formats a chat archive can be downloaded as.
+const (
+	ChatArchiveFormatJSON = "json"
+	ChatArchiveFormatHTML = "html"
+	ChatArchiveFormatText = "txt"
+)
+
+var chatArchiveTemplate = template.Must(template.New("archive").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; }
+.message { margin: 0.5em 0; }
+.timestamp { color: #888; }
+.author { font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{range .Messages}}<div class="message"><span class="timestamp">{{.Timestamp.Format "15:04:05"}}</span> <span class="author">{{.User.DisplayName}}</span>: {{.Body}}</div>
+{{end}}</body>
+</html>
+`))
+
+// ArchiveBroadcast will save the chat of a broadcast that has ended, if
+// broadcast chat archives are enabled.
+func ArchiveBroadcast(startedAt time.Time, endedAt time.Time) {
+	if !configrepository.Get().GetChatRetention().ArchiveBroadcasts {
+		return
+	}
+
+	messages, err := chatmessagerepository.Get().GetMessagesBetween(startedAt, endedAt)
+	if err != nil {
+		log.Errorln("error fetching broadcast chat to archive", err)
+		return
+	}
+
+	if len(messages) == 0 {
+		log.Traceln("No chat messages to archive for broadcast started at", startedAt)
+		return
+	}
+
+	data, err := json.Marshal(messages)
+	if err != nil {
+		log.Errorln("error archiving broadcast chat", err)
+		return
+	}
+
+	archive := models.ChatArchive{
+		ID:           shortid.MustGenerate(),
+		StartedAt:    startedAt,
+		EndedAt:      endedAt,
+		CreatedAt:    time.Now(),
+		MessageCount: len(messages),
+		Messages:     data,
+	}
+
+	if err := chatarchiverepository.Get().SaveArchive(archive); err != nil {
+		log.Errorln("error saving broadcast chat archive", err)
+	}
+}
+
+// RenderChatArchive will return a chat archive in the requested format
+// along with its content type.
+func RenderChatArchive(archive *models.ChatArchive, format string) ([]byte, string, error) {
+	if format == ChatArchiveFormatJSON {
+		return archive.Messages, "application/json", nil
+	}
+
+	var messages []events.UserMessageEvent
+	if err := json.Unmarshal(archive.Messages, &messages); err != nil {
+		return nil, "", errors.Wrap(err, "unable to read chat archive")
+	}
+
+	switch format {
+	case ChatArchiveFormatHTML:
+		return renderChatArchiveHTML(archive, messages)
+	case ChatArchiveFormatText:
+		return renderChatArchiveText(messages), "text/plain; charset=utf-8", nil
+	}
+
+	return nil, "", errors.New("unsupported chat archive format " + format)
+}
+
+func renderChatArchiveHTML(archive *models.ChatArchive, messages []events.UserMessageEvent) ([]byte, string, error) {
+	type archivedMessage struct {
+		Timestamp time.Time
+		User      *models.User
+		Body      template.HTML
+	}
+
+	page := struct {
+		Title    string
+		Messages []archivedMessage
+	}{
+		Title: "Chat from " + archive.StartedAt.Format(time.RFC1123),
+	}
+
+	for _, message := range messages {
+		// Message bodies were sanitized when they were sent.
+		page.Messages = append(page.Messages, archivedMessage{
+			Timestamp: message.Timestamp,
+			User:      message.User,
+			Body:      template.HTML(message.Body), // nolint:gosec
+		})
+	}
+
+	var b bytes.Buffer
+	if err := chatArchiveTemplate.Execute(&b, page); err != nil {
+		return nil, "", err
+	}
+
+	return b.Bytes(), "text/html; charset=utf-8", nil
+}
+
+func renderChatArchiveText(messages []events.UserMessageEvent) []byte {
+	var b strings.Builder
+	for _, message := range messages {
+		body := strings.TrimSpace(html.UnescapeString(utils.StripHTML(message.Body)))
+		fmt.Fprintf(&b, "[%s] %s: %s\n", message.Timestamp.Format("15:04:05"), message.User.DisplayName, body)
+	}
+
+	return []byte(b.String())
+}
@@ -0,0 +1,51 @@
+package chat
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/models"
+)
+
+func TestRenderChatArchive(t *testing.T) {
+	timestamp := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	messages := []events.UserMessageEvent{
+		{
+			Event:        events.Event{ID: "one", Timestamp: timestamp},
+			UserEvent:    events.UserEvent{User: &models.User{DisplayName: "viewer"}},
+			MessageEvent: events.MessageEvent{Body: "<p>it&#39;s <strong>live</strong></p>"},
+		},
+	}
+
+	data, err := json.Marshal(messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	archive := &models.ChatArchive{ID: "archive", StartedAt: timestamp, Messages: data}
+
+	text, contentType, err := RenderChatArchive(archive, ChatArchiveFormatText)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(contentType, "text/plain") {
+		t.Errorf("unexpected content type %s", contentType)
+	}
+	if string(text) != "[15:04:05] viewer: it's live\n" {
+		t.Errorf("unexpected text archive %q", text)
+	}
+
+	page, _, err := RenderChatArchive(archive, ChatArchiveFormatHTML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(page), "<strong>live</strong>") {
+		t.Errorf("html archive does not contain the message body: %s", page)
+	}
+
+	if _, _, err := RenderChatArchive(archive, "pdf"); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
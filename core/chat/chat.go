@@ -7,6 +7,7 @@ import (
 
 	"github.com/owncast/owncast/config"
 	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/core/webhooks"
 	"github.com/owncast/owncast/models"
 	"github.com/owncast/owncast/persistence/chatmessagerepository"
 	"github.com/owncast/owncast/persistence/configrepository"
@@ -22,15 +23,23 @@ var (
 
 // Start begins the chat server.
 func Start(getStatusFunc func() models.Status) error {
+	getStatus = getStatusFunc
 	setupPersistence()
 
 	configRepository := configrepository.Get()
 
-	getStatus = getStatusFunc
 	_server = NewChat()
 
 	go _server.Run()
 
+	resumeActivePoll()
+
+	webhooks.SetChatBotActionHandler(HandleChatBotActions)
+
+	if err := ReloadChatFilterRules(); err != nil {
+		log.Errorln("error loading chat filter rules", err)
+	}
+
 	log.Traceln("Chat server started with max connection count of", _server.maxSocketConnectionLimit)
 
 	chatMessagesSentCounter = promauto.NewGauge(prometheus.GaugeOpts{
@@ -0,0 +1,122 @@
+package chat
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
+	"github.com/owncast/owncast/persistence/moderationrepository"
+	"github.com/owncast/owncast/persistence/userrepository"
+	log "github.com/sirupsen/logrus"
+)
+
+// chatBotActionScopes are the access token scopes each chat bot action
+// requires of the integration the bot acts as.
+var chatBotActionScopes = map[models.ChatBotActionType]string{
+	models.ChatBotActionReply:       models.ScopeCanSendChatMessages,
+	models.ChatBotActionHideMessage: models.ScopeHasAdminAccess,
+	models.ChatBotActionTimeoutUser: models.ScopeHasAdminAccess,
+}
+
+// HandleChatBotActions will perform the actions a chat bot responded to a
+// webhook with, as the integration that owns the access token.
+func HandleChatBotActions(accessToken string, actions []models.ChatBotAction) {
+	userRepository := userrepository.Get()
+
+	for _, action := range actions {
+		scope, ok := chatBotActionScopes[action.Type]
+		if !ok {
+			log.Warnf("Chat bot responded with unknown action %q", action.Type)
+			continue
+		}
+
+		integration, err := userRepository.GetExternalAPIUserForAccessTokenAndScope(accessToken, scope)
+		if err != nil || integration == nil {
+			log.Warnf("Chat bot is not permitted to perform %s", action.Type)
+			continue
+		}
+
+		if err := performChatBotAction(*integration, action); err != nil {
+			log.Warnf("Chat bot %s unable to perform %s: %s", integration.DisplayName, action.Type, err)
+		}
+	}
+}
+
+func performChatBotAction(integration models.ExternalAPIUser, action models.ChatBotAction) error {
+	switch action.Type {
+	case models.ChatBotActionReply:
+		return sendChatBotReply(integration, action.Body, action.ReplyTo)
+
+	case models.ChatBotActionHideMessage:
+		if action.MessageID == "" {
+			return fmt.Errorf("no message to hide")
+		}
+		if err := SetMessagesVisibility([]string{action.MessageID}, false); err != nil {
+			return err
+		}
+		recordChatBotModerationAction(integration, models.ModerationActionHideMessage, action.MessageID, "")
+
+	case models.ChatBotActionTimeoutUser:
+		duration := defaultCommandTimeout
+		if action.DurationSeconds > 0 {
+			duration = time.Duration(action.DurationSeconds) * time.Second
+		}
+		if err := TimeoutUser(action.UserID, duration, action.Reason); err != nil {
+			return err
+		}
+		recordChatBotModerationAction(integration, models.ModerationActionTimeoutUser, action.UserID, action.Reason)
+	}
+
+	return nil
+}
+
+// sendChatBotReply will send a chat message as the chat bot. No webhooks
+// are sent for it so bots can't trigger each other in a loop.
+func sendChatBotReply(integration models.ExternalAPIUser, body string, replyTo string) error {
+	event := events.UserMessageEvent{
+		MessageEvent: events.MessageEvent{
+			Body: body,
+		},
+	}
+	event.SetDefaults()
+	event.RenderBody()
+	event.Type = "CHAT"
+	event.ReplyTo = validReplyTo(replyTo)
+
+	if event.Empty() {
+		return fmt.Errorf("invalid message")
+	}
+
+	event.User = &models.User{
+		ID:           integration.ID,
+		DisplayName:  integration.DisplayName,
+		DisplayColor: integration.DisplayColor,
+		CreatedAt:    integration.CreatedAt,
+		IsBot:        true,
+	}
+
+	if err := Broadcast(&event); err != nil {
+		return err
+	}
+
+	chatMessageRepository := chatmessagerepository.Get()
+	chatMessageRepository.SaveUserMessage(event)
+
+	return nil
+}
+
+func recordChatBotModerationAction(integration models.ExternalAPIUser, action models.ModerationActionType, target string, reason string) {
+	moderationRepository := moderationrepository.Get()
+	if err := moderationRepository.RecordAction(models.ModerationAction{
+		ActorType: models.ModerationActorIntegration,
+		ActorID:   integration.ID,
+		ActorName: integration.DisplayName,
+		Action:    action,
+		Target:    target,
+		Reason:    reason,
+	}); err != nil {
+		log.Errorln("error recording moderation action", err)
+	}
+}
@@ -0,0 +1,163 @@
+package chat
+
+import (
+	"encoding/json"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
+	"github.com/owncast/owncast/persistence/userrepository"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/teris-io/shortid"
+)
+
+func TestMain(m *testing.M) {
+	dbFile, err := os.CreateTemp(os.TempDir(), "owncast-chat-test-db.db")
+	if err != nil {
+		panic(err)
+	}
+	dbFile.Close()
+	defer os.Remove(dbFile.Name())
+
+	if err := data.SetupPersistence(dbFile.Name()); err != nil {
+		panic(err)
+	}
+
+	getStatus = func() models.Status { return models.Status{Online: true} }
+	chatMessagesSentCounter = prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_chat_messages"})
+	setupPersistence()
+	_server = NewChat()
+
+	m.Run()
+}
+
+var testClientID atomic.Uint32
+
+// newTestUser will create a chat user.
+func newTestUser(t *testing.T, name string) *models.User {
+	t.Helper()
+
+	user, _, err := userrepository.Get().CreateAnonymousUser(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return user
+}
+
+// newTestClient will connect a client for a user that records everything
+// sent to it instead of writing to a socket.
+func newTestClient(t *testing.T, user *models.User) *Client {
+	t.Helper()
+
+	client := &Client{
+		Id:     uint(testClientID.Add(1)),
+		User:   user,
+		server: _server,
+		send:   make(chan []byte, 256),
+	}
+
+	_server.mu.Lock()
+	_server.clients[client.Id] = client
+	_server.mu.Unlock()
+
+	t.Cleanup(func() {
+		_server.mu.Lock()
+		delete(_server.clients, client.Id)
+		_server.mu.Unlock()
+	})
+
+	return client
+}
+
+// newTestChatter will create a user with a connected client that can send
+// messages as them.
+func newTestChatter(t *testing.T, name string) (*models.User, *Client) {
+	t.Helper()
+
+	user, accessToken, err := userrepository.Get().CreateAnonymousUser(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := newTestClient(t, user)
+	client.accessToken = accessToken
+
+	return user, client
+}
+
+// newTestModerator will create a moderator with a connected client.
+func newTestModerator(t *testing.T, name string) (*models.User, *Client) {
+	t.Helper()
+
+	user, client := newTestChatter(t, name)
+	if err := userrepository.Get().SetModerator(user.ID, true); err != nil {
+		t.Fatal(err)
+	}
+	user.Scopes = append(user.Scopes, models.ModeratorScopeKey)
+
+	return user, client
+}
+
+// saveTestMessage will save a chat message from a user and return its ID.
+func saveTestMessage(user *models.User, body string, timestamp time.Time) string {
+	id := shortid.MustGenerate()
+	chatmessagerepository.Get().SaveUserMessage(events.UserMessageEvent{
+		Event:        events.Event{ID: id, Type: events.MessageSent, Timestamp: timestamp},
+		UserEvent:    events.UserEvent{User: user},
+		MessageEvent: events.MessageEvent{Body: body},
+	})
+
+	return id
+}
+
+// sendTestEvent will handle an event as if a client had sent it.
+func sendTestEvent(t *testing.T, client *Client, event map[string]interface{}) {
+	t.Helper()
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_server.eventReceived(chatClientEvent{client: client, data: data, receivedAt: time.Now()})
+}
+
+// receivedEventsOfType will return the events of a type sent to a test
+// client so far, discarding any others.
+func receivedEventsOfType(t *testing.T, client *Client, eventType string) []map[string]interface{} {
+	t.Helper()
+
+	matching := []map[string]interface{}{}
+	for _, event := range receivedEvents(t, client) {
+		if event["type"] == eventType {
+			matching = append(matching, event)
+		}
+	}
+
+	return matching
+}
+
+// receivedEvents will return every event sent to a test client so far.
+func receivedEvents(t *testing.T, client *Client) []map[string]interface{} {
+	t.Helper()
+
+	received := []map[string]interface{}{}
+	for {
+		select {
+		case data := <-client.send:
+			var event map[string]interface{}
+			if err := json.Unmarshal(data, &event); err != nil {
+				t.Fatal(err)
+			}
+			received = append(received, event)
+		default:
+			return received
+		}
+	}
+}
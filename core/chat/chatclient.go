@@ -20,14 +20,15 @@ import (
 
 // Client represents a single chat client.
 type Client struct {
-	ConnectedAt   time.Time `json:"connectedAt"`
-	timeoutTimer  *time.Timer
-	rateLimiter   *rate.Limiter
-	messageFilter *ChatMessageFilter
-	conn          *websocket.Conn
-	User          *models.User `json:"user"`
-	server        *Server
-	Geo           *geoip.GeoDetails `json:"geo"`
+	ConnectedAt         time.Time `json:"connectedAt"`
+	timeoutTimer        *time.Timer
+	rateLimiter         *rate.Limiter
+	reactionRateLimiter *rate.Limiter
+	messageFilter       *ChatMessageFilter
+	conn                *websocket.Conn
+	User                *models.User `json:"user"`
+	server              *Server
+	Geo                 *geoip.GeoDetails `json:"geo"`
 	// Buffered channel of outbound messages.
 	send         chan []byte
 	accessToken  string
@@ -40,8 +41,9 @@ type Client struct {
 }
 
 type chatClientEvent struct {
-	client *Client
-	data   []byte
+	receivedAt time.Time
+	client     *Client
+	data       []byte
 }
 
 const (
@@ -92,6 +94,7 @@ func (c *Client) readPump() {
 	// Allow 3 messages every two seconds.
 	limit := rate.Every(2 * time.Second / 3)
 	c.rateLimiter = rate.NewLimiter(limit, 1)
+	c.reactionRateLimiter = newReactionRateLimiter()
 	c.messageFilter = NewMessageFilter()
 
 	defer func() {
@@ -193,7 +196,7 @@ func (c *Client) writePump() {
 }
 
 func (c *Client) handleEvent(data []byte) {
-	c.server.inbound <- chatClientEvent{data: data, client: c}
+	c.server.inbound <- chatClientEvent{data: data, client: c, receivedAt: time.Now()}
 }
 
 func (c *Client) close() {
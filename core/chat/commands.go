@@ -0,0 +1,438 @@
+package chat
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/owncast/owncast/config"
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/owncast/owncast/persistence/moderationrepository"
+	"github.com/owncast/owncast/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// The default length of a timeout when one is not provided.
+	defaultCommandTimeout = 5 * time.Minute
+	// How often a single custom command can be run by non-moderators.
+	customCommandCooldown = 10 * time.Second
+	// The longest a custom command response can be.
+	maxCustomCommandResponseLength = 1000
+)
+
+var customCommandNameMatch = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
+// chatCommand is a built-in command that can be typed into chat.
+type chatCommand struct {
+	usage         string
+	description   string
+	moderatorOnly bool
+	run           func(s *Server, eventData chatClientEvent, args string)
+}
+
+var builtInCommands map[string]chatCommand
+
+var (
+	customCommandLock    sync.Mutex
+	customCommandLastRun = map[string]time.Time{}
+)
+
+func init() {
+	builtInCommands = map[string]chatCommand{
+		"help": {
+			usage:       "/help",
+			description: "List the commands you can use.",
+			run:         helpCommand,
+		},
+		"me": {
+			usage:       "/me <action>",
+			description: "Describe something you are doing.",
+			run:         meCommand,
+		},
+		"color": {
+			usage:       "/color <number>",
+			description: fmt.Sprintf("Change the color of your name, from 0 to %d.", config.MaxUserColor),
+			run:         colorCommand,
+		},
+		"timeout": {
+			usage:         "/timeout <name> [duration] [reason]",
+			description:   "Time a user out of chat, for five minutes unless a duration such as 30s, 10m or 1h is given.",
+			moderatorOnly: true,
+			run:           timeoutCommand,
+		},
+		"hide": {
+			usage:         "/hide <name>",
+			description:   "Hide all the messages from a user.",
+			moderatorOnly: true,
+			run:           hideCommand,
+		},
+		"slow": {
+			usage:         "/slow <seconds|off>",
+			description:   "Turn slow mode on or off.",
+			moderatorOnly: true,
+			run:           slowCommand,
+		},
+		"clear": {
+			usage:         "/clear",
+			description:   "Hide all the messages in chat.",
+			moderatorOnly: true,
+			run:           clearCommand,
+		},
+	}
+}
+
+// handleCommand will run the command in a chat message if it contains one.
+// Returns false if the message is not a command and should be sent to chat.
+func (s *Server) handleCommand(eventData chatClientEvent) bool {
+	var event events.UserMessageEvent
+	if err := json.Unmarshal(eventData.data, &event); err != nil {
+		return false
+	}
+
+	name, args, isCommand := parseCommand(event.Body)
+	if !isCommand {
+		return false
+	}
+
+	c := eventData.client
+	if c.User == nil {
+		return true
+	}
+
+	if command, ok := builtInCommands[name]; ok {
+		if command.moderatorOnly && !c.User.IsModerator() {
+			s.sendActionToClient(c, fmt.Sprintf("Only moderators can use /%s.", name))
+			return true
+		}
+
+		command.run(s, eventData, args)
+		return true
+	}
+
+	for _, command := range configrepository.Get().GetChatCustomCommands() {
+		if command.Name == name {
+			s.runCustomCommand(c, command)
+			return true
+		}
+	}
+
+	s.sendActionToClient(c, fmt.Sprintf("Unknown command /%s. Type /help to see the commands you can use.", name))
+	return true
+}
+
+// parseCommand will return the name and arguments of a command typed into
+// chat, and if the message is a command.
+func parseCommand(body string) (string, string, bool) {
+	text := strings.TrimSpace(html.UnescapeString(utils.StripHTML(body)))
+	if !strings.HasPrefix(text, "/") {
+		return "", "", false
+	}
+
+	text = strings.TrimPrefix(text, "/")
+	name, args, _ := strings.Cut(text, " ")
+	name = strings.ToLower(name)
+
+	// Not a command, such as a message starting with a path or a smiley.
+	if !customCommandNameMatch.MatchString(name) {
+		return "", "", false
+	}
+
+	return name, strings.TrimSpace(args), true
+}
+
+func helpCommand(s *Server, eventData chatClientEvent, _ string) {
+	c := eventData.client
+
+	names := make([]string, 0, len(builtInCommands))
+	for name := range builtInCommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := []string{}
+	for _, name := range names {
+		command := builtInCommands[name]
+		if command.moderatorOnly && !c.User.IsModerator() {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("`%s` %s", command.usage, command.description))
+	}
+
+	for _, command := range configrepository.Get().GetChatCustomCommands() {
+		if command.ModeratorOnly && !c.User.IsModerator() {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("`/%s`", command.Name))
+	}
+
+	s.sendActionToClient(c, strings.Join(lines, "<br>"))
+}
+
+// meCommand will send the action as an emphasized chat message so it is
+// subject to the same chat modes, filters and review as any other message.
+func meCommand(s *Server, eventData chatClientEvent, args string) {
+	if args == "" {
+		s.sendActionToClient(eventData.client, "Usage: `/me <action>`")
+		return
+	}
+
+	var message map[string]interface{}
+	if err := json.Unmarshal(eventData.data, &message); err != nil {
+		log.Errorln("error unmarshalling /me command", err)
+		return
+	}
+	message["body"] = "_" + args + "_"
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		log.Errorln("error marshalling /me command", err)
+		return
+	}
+
+	eventData.data = data
+	s.userMessageSent(eventData)
+}
+
+func colorCommand(s *Server, eventData chatClientEvent, args string) {
+	color, err := strconv.Atoi(args)
+	if err != nil || color < 0 || color > config.MaxUserColor {
+		s.sendActionToClient(eventData.client, fmt.Sprintf("Usage: `/color <number>` with a number from 0 to %d.", config.MaxUserColor))
+		return
+	}
+
+	s.changeUserColor(eventData.client, color)
+}
+
+func timeoutCommand(s *Server, eventData chatClientEvent, args string) {
+	c := eventData.client
+
+	if strings.TrimSpace(args) == "" {
+		s.sendActionToClient(c, "Usage: `/timeout <name> [duration] [reason]`")
+		return
+	}
+
+	user, rest := findConnectedUserAtStart(args)
+	if user == nil {
+		name := strings.Fields(args)[0]
+		s.sendActionToClient(c, fmt.Sprintf("No one named **%s** is in chat.", strings.TrimPrefix(name, "@")))
+		return
+	}
+
+	duration := defaultCommandTimeout
+	reasonFields := strings.Fields(rest)
+	if len(reasonFields) > 0 {
+		if parsed, err := time.ParseDuration(reasonFields[0]); err == nil {
+			duration = parsed
+			reasonFields = reasonFields[1:]
+		}
+	}
+	reason := strings.Join(reasonFields, " ")
+
+	if err := TimeoutUser(user.ID, duration, reason); err != nil {
+		s.sendActionToClient(c, "Unable to time out user: "+err.Error())
+		return
+	}
+
+	recordCommandModerationAction(c, models.ModerationActionTimeoutUser, user.ID, reason)
+}
+
+func hideCommand(s *Server, eventData chatClientEvent, args string) {
+	c := eventData.client
+
+	user := findConnectedUserByName(args)
+	if user == nil {
+		s.sendActionToClient(c, fmt.Sprintf("No one named **%s** is in chat.", strings.TrimPrefix(args, "@")))
+		return
+	}
+
+	messageIDs, err := chatmessagerepository.Get().GetMessageIdsForUserID(user.ID)
+	if err != nil {
+		log.Errorln("error fetching user messages", err)
+		return
+	}
+
+	if len(messageIDs) == 0 {
+		s.sendActionToClient(c, fmt.Sprintf("**%s** has no messages to hide.", user.DisplayName))
+		return
+	}
+
+	if err := SetMessagesVisibility(messageIDs, false); err != nil {
+		s.sendActionToClient(c, "Unable to hide messages.")
+		return
+	}
+
+	for _, messageID := range messageIDs {
+		recordCommandModerationAction(c, models.ModerationActionHideMessage, messageID, "")
+	}
+
+	s.sendActionToClient(c, fmt.Sprintf("Hid %s from **%s**.", pluralize(len(messageIDs), "message"), user.DisplayName))
+}
+
+func slowCommand(s *Server, eventData chatClientEvent, args string) {
+	c := eventData.client
+
+	seconds := 0
+	if args != "off" {
+		parsed, err := strconv.Atoi(args)
+		if err != nil {
+			s.sendActionToClient(c, "Usage: `/slow <seconds|off>`")
+			return
+		}
+		seconds = parsed
+	}
+
+	modes := GetChatModes()
+	modes.SlowModeSeconds = seconds
+	if err := SetChatModes(modes); err != nil {
+		s.sendActionToClient(c, err.Error())
+	}
+}
+
+func clearCommand(s *Server, eventData chatClientEvent, _ string) {
+	c := eventData.client
+
+	messageIDs, err := chatmessagerepository.Get().GetVisibleMessageIDs()
+	if err != nil {
+		log.Errorln("error fetching chat messages", err)
+		return
+	}
+
+	if len(messageIDs) == 0 {
+		return
+	}
+
+	if err := SetMessagesVisibility(messageIDs, false); err != nil {
+		s.sendActionToClient(c, "Unable to clear chat.")
+		return
+	}
+
+	recordCommandModerationAction(c, models.ModerationActionHideMessage, "all", "cleared chat")
+}
+
+// runCustomCommand will reply to chat with the response of an admin
+// defined command.
+func (s *Server) runCustomCommand(c *Client, command models.ChatCommand) {
+	if command.ModeratorOnly && !c.User.IsModerator() {
+		s.sendActionToClient(c, fmt.Sprintf("Only moderators can use /%s.", command.Name))
+		return
+	}
+
+	if !c.User.IsModerator() {
+		customCommandLock.Lock()
+		lastRun := customCommandLastRun[command.Name]
+		if time.Since(lastRun) < customCommandCooldown {
+			customCommandLock.Unlock()
+			return
+		}
+		customCommandLastRun[command.Name] = time.Now()
+		customCommandLock.Unlock()
+	}
+
+	if err := SendSystemMessage(command.Response, false); err != nil {
+		log.Errorln("error sending custom command response", err)
+	}
+}
+
+// SetCustomCommands will validate and save the admin defined chat commands.
+func SetCustomCommands(commands []models.ChatCommand) error {
+	seen := map[string]bool{}
+	for i, command := range commands {
+		name := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(command.Name), "/"))
+		if !customCommandNameMatch.MatchString(name) {
+			return fmt.Errorf("%q is not a valid command name. Use up to 32 letters, numbers, dashes and underscores", command.Name)
+		}
+
+		if _, builtIn := builtInCommands[name]; builtIn {
+			return fmt.Errorf("/%s is a built-in command", name)
+		}
+
+		if seen[name] {
+			return fmt.Errorf("/%s is defined more than once", name)
+		}
+		seen[name] = true
+
+		response := strings.TrimSpace(command.Response)
+		if response == "" || len(response) > maxCustomCommandResponseLength {
+			return fmt.Errorf("the response to /%s must be between 1 and %d characters", name, maxCustomCommandResponseLength)
+		}
+
+		commands[i].Name = name
+		commands[i].Response = response
+	}
+
+	return configrepository.Get().SetChatCustomCommands(commands)
+}
+
+// findConnectedUserByName will return the user in chat with a display name,
+// ignoring case and a leading @.
+func findConnectedUserByName(name string) *models.User {
+	name = strings.TrimPrefix(strings.TrimSpace(name), "@")
+	if name == "" {
+		return nil
+	}
+
+	for _, client := range GetClients() {
+		if client.User != nil && strings.EqualFold(client.User.DisplayName, name) {
+			return client.User
+		}
+	}
+
+	return nil
+}
+
+// findConnectedUserAtStart will return the user in chat whose display name
+// the arguments start with, and the arguments that follow it. Display names
+// can contain spaces, so the longest matching name is used.
+func findConnectedUserAtStart(args string) (*models.User, string) {
+	args = strings.TrimPrefix(strings.TrimSpace(args), "@")
+
+	var found *models.User
+	for _, client := range GetClients() {
+		if client.User == nil || client.User.DisplayName == "" {
+			continue
+		}
+
+		name := client.User.DisplayName
+		if len(name) > len(args) || !strings.EqualFold(args[:len(name)], name) {
+			continue
+		}
+		// The name must be followed by the end of the arguments or a space.
+		if len(args) > len(name) && !unicode.IsSpace(rune(args[len(name)])) {
+			continue
+		}
+		if found == nil || len(name) > len(found.DisplayName) {
+			found = client.User
+		}
+	}
+
+	if found == nil {
+		return nil, args
+	}
+
+	return found, strings.TrimSpace(args[len(found.DisplayName):])
+}
+
+func recordCommandModerationAction(c *Client, action models.ModerationActionType, target string, reason string) {
+	moderationRepository := moderationrepository.Get()
+	if err := moderationRepository.RecordAction(models.ModerationAction{
+		ActorType: models.ModerationActorModerator,
+		ActorID:   c.User.ID,
+		ActorName: c.User.DisplayName,
+		Action:    action,
+		Target:    target,
+		Reason:    reason,
+	}); err != nil {
+		log.Errorln("error recording moderation action", err)
+	}
+}
@@ -0,0 +1,84 @@
+package chat
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/models"
+)
+
+func TestParseCommand(t *testing.T) {
+	commands := map[string][2]string{
+		"/help":                       {"help", ""},
+		"  /ME dances  ":              {"me", "dances"},
+		"/timeout @viewer 10m spam":   {"timeout", "@viewer 10m spam"},
+		"<p>/slow 30</p>":             {"slow", "30"},
+		"/discord":                    {"discord", ""},
+		"/me it&#39;s <em>great</em>": {"me", "it's great"},
+	}
+
+	for body, expected := range commands {
+		name, args, isCommand := parseCommand(body)
+		if !isCommand {
+			t.Errorf("%q should be a command", body)
+			continue
+		}
+		if name != expected[0] || args != expected[1] {
+			t.Errorf("%q should be parsed as %q %q, got %q %q", body, expected[0], expected[1], name, args)
+		}
+	}
+
+	messages := []string{"hello", "/", "/ nothing", ":/", "/usr/bin is a path", "a /help"}
+	for _, body := range messages {
+		if _, _, isCommand := parseCommand(body); isCommand {
+			t.Errorf("%q should not be a command", body)
+		}
+	}
+}
+
+func TestSetCustomCommandsValidation(t *testing.T) {
+	invalid := map[string][]models.ChatCommand{
+		"invalid name":  {{Name: "two words", Response: "hi"}},
+		"built-in name": {{Name: "/timeout", Response: "hi"}},
+		"duplicate":     {{Name: "discord", Response: "hi"}, {Name: "Discord", Response: "hi"}},
+		"no response":   {{Name: "discord", Response: " "}},
+	}
+
+	for name, commands := range invalid {
+		if err := SetCustomCommands(commands); err == nil {
+			t.Errorf("%s should not be allowed", name)
+		}
+	}
+}
+
+func TestTimeoutCommandNameWithSpaces(t *testing.T) {
+	_, moderatorClient := newTestModerator(t, "timeout-moderator")
+	shortName, _ := newTestChatter(t, "Timeout")
+	longName, _ := newTestChatter(t, "Timeout Target")
+
+	sendTestEvent(t, moderatorClient, map[string]interface{}{
+		"type": events.MessageSent,
+		"body": "/timeout @timeout target 5m being rude",
+	})
+
+	allowed, message := passesTimeout(longName)
+	if allowed {
+		t.Fatal("the user with the longest matching name should be timed out")
+	}
+	if !strings.Contains(message, "5 minutes") || !strings.Contains(message, "Reason: being rude") {
+		t.Errorf("unexpected timeout message %q", message)
+	}
+	if allowed, _ := passesTimeout(shortName); !allowed {
+		t.Error("a user whose name only starts the target's name should not be timed out")
+	}
+
+	sendTestEvent(t, moderatorClient, map[string]interface{}{
+		"type": events.MessageSent,
+		"body": "/timeout Timeout",
+	})
+
+	if allowed, _ := passesTimeout(shortName); allowed {
+		t.Error("the user named exactly in the command should be timed out")
+	}
+}
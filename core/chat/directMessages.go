@@ -0,0 +1,145 @@
+package chat
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/owncast/owncast/config"
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/owncast/owncast/persistence/directmessagerepository"
+	"github.com/owncast/owncast/persistence/userrepository"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxDirectMessageHistory is the most direct messages sent to a user
+// when they connect to chat.
+const maxDirectMessageHistory = 50
+
+// SendDirectMessage will privately send a message from one user to another.
+// The body is expected to already be rendered and sanitized.
+func SendDirectMessage(sender *models.User, recipientID string, body string) error {
+	return _server.sendDirectMessage(sender, recipientID, body)
+}
+
+func (s *Server) directMessageReceived(eventData chatClientEvent) {
+	c := eventData.client
+
+	var event events.DirectMessageEvent
+	if err := json.Unmarshal(eventData.data, &event); err != nil {
+		log.Errorln("error unmarshalling to DirectMessageEvent", err)
+		return
+	}
+
+	event.RenderAndSanitizeMessageBody()
+	if event.Empty() {
+		return
+	}
+
+	// Moderators can message anybody. Everybody else can only message
+	// moderators, and only if the admin allows it.
+	if !c.User.IsModerator() {
+		if !configrepository.Get().GetChatUserDirectMessagesEnabled() {
+			s.sendActionToClient(c, "Sorry, direct messages are not enabled in this chat.")
+			return
+		}
+
+		recipient := userrepository.Get().GetUserByID(event.RecipientID)
+		if recipient == nil || !recipient.IsModerator() {
+			s.sendActionToClient(c, "Sorry, you can only send direct messages to moderators.")
+			return
+		}
+	}
+
+	if err := s.sendDirectMessage(c.User, event.RecipientID, event.Body); err != nil {
+		s.sendActionToClient(c, err.Error())
+	}
+}
+
+func (s *Server) sendDirectMessage(sender *models.User, recipientID string, body string) error {
+	if body == "" {
+		return errors.New("direct message cannot be empty")
+	}
+
+	if sender.ID == recipientID {
+		return errors.New("you cannot send a direct message to yourself")
+	}
+
+	userRepository := userrepository.Get()
+	if recipient := userRepository.GetUserByID(recipientID); recipient == nil {
+		return errors.New("user not found")
+	}
+
+	event := events.DirectMessageEvent{
+		UserEvent:    events.UserEvent{User: sender},
+		MessageEvent: events.MessageEvent{Body: body},
+		RecipientID:  recipientID,
+	}
+	event.Event.SetDefaults()
+
+	directMessageRepository := directmessagerepository.Get()
+	if err := directMessageRepository.SaveMessage(models.DirectMessage{
+		ID:          event.ID,
+		Sender:      sender,
+		RecipientID: recipientID,
+		Body:        body,
+		Timestamp:   event.Timestamp,
+	}); err != nil {
+		return errors.Wrap(err, "error saving direct message")
+	}
+
+	payload := event.GetBroadcastPayload()
+	for _, userID := range []string{sender.ID, recipientID} {
+		clients, err := GetClientsForUser(userID)
+		if err != nil {
+			continue
+		}
+		for _, client := range clients {
+			client.sendPayload(payload)
+		}
+	}
+
+	return nil
+}
+
+// sendDirectMessages will send a user their recent direct messages.
+func (c *Client) sendDirectMessages() {
+	directMessageRepository := directmessagerepository.Get()
+	messages, err := directMessageRepository.GetMessagesForUser(c.User.ID, maxDirectMessageHistory)
+	if err != nil {
+		log.Errorln("error fetching direct messages", err)
+		return
+	}
+
+	for _, message := range messages {
+		event := events.DirectMessageEvent{
+			Event:        events.Event{ID: message.ID, Timestamp: message.Timestamp},
+			UserEvent:    events.UserEvent{User: message.Sender},
+			MessageEvent: events.MessageEvent{Body: message.Body},
+			RecipientID:  message.RecipientID,
+		}
+		c.sendPayload(event.GetBroadcastPayload())
+	}
+}
+
+// pruneDirectMessages will remove direct messages older than the chat
+// retention. Direct messages are not counted towards a retention by number
+// of messages, so they are kept for the default number of hours instead.
+func pruneDirectMessages() {
+	retention := configrepository.Get().GetChatRetention()
+	if retention.Mode == models.ChatRetentionForever {
+		return
+	}
+
+	hours := config.GetDefaults().ChatRetentionHours
+	if retention.Mode == models.ChatRetentionHours {
+		hours = retention.Hours
+	}
+
+	directMessageRepository := directmessagerepository.Get()
+	if err := directMessageRepository.RemoveMessagesBefore(time.Now().Add(-time.Duration(hours) * time.Hour)); err != nil {
+		log.Debugln(err)
+	}
+}
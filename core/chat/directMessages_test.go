@@ -0,0 +1,101 @@
+package chat
+
+import (
+	"testing"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/owncast/owncast/persistence/directmessagerepository"
+)
+
+// setUserDirectMessagesEnabled will allow or disallow users messaging
+// moderators for the rest of the test.
+func setUserDirectMessagesEnabled(t *testing.T, enabled bool) {
+	t.Helper()
+
+	configRepository := configrepository.Get()
+	if err := configRepository.SetChatUserDirectMessagesEnabled(enabled); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = configRepository.SetChatUserDirectMessagesEnabled(false)
+	})
+}
+
+func sendTestDirectMessage(t *testing.T, client *Client, recipientID string, body string) {
+	t.Helper()
+
+	sendTestEvent(t, client, map[string]interface{}{"type": events.DirectMessage, "recipientId": recipientID, "body": body})
+}
+
+func TestUserDirectMessagesRequireBeingEnabled(t *testing.T) {
+	setUserDirectMessagesEnabled(t, false)
+
+	user, userClient := newTestChatter(t, "dm-disabled-user")
+	moderator, moderatorClient := newTestModerator(t, "dm-disabled-moderator")
+
+	sendTestDirectMessage(t, userClient, moderator.ID, "hello")
+	if received := receivedEventsOfType(t, moderatorClient, events.DirectMessage); len(received) != 0 {
+		t.Errorf("Expected users to not be able to message moderators but got %v", received)
+	}
+	if actions := receivedEventsOfType(t, userClient, events.ChatActionSent); len(actions) != 1 {
+		t.Errorf("Expected the user to be told direct messages are disabled but got %v", actions)
+	}
+
+	// Moderators can always message users.
+	sendTestDirectMessage(t, moderatorClient, user.ID, "hello")
+	if received := receivedEventsOfType(t, userClient, events.DirectMessage); len(received) != 1 {
+		t.Errorf("Expected moderators to be able to message users but got %v", received)
+	}
+}
+
+func TestUsersCanOnlyDirectMessageModerators(t *testing.T) {
+	setUserDirectMessagesEnabled(t, true)
+
+	user, userClient := newTestChatter(t, "dm-user")
+	other, otherClient := newTestChatter(t, "dm-other-user")
+	moderator, moderatorClient := newTestModerator(t, "dm-moderator")
+	_, bystanderClient := newTestChatter(t, "dm-bystander")
+
+	sendTestDirectMessage(t, userClient, other.ID, "psst")
+	if received := receivedEventsOfType(t, otherClient, events.DirectMessage); len(received) != 0 {
+		t.Errorf("Expected users to not be able to message other users but got %v", received)
+	}
+	if actions := receivedEventsOfType(t, userClient, events.ChatActionSent); len(actions) != 1 {
+		t.Errorf("Expected the user to be told they can only message moderators but got %v", actions)
+	}
+
+	sendTestDirectMessage(t, userClient, moderator.ID, "help")
+	received := receivedEventsOfType(t, moderatorClient, events.DirectMessage)
+	if len(received) != 1 || received[0]["body"] != "<p>help</p>" || received[0]["recipientId"] != moderator.ID {
+		t.Errorf("Expected the moderator to receive the message but got %v", received)
+	}
+	if sent := receivedEventsOfType(t, userClient, events.DirectMessage); len(sent) != 1 {
+		t.Errorf("Expected the sender to be sent their own message but got %v", sent)
+	}
+	if leaked := receivedEventsOfType(t, bystanderClient, events.DirectMessage); len(leaked) != 0 {
+		t.Errorf("Expected direct messages to be private but got %v", leaked)
+	}
+
+	messages, err := directmessagerepository.Get().GetMessagesForUser(moderator.ID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 1 || messages[0].Sender.ID != user.ID {
+		t.Errorf("Expected the message to be saved for the moderator but got %+v", messages)
+	}
+}
+
+func TestDirectMessageRecipients(t *testing.T) {
+	moderator, _ := newTestModerator(t, "dm-recipient-moderator")
+
+	if err := SendDirectMessage(moderator, moderator.ID, "<p>me</p>"); err == nil {
+		t.Error("Expected a direct message to yourself to be rejected")
+	}
+	if err := SendDirectMessage(moderator, "not-a-user", "<p>anyone?</p>"); err == nil {
+		t.Error("Expected a direct message to a user that doesn't exist to be rejected")
+	}
+	if err := SendDirectMessage(moderator, newTestUser(t, "dm-empty").ID, ""); err == nil {
+		t.Error("Expected an empty direct message to be rejected")
+	}
+}
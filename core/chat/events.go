@@ -9,6 +9,7 @@ import (
 	"github.com/owncast/owncast/config"
 	"github.com/owncast/owncast/core/chat/events"
 	"github.com/owncast/owncast/core/webhooks"
+	"github.com/owncast/owncast/metrics/collectors"
 	"github.com/owncast/owncast/persistence/chatmessagerepository"
 	"github.com/owncast/owncast/persistence/configrepository"
 	"github.com/owncast/owncast/persistence/userrepository"
@@ -108,8 +109,6 @@ func (s *Server) userNameChanged(eventData chatClientEvent) {
 }
 
 func (s *Server) userColorChanged(eventData chatClientEvent) {
-	userRepository := userrepository.Get()
-
 	var receivedEvent events.ColorChangeEvent
 	if err := json.Unmarshal(eventData.data, &receivedEvent); err != nil {
 		log.Errorln("error unmarshalling to ColorChangeEvent", err)
@@ -122,14 +121,20 @@ func (s *Server) userColorChanged(eventData chatClientEvent) {
 		return
 	}
 
+	s.changeUserColor(eventData.client, receivedEvent.NewColor)
+}
+
+func (s *Server) changeUserColor(c *Client, color int) {
+	userRepository := userrepository.Get()
+
 	// Save the new color
-	if err := userRepository.ChangeUserColor(eventData.client.User.ID, receivedEvent.NewColor); err != nil {
+	if err := userRepository.ChangeUserColor(c.User.ID, color); err != nil {
 		log.Errorln("error changing user display color", err)
 	}
 
 	// Resend client's user info with new color, otherwise the name change dialog would still show the old color
-	eventData.client.User.DisplayColor = receivedEvent.NewColor
-	eventData.client.sendConnectedClientInfo()
+	c.User.DisplayColor = color
+	c.sendConnectedClientInfo()
 }
 
 func (s *Server) userMessageSent(eventData chatClientEvent) {
@@ -143,6 +148,7 @@ func (s *Server) userMessageSent(eventData chatClientEvent) {
 
 	event.SetDefaults()
 	event.ClientID = eventData.client.Id
+	event.Reactions = nil
 
 	// Ignore empty messages
 	if event.Empty() {
@@ -164,18 +170,52 @@ func (s *Server) userMessageSent(eventData chatClientEvent) {
 		return
 	}
 
-	payload := event.GetBroadcastPayload()
-	if err := s.Broadcast(payload); err != nil {
+	// Only keep replies to messages that exist.
+	event.ReplyTo = validReplyTo(event.ReplyTo)
+
+	// Enforce slow mode, authenticated only mode and emote only mode.
+	if allowed, reason := s.passesChatModes(eventData.client, &event); !allowed {
+		s.sendActionToClient(eventData.client, reason)
+		return
+	}
+
+	// Apply the admin managed chat filter rules.
+	if !s.passesFilterRules(eventData.client, &event) {
+		return
+	}
+
+	// Hold back messages that a moderator needs to review first.
+	if hold, reason := s.shouldHoldMessage(eventData.client, &event); hold {
+		s.holdMessage(&event, reason)
+		s.sendActionToClient(eventData.client, "Your message will be sent once a moderator approves it.")
+		return
+	}
+
+	if err := s.publishUserMessage(&event); err != nil {
 		log.Errorln("error broadcasting UserMessageEvent payload", err)
 		return
 	}
 
+	s.recordMessageSent(event.User.ID)
+	eventData.client.MessageCount++
+}
+
+// publishUserMessage will send a user message to chat, notify webhooks
+// and persist it.
+func (s *Server) publishUserMessage(event *events.UserMessageEvent) error {
+	payload := event.GetBroadcastPayload()
+	if err := s.Broadcast(payload); err != nil {
+		return err
+	}
+
 	// Send chat message sent webhook
-	webhooks.SendChatEvent(&event)
+	webhooks.SendChatEvent(event)
 	chatMessagesSentCounter.Inc()
+	collectors.ChatMessages.Inc()
 	chatMessageRepository := chatmessagerepository.Get()
-	chatMessageRepository.SaveUserMessage(event)
-	eventData.client.MessageCount++
+	chatMessageRepository.SaveUserMessage(*event)
+
+	return nil
 }
 
 func logSanitize(userValue string) string {
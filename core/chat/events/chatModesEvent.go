@@ -0,0 +1,26 @@
+package events
+
+import "github.com/owncast/owncast/models"
+
+// ChatModesEvent is the event fired when the chat moderation modes change.
+type ChatModesEvent struct {
+	Event
+	models.ChatModes
+}
+
+// GetBroadcastPayload will return the object to send to all chat users.
+func (e *ChatModesEvent) GetBroadcastPayload() EventPayload {
+	return EventPayload{
+		"type":              ChatModesUpdate,
+		"id":                e.ID,
+		"timestamp":         e.Timestamp,
+		"slowModeSeconds":   e.SlowModeSeconds,
+		"authenticatedOnly": e.AuthenticatedOnly,
+		"emoteOnly":         e.EmoteOnly,
+	}
+}
+
+// GetMessageType will return the event type for this message.
+func (e *ChatModesEvent) GetMessageType() EventType {
+	return ChatModesUpdate
+}
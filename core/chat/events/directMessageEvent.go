@@ -0,0 +1,26 @@
+package events
+
+// DirectMessageEvent is a private message sent from one chat user to another.
+type DirectMessageEvent struct {
+	Event
+	UserEvent
+	MessageEvent
+	RecipientID string `json:"recipientId"`
+}
+
+// GetBroadcastPayload will return the object to send to the sender and recipient.
+func (e *DirectMessageEvent) GetBroadcastPayload() EventPayload {
+	return EventPayload{
+		"type":        DirectMessage,
+		"id":          e.ID,
+		"timestamp":   e.Timestamp,
+		"user":        e.User,
+		"recipientId": e.RecipientID,
+		"body":        e.Body,
+	}
+}
+
+// GetMessageType will return the event type for this message.
+func (e *DirectMessageEvent) GetMessageType() EventType {
+	return DirectMessage
+}
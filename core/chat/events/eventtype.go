@@ -46,4 +46,28 @@ const (
 	FediverseEngagementLike EventType = "FEDIVERSE_ENGAGEMENT_LIKE"
 	// FediverseEngagementRepost is an event representing a re-post action that took place on the fediverse.
 	FediverseEngagementRepost EventType = "FEDIVERSE_ENGAGEMENT_REPOST"
+	// ChatModesUpdate is sent by moderators to change the chat modes, and to all clients when they change.
+	ChatModesUpdate EventType = "CHAT_MODES_UPDATE"
+	// UserTimedOut is a private event to a user letting them know they have been timed out, or that their timeout was removed.
+	UserTimedOut EventType = "USER_TIMED_OUT"
+	// HeldMessage is sent to moderators when a message is held for them to review.
+	HeldMessage EventType = "HELD_MESSAGE"
+	// HeldMessageReviewed is sent by moderators to approve or reject a held message, and to all moderators once it has been reviewed.
+	HeldMessageReviewed EventType = "HELD_MESSAGE_REVIEWED"
+	// MessageReaction is sent by a user to add or remove a reaction on a message, and to all clients when a reaction changes.
+	MessageReaction EventType = "MESSAGE_REACTION"
+	// MessageEdited is sent by a user to edit their own message, and to all clients when a message is edited.
+	MessageEdited EventType = "MESSAGE_EDITED"
+	// MessageDeleted is sent by a user to delete their own message.
+	MessageDeleted EventType = "MESSAGE_DELETED"
+	// DirectMessage is a private message between a user and a moderator.
+	DirectMessage EventType = "DIRECT_MESSAGE"
+	// PollVote is sent by a user to vote in the active poll.
+	PollVote EventType = "POLL_VOTE"
+	// PollStarted is sent to all clients when a poll starts.
+	PollStarted EventType = "POLL_STARTED"
+	// PollUpdated is sent to all clients with the live results of the active poll.
+	PollUpdated EventType = "POLL_UPDATED"
+	// PollEnded is sent to all clients with the final results of a poll.
+	PollEnded EventType = "POLL_ENDED"
 )
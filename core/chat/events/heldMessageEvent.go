@@ -0,0 +1,46 @@
+package events
+
+// HeldMessageEvent is a user message held for moderators to review before
+// it is sent to chat.
+type HeldMessageEvent struct {
+	UserMessageEvent
+	Reason string `json:"reason"`
+}
+
+// GetBroadcastPayload will return the object to send to moderators.
+func (e *HeldMessageEvent) GetBroadcastPayload() EventPayload {
+	payload := e.UserMessageEvent.GetBroadcastPayload()
+	payload["type"] = HeldMessage
+	payload["reason"] = e.Reason
+
+	return payload
+}
+
+// GetMessageType will return the event type for this message.
+func (e *HeldMessageEvent) GetMessageType() EventType {
+	return HeldMessage
+}
+
+// HeldMessageReviewedEvent is the event fired when a moderator approves or
+// rejects a held message.
+type HeldMessageReviewedEvent struct {
+	Event
+	MessageID string `json:"messageId"`
+	Approved  bool   `json:"approved"`
+}
+
+// GetBroadcastPayload will return the object to send to moderators.
+func (e *HeldMessageReviewedEvent) GetBroadcastPayload() EventPayload {
+	return EventPayload{
+		"type":      HeldMessageReviewed,
+		"id":        e.ID,
+		"timestamp": e.Timestamp,
+		"messageId": e.MessageID,
+		"approved":  e.Approved,
+	}
+}
+
+// GetMessageType will return the event type for this message.
+func (e *HeldMessageReviewedEvent) GetMessageType() EventType {
+	return HeldMessageReviewed
+}
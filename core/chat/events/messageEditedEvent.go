@@ -0,0 +1,41 @@
+package events
+
+import "time"
+
+// MessageEditedEvent is sent by a user to edit one of their messages, and to
+// all clients when a message has been edited.
+type MessageEditedEvent struct {
+	Event
+	UserEvent
+	MessageEvent
+	MessageID string `json:"messageId"`
+}
+
+// MessageDeletedEvent is sent by a user to delete one of their messages.
+type MessageDeletedEvent struct {
+	Event
+	MessageID string `json:"messageId"`
+}
+
+// MessageEdit is a previous version of an edited message.
+type MessageEdit struct {
+	EditedAt time.Time `json:"editedAt"`
+	Body     string    `json:"body"`
+}
+
+// GetBroadcastPayload will return the object to send to all chat users.
+func (e *MessageEditedEvent) GetBroadcastPayload() EventPayload {
+	return EventPayload{
+		"type":      MessageEdited,
+		"id":        e.ID,
+		"timestamp": e.Timestamp,
+		"user":      e.User,
+		"messageId": e.MessageID,
+		"body":      e.Body,
+	}
+}
+
+// GetMessageType will return the event type for this message.
+func (e *MessageEditedEvent) GetMessageType() EventType {
+	return MessageEdited
+}
@@ -0,0 +1,36 @@
+package events
+
+// MessageReactionEvent is sent by a user to add or remove an emoji reaction
+// on a chat message, and to all clients when a reaction changes.
+type MessageReactionEvent struct {
+	Event
+	UserEvent
+	MessageID string `json:"messageId"`
+	Emoji     string `json:"emoji"`
+	Removed   bool   `json:"removed"`
+}
+
+// MessageReactions is the summary of a single emoji reaction on a message.
+type MessageReactions struct {
+	Emoji   string   `json:"emoji"`
+	UserIDs []string `json:"userIds"`
+	Count   int      `json:"count"`
+}
+
+// GetBroadcastPayload will return the object to send to all chat users.
+func (e *MessageReactionEvent) GetBroadcastPayload() EventPayload {
+	return EventPayload{
+		"type":      MessageReaction,
+		"id":        e.ID,
+		"timestamp": e.Timestamp,
+		"user":      e.User,
+		"messageId": e.MessageID,
+		"emoji":     e.Emoji,
+		"removed":   e.Removed,
+	}
+}
+
+// GetMessageType will return the event type for this message.
+func (e *MessageReactionEvent) GetMessageType() EventType {
+	return MessageReaction
+}
@@ -0,0 +1,33 @@
+package events
+
+import "github.com/owncast/owncast/models"
+
+// PollEvent is sent to all clients when a poll starts, when its results
+// change and when it ends.
+type PollEvent struct {
+	Event
+	Poll models.Poll `json:"poll"`
+}
+
+// GetBroadcastPayload will return the object to send to all chat users.
+func (e *PollEvent) GetBroadcastPayload() EventPayload {
+	return EventPayload{
+		"type":      e.Type,
+		"id":        e.ID,
+		"timestamp": e.Timestamp,
+		"poll":      e.Poll,
+	}
+}
+
+// GetMessageType will return the event type for this message.
+func (e *PollEvent) GetMessageType() EventType {
+	return e.Type
+}
+
+// PollVoteEvent is sent by a user to vote for an option in the active poll.
+type PollVoteEvent struct {
+	Event
+	UserEvent
+	PollID string `json:"pollId"`
+	Option int    `json:"option"`
+}
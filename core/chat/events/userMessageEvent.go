@@ -1,10 +1,17 @@
 package events
 
+import "time"
+
 // UserMessageEvent is an inbound message from a user.
 type UserMessageEvent struct {
 	Event
 	UserEvent
 	MessageEvent
+	EditedAt    *time.Time         `json:"editedAt,omitempty"`
+	DeletedAt   *time.Time         `json:"deletedAt,omitempty"`
+	ReplyTo     string             `json:"replyTo,omitempty"`
+	Reactions   []MessageReactions `json:"reactions,omitempty"`
+	EditHistory []MessageEdit      `json:"editHistory,omitempty"`
 }
 
 // GetBroadcastPayload will return the object to send to all chat users.
@@ -16,6 +23,8 @@ func (e *UserMessageEvent) GetBroadcastPayload() EventPayload {
 		"user":      e.User,
 		"type":      MessageSent,
 		"visible":   e.HiddenAt == nil,
+		"replyTo":   e.ReplyTo,
+		"editedAt":  e.EditedAt,
 	}
 }
 
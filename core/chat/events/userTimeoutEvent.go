@@ -0,0 +1,35 @@
+package events
+
+import "time"
+
+// UserTimeoutEvent is the event sent to a user when they have been timed
+// out of chat, or when their timeout is removed.
+type UserTimeoutEvent struct {
+	Event
+	UserEvent
+	ExpiresAt time.Time `json:"expiresAt"`
+	Reason    string    `json:"reason"`
+}
+
+// GetBroadcastPayload will return the object to send to the timed out user.
+func (e *UserTimeoutEvent) GetBroadcastPayload() EventPayload {
+	remaining := time.Until(e.ExpiresAt).Seconds()
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return EventPayload{
+		"type":             UserTimedOut,
+		"id":               e.ID,
+		"timestamp":        e.Timestamp,
+		"user":             e.User,
+		"reason":           e.Reason,
+		"expiresAt":        e.ExpiresAt,
+		"remainingSeconds": int(remaining),
+	}
+}
+
+// GetMessageType will return the event type for this message.
+func (e *UserTimeoutEvent) GetMessageType() EventType {
+	return UserTimedOut
+}
@@ -0,0 +1,113 @@
+package chat
+
+import (
+	"time"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/chatfilterrepository"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
+	"github.com/owncast/owncast/persistence/configrepository"
+	log "github.com/sirupsen/logrus"
+)
+
+// ReloadChatFilterRules will start applying the latest enabled filter rules.
+func ReloadChatFilterRules() error {
+	chatFilterRepository := chatfilterrepository.Get()
+	rules, err := chatFilterRepository.GetEnabledRules()
+	if err != nil {
+		return err
+	}
+
+	filterRules := NewChatFilterRules(rules)
+
+	_server.mu.Lock()
+	_server.filterRules = filterRules
+	_server.mu.Unlock()
+
+	return nil
+}
+
+// pruneFilterRuleHistory will forget the messages the filter rules no
+// longer need to detect repeats.
+func pruneFilterRuleHistory() {
+	_server.mu.RLock()
+	filterRules := _server.filterRules
+	_server.mu.RUnlock()
+
+	if filterRules != nil {
+		filterRules.pruneRecentMessages(time.Now())
+	}
+}
+
+// passesFilterRules will apply the chat filter rules to a message and
+// perform the action of any rule it matches. It returns false when the
+// message should not be sent to chat.
+func (s *Server) passesFilterRules(c *Client, event *events.UserMessageEvent) bool {
+	if c.User.IsModerator() {
+		return true
+	}
+
+	s.mu.RLock()
+	filterRules := s.filterRules
+	s.mu.RUnlock()
+
+	if filterRules == nil {
+		return true
+	}
+
+	rule := filterRules.Evaluate(c.User.ID, event.RawBody)
+	if rule == nil {
+		return true
+	}
+
+	log.Debugln(logSanitize(c.User.DisplayName), "sent a message matching chat filter rule", rule.ID)
+
+	switch rule.Action {
+	case models.ChatFilterActionHide:
+		if configrepository.Get().GetChatReviewQueue().HoldFilterMatches {
+			s.holdMessage(event, "Message matched a chat filter rule.")
+		} else {
+			s.holdFilteredMessage(event)
+		}
+		s.sendActionToClient(c, "Your message has been hidden until a moderator reviews it.")
+	case models.ChatFilterActionTimeout:
+		duration := time.Duration(rule.TimeoutSeconds) * time.Second
+		if err := TimeoutUser(c.User.ID, duration, "Sent a message that is not allowed in this chat."); err != nil {
+			log.Errorln("error timing out user from chat filter rule", err)
+		}
+	default:
+		s.sendActionToClient(c, "Sorry, that message is not allowed in this chat.")
+	}
+
+	return false
+}
+
+// holdFilteredMessage will save a message as hidden and only send it to
+// moderators so it can be reviewed and made visible.
+func (s *Server) holdFilteredMessage(event *events.UserMessageEvent) {
+	hiddenAt := time.Now()
+	event.HiddenAt = &hiddenAt
+
+	chatMessageRepository := chatmessagerepository.Get()
+	chatMessageRepository.SaveUserMessage(*event)
+
+	payload := event.GetBroadcastPayload()
+	for _, client := range s.getModeratorClients() {
+		client.sendPayload(payload)
+	}
+}
+
+func (s *Server) getModeratorClients() []*Client {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	clients := []*Client{}
+	for _, client := range s.clients {
+		if client.User != nil && client.User.IsModerator() {
+			clients = append(clients, client)
+		}
+	}
+
+	return clients
+}
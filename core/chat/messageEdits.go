@@ -0,0 +1,153 @@
+package chat
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/owncast/owncast/config"
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/core/webhooks"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
+	log "github.com/sirupsen/logrus"
+)
+
+func (s *Server) messageEditReceived(eventData chatClientEvent) {
+	c := eventData.client
+
+	var event events.MessageEditedEvent
+	if err := json.Unmarshal(eventData.data, &event); err != nil {
+		log.Errorln("error unmarshalling to MessageEditedEvent", err)
+		return
+	}
+
+	if allowed, reason := canChangeMessage(c.User, event.MessageID); !allowed {
+		s.sendActionToClient(c, reason)
+		return
+	}
+
+	event.Event.SetDefaults()
+	event.RenderAndSanitizeMessageBody()
+	event.User = c.User
+
+	if event.Empty() {
+		s.sendActionToClient(c, "Sorry, a message cannot be edited to be empty. Delete it instead.")
+		return
+	}
+
+	if allowed, reason := s.passesEditRules(c, &event); !allowed {
+		s.sendActionToClient(c, reason)
+		return
+	}
+
+	chatMessageRepository := chatmessagerepository.Get()
+	if err := chatMessageRepository.EditMessage(event.MessageID, event.Body); err != nil {
+		log.Errorln("error saving edited message", err)
+		return
+	}
+
+	if err := s.Broadcast(event.GetBroadcastPayload()); err != nil {
+		log.Errorln("error broadcasting MessageEditedEvent payload", err)
+		return
+	}
+
+	webhooks.SendChatEventMessageEdited(event)
+}
+
+func (s *Server) messageDeleteReceived(eventData chatClientEvent) {
+	c := eventData.client
+
+	var event events.MessageDeletedEvent
+	if err := json.Unmarshal(eventData.data, &event); err != nil {
+		log.Errorln("error unmarshalling to MessageDeletedEvent", err)
+		return
+	}
+
+	if allowed, reason := canChangeMessage(c.User, event.MessageID); !allowed {
+		s.sendActionToClient(c, reason)
+		return
+	}
+
+	chatMessageRepository := chatmessagerepository.Get()
+	if err := chatMessageRepository.DeleteMessage(event.MessageID); err != nil {
+		log.Errorln("error deleting message", err)
+		return
+	}
+
+	// Deleted messages are hidden from chat the same way moderators hide them.
+	visibilityEvent := events.SetMessageVisibilityEvent{
+		MessageIDs: []string{event.MessageID},
+		Visible:    false,
+	}
+	visibilityEvent.Event.SetDefaults()
+
+	if err := s.Broadcast(visibilityEvent.GetBroadcastPayload()); err != nil {
+		log.Errorln("error broadcasting message visibility payload", err)
+		return
+	}
+
+	webhooks.SendChatEventSetMessageVisibility(visibilityEvent)
+}
+
+// canChangeMessage will test if a user is allowed to edit or delete a
+// message. If they are not, the returned string explains why.
+func canChangeMessage(u *models.User, messageID string) (bool, string) {
+	if u == nil {
+		return false, "You must be in chat to change a message."
+	}
+
+	chatMessageRepository := chatmessagerepository.Get()
+	message, err := chatMessageRepository.GetMessageByID(messageID)
+	if err != nil || message.User == nil || message.User.ID != u.ID {
+		return false, "Sorry, you can only change your own messages."
+	}
+
+	if message.HiddenAt != nil {
+		return false, "Sorry, that message can no longer be changed."
+	}
+
+	editWindow := config.GetDefaults().ChatMessageEditWindowDuration
+	if time.Since(message.Timestamp) > editWindow {
+		return false, fmt.Sprintf("Sorry, messages can only be changed within %s of sending them.", formatTimeoutDuration(editWindow))
+	}
+
+	return true, ""
+}
+
+// passesEditRules will test if an edited message is allowed under the
+// current chat modes and filter rules. If it is not, the returned string
+// explains why to the sender.
+func (s *Server) passesEditRules(c *Client, event *events.MessageEditedEvent) (bool, string) {
+	if c.User.IsModerator() {
+		return true, ""
+	}
+
+	if GetChatModes().EmoteOnly && !isEmoteOnly(event.Body) {
+		return false, "Chat is in emote only mode. Only emoji are allowed right now."
+	}
+
+	s.mu.RLock()
+	filterRules := s.filterRules
+	s.mu.RUnlock()
+
+	if filterRules == nil {
+		return true, ""
+	}
+
+	rule := filterRules.Evaluate(c.User.ID, event.RawBody)
+	if rule == nil {
+		return true, ""
+	}
+
+	log.Debugln(logSanitize(c.User.DisplayName), "edited a message to match chat filter rule", rule.ID)
+
+	if rule.Action == models.ChatFilterActionTimeout {
+		duration := time.Duration(rule.TimeoutSeconds) * time.Second
+		if err := TimeoutUser(c.User.ID, duration, "Sent a message that is not allowed in this chat."); err != nil {
+			log.Errorln("error timing out user from chat filter rule", err)
+		}
+	}
+
+	return false, "Sorry, that message is not allowed in this chat."
+}
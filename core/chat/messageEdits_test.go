@@ -0,0 +1,98 @@
+package chat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/config"
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
+)
+
+func TestCanChangeMessage(t *testing.T) {
+	owner := newTestUser(t, "message-owner")
+	other := newTestUser(t, "someone-else")
+	moderator, _ := newTestModerator(t, "edit-moderator")
+
+	recent := saveTestMessage(owner, "<p>recent</p>", time.Now())
+	old := saveTestMessage(owner, "<p>old</p>", time.Now().Add(-config.GetDefaults().ChatMessageEditWindowDuration-time.Minute))
+	hidden := saveTestMessage(owner, "<p>hidden</p>", time.Now())
+	if err := SetMessagesVisibility([]string{hidden}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if allowed, reason := canChangeMessage(owner, recent); !allowed {
+		t.Errorf("Expected a user to be able to change their own recent message. %s", reason)
+	}
+	if allowed, _ := canChangeMessage(other, recent); allowed {
+		t.Error("Expected a user to not be able to change someone else's message")
+	}
+	if allowed, _ := canChangeMessage(moderator, recent); allowed {
+		t.Error("Expected a moderator to not be able to change someone else's message")
+	}
+	if allowed, _ := canChangeMessage(owner, old); allowed {
+		t.Error("Expected a message outside of the edit window to not be changeable")
+	}
+	if allowed, _ := canChangeMessage(owner, hidden); allowed {
+		t.Error("Expected a hidden message to not be changeable")
+	}
+	if allowed, _ := canChangeMessage(owner, "not-a-message"); allowed {
+		t.Error("Expected a message that doesn't exist to not be changeable")
+	}
+	if allowed, _ := canChangeMessage(nil, recent); allowed {
+		t.Error("Expected a message to not be changeable without a user")
+	}
+}
+
+func TestEditMessage(t *testing.T) {
+	owner, ownerClient := newTestChatter(t, "editing-owner")
+	_, otherClient := newTestChatter(t, "editing-other")
+	messageID := saveTestMessage(owner, "<p>original</p>", time.Now())
+	chatMessageRepository := chatmessagerepository.Get()
+
+	sendTestEvent(t, otherClient, map[string]interface{}{"type": events.MessageEdited, "messageId": messageID, "body": "hijacked"})
+	if message, _ := chatMessageRepository.GetMessageByID(messageID); message == nil || message.Body != "<p>original</p>" {
+		t.Fatalf("Expected someone else's edit to be rejected but got %+v", message)
+	}
+	if edits := receivedEventsOfType(t, ownerClient, events.MessageEdited); len(edits) != 0 {
+		t.Errorf("Expected a rejected edit to not be sent to chat but got %v", edits)
+	}
+	if actions := receivedEventsOfType(t, otherClient, events.ChatActionSent); len(actions) != 1 {
+		t.Errorf("Expected the user to be told why their edit was rejected but got %v", actions)
+	}
+
+	sendTestEvent(t, ownerClient, map[string]interface{}{"type": events.MessageEdited, "messageId": messageID, "body": "edited"})
+	message, err := chatMessageRepository.GetMessageByID(messageID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message.Body != "<p>edited</p>" || message.EditedAt == nil {
+		t.Errorf("Expected the owner's edit to be saved but got %+v", message)
+	}
+	if edits := receivedEventsOfType(t, otherClient, events.MessageEdited); len(edits) != 1 || edits[0]["messageId"] != messageID {
+		t.Errorf("Expected the edit to be sent to chat but got %v", edits)
+	}
+}
+
+func TestDeleteMessage(t *testing.T) {
+	owner, ownerClient := newTestChatter(t, "deleting-owner")
+	_, otherClient := newTestChatter(t, "deleting-other")
+	messageID := saveTestMessage(owner, "<p>delete me</p>", time.Now())
+	chatMessageRepository := chatmessagerepository.Get()
+
+	sendTestEvent(t, otherClient, map[string]interface{}{"type": events.MessageDeleted, "messageId": messageID})
+	if message, _ := chatMessageRepository.GetMessageByID(messageID); message == nil || message.DeletedAt != nil {
+		t.Fatalf("Expected someone else's delete to be rejected but got %+v", message)
+	}
+	if updates := receivedEventsOfType(t, ownerClient, events.VisibiltyUpdate); len(updates) != 0 {
+		t.Errorf("Expected a rejected delete to not be sent to chat but got %v", updates)
+	}
+
+	sendTestEvent(t, ownerClient, map[string]interface{}{"type": events.MessageDeleted, "messageId": messageID})
+	if message, _ := chatMessageRepository.GetMessageByID(messageID); message == nil || message.DeletedAt == nil || message.HiddenAt == nil {
+		t.Errorf("Expected the owner's delete to hide the message but got %+v", message)
+	}
+	if updates := receivedEventsOfType(t, otherClient, events.VisibiltyUpdate); len(updates) != 1 {
+		t.Errorf("Expected the message to be hidden from chat but got %v", updates)
+	}
+}
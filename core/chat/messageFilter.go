@@ -1,9 +1,29 @@
 package chat
 
 import (
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
 	goaway "github.com/TwiN/go-away"
+	"github.com/owncast/owncast/models"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// The fewest letters a message needs before the caps ratio is tested.
+	minCapsRatioLetters = 10
+	// The fewest characters a message needs before the emoji ratio is tested.
+	minEmojiRatioCharacters = 5
+	// How long sent messages are remembered for repeated message detection.
+	repeatedMessageWindow = 2 * time.Minute
 )
 
+var _linkMatch = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"'()]+`)
+
 // ChatMessageFilter is a allow/deny chat message filter.
 type ChatMessageFilter struct{}
 
@@ -16,3 +36,229 @@ func NewMessageFilter() *ChatMessageFilter {
 func (*ChatMessageFilter) Allow(message string) bool {
 	return !goaway.IsProfane(message)
 }
+
+// ChatFilterRules tests chat messages against the admin managed filter rules.
+type ChatFilterRules struct {
+	recentMessages map[string][]sentMessage
+	rules          []compiledFilterRule
+	mu             sync.Mutex
+	tracksRepeats  bool
+}
+
+type compiledFilterRule struct {
+	pattern *regexp.Regexp
+	domains []string
+	models.ChatFilterRule
+}
+
+type sentMessage struct {
+	sentAt time.Time
+	text   string
+}
+
+// NewChatFilterRules will return a filter that applies the provided rules.
+// Rules that cannot be applied are skipped.
+func NewChatFilterRules(rules []models.ChatFilterRule) *ChatFilterRules {
+	f := &ChatFilterRules{
+		recentMessages: map[string][]sentMessage{},
+	}
+
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			log.Warnln("skipping chat filter rule", rule.ID, err)
+			continue
+		}
+
+		compiled := compiledFilterRule{ChatFilterRule: rule}
+
+		switch rule.Type {
+		case models.ChatFilterBlockedWord:
+			compiled.pattern = regexp.MustCompile(`(?i)(^|\W)` + regexp.QuoteMeta(strings.TrimSpace(rule.Value)) + `($|\W)`)
+		case models.ChatFilterPattern:
+			compiled.pattern = regexp.MustCompile(rule.Value)
+		case models.ChatFilterBlockedLinks, models.ChatFilterAllowedLinks:
+			compiled.domains = splitDomains(rule.Value)
+		case models.ChatFilterRepeatedMessage:
+			f.tracksRepeats = true
+		}
+
+		f.rules = append(f.rules, compiled)
+	}
+
+	return f
+}
+
+// Evaluate will return the first rule the message from a user matches, or
+// nil if the message is allowed.
+func (f *ChatFilterRules) Evaluate(userID string, message string) *models.ChatFilterRule {
+	repeatCount := 0
+	if f.tracksRepeats {
+		repeatCount = f.recordMessage(userID, message)
+	}
+
+	for i := range f.rules {
+		rule := &f.rules[i]
+		if rule.matches(message, repeatCount) {
+			return &rule.ChatFilterRule
+		}
+	}
+
+	return nil
+}
+
+// recordMessage will remember a message sent by a user and return how many
+// times they have sent it within the repeated message window.
+func (f *ChatFilterRules) recordMessage(userID string, message string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	text := strings.Join(strings.Fields(strings.ToLower(message)), " ")
+	now := time.Now()
+
+	recent := make([]sentMessage, 0, len(f.recentMessages[userID])+1)
+	count := 1
+	for _, m := range f.recentMessages[userID] {
+		if now.Sub(m.sentAt) > repeatedMessageWindow {
+			continue
+		}
+		if m.text == text {
+			count++
+		}
+		recent = append(recent, m)
+	}
+
+	f.recentMessages[userID] = append(recent, sentMessage{sentAt: now, text: text})
+
+	return count
+}
+
+// pruneRecentMessages will forget messages older than the repeated message
+// window, including those of users who have stopped chatting.
+func (f *ChatFilterRules) pruneRecentMessages(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for userID, messages := range f.recentMessages {
+		recent := messages[:0]
+		for _, m := range messages {
+			if now.Sub(m.sentAt) <= repeatedMessageWindow {
+				recent = append(recent, m)
+			}
+		}
+
+		if len(recent) == 0 {
+			delete(f.recentMessages, userID)
+		} else {
+			f.recentMessages[userID] = recent
+		}
+	}
+}
+
+func (r *compiledFilterRule) matches(message string, repeatCount int) bool {
+	switch r.Type {
+	case models.ChatFilterBlockedWord, models.ChatFilterPattern:
+		return r.pattern.MatchString(message)
+	case models.ChatFilterBlockedLinks:
+		for _, host := range linkHosts(message) {
+			if len(r.domains) == 0 || hostInDomains(host, r.domains) {
+				return true
+			}
+		}
+	case models.ChatFilterAllowedLinks:
+		for _, host := range linkHosts(message) {
+			if !hostInDomains(host, r.domains) {
+				return true
+			}
+		}
+	case models.ChatFilterCapsRatio:
+		return capsRatio(message) >= r.Threshold
+	case models.ChatFilterEmojiRatio:
+		return emojiRatio(message) >= r.Threshold
+	case models.ChatFilterRepeatedMessage:
+		return float64(repeatCount) >= r.Threshold
+	}
+
+	return false
+}
+
+func splitDomains(value string) []string {
+	domains := []string{}
+	for _, d := range strings.Split(value, ",") {
+		if d = strings.ToLower(strings.TrimSpace(d)); d != "" {
+			domains = append(domains, d)
+		}
+	}
+	return domains
+}
+
+// linkHosts will return the host names of all the links in a message.
+func linkHosts(message string) []string {
+	hosts := []string{}
+	for _, link := range _linkMatch.FindAllString(message, -1) {
+		if !strings.Contains(strings.ToLower(link), "://") {
+			link = "http://" + link
+		}
+
+		u, err := url.Parse(link)
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+		hosts = append(hosts, strings.ToLower(u.Hostname()))
+	}
+	return hosts
+}
+
+// hostInDomains will return if a host is one of the domains, or a
+// subdomain of one of them.
+func hostInDomains(host string, domains []string) bool {
+	for _, d := range domains {
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+func capsRatio(message string) float64 {
+	letters, upper := 0, 0
+	for _, r := range message {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		if unicode.IsUpper(r) {
+			upper++
+		}
+	}
+
+	if letters < minCapsRatioLetters {
+		return 0
+	}
+
+	return float64(upper) / float64(letters)
+}
+
+func emojiRatio(message string) float64 {
+	emoji := 0
+	text := _emojiImageTagMatch.ReplaceAllStringFunc(message, func(string) string {
+		emoji++
+		return ""
+	})
+
+	characters := emoji
+	for _, r := range text {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		characters++
+		if isEmojiRune(r) {
+			emoji++
+		}
+	}
+
+	if characters < minEmojiRatioCharacters {
+		return 0
+	}
+
+	return float64(emoji) / float64(characters)
+}
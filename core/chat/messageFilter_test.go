@@ -2,6 +2,9 @@ package chat
 
 import (
 	"testing"
+	"time"
+
+	"github.com/owncast/owncast/models"
 )
 
 func TestFiltering(t *testing.T) {
@@ -37,3 +40,121 @@ func TestFiltering(t *testing.T) {
 		}
 	}
 }
+
+func TestFilterRules(t *testing.T) {
+	rules := NewChatFilterRules([]models.ChatFilterRule{
+		{ID: 1, Type: models.ChatFilterBlockedWord, Value: "spoiler", Action: models.ChatFilterActionDrop},
+		{ID: 2, Type: models.ChatFilterPattern, Value: `(?i)buy\s+followers`, Action: models.ChatFilterActionHide},
+		{ID: 3, Type: models.ChatFilterBlockedLinks, Value: "spam.example, bad.example", Action: models.ChatFilterActionTimeout, TimeoutSeconds: 60},
+		{ID: 4, Type: models.ChatFilterCapsRatio, Threshold: 0.8, Action: models.ChatFilterActionDrop},
+		{ID: 5, Type: models.ChatFilterEmojiRatio, Threshold: 0.9, Action: models.ChatFilterActionDrop},
+	})
+
+	filteredTestMessages := map[string]int{
+		"no SPOILER please":                  1,
+		"spoiler":                            1,
+		"Buy   followers now":                2,
+		"check out https://spam.example/win": 3,
+		"www.cdn.bad.example/free":           3,
+		"WHY IS NOBODY TALKING":              4,
+		"😀😀😀😀😀😀":                             5,
+	}
+
+	unfilteredTestMessages := []string{
+		"spoilers are fine",
+		"I will not buy anything",
+		"check out https://owncast.online",
+		"LOL that was great",
+		"nice 😀 stream everybody",
+	}
+
+	for m, expectedRuleID := range filteredTestMessages {
+		rule := rules.Evaluate("user", m)
+		if rule == nil {
+			t.Errorf("%s should be filtered by rule %d", m, expectedRuleID)
+		} else if rule.ID != expectedRuleID {
+			t.Errorf("%s was filtered by rule %d, expected rule %d", m, rule.ID, expectedRuleID)
+		}
+	}
+
+	for _, m := range unfilteredTestMessages {
+		if rule := rules.Evaluate("user", m); rule != nil {
+			t.Errorf("%s should not be filtered but matched rule %d", m, rule.ID)
+		}
+	}
+}
+
+func TestAllowedLinksFilterRule(t *testing.T) {
+	rules := NewChatFilterRules([]models.ChatFilterRule{
+		{ID: 1, Type: models.ChatFilterAllowedLinks, Value: "owncast.online", Action: models.ChatFilterActionDrop},
+	})
+
+	if rule := rules.Evaluate("user", "read https://docs.owncast.online/setup"); rule != nil {
+		t.Error("links to allowed domains should not be filtered")
+	}
+
+	if rule := rules.Evaluate("user", "read https://example.com/setup"); rule == nil {
+		t.Error("links to other domains should be filtered")
+	}
+}
+
+func TestRepeatedMessageFilterRule(t *testing.T) {
+	rules := NewChatFilterRules([]models.ChatFilterRule{
+		{ID: 1, Type: models.ChatFilterRepeatedMessage, Threshold: 3, Action: models.ChatFilterActionDrop},
+	})
+
+	for i := 0; i < 2; i++ {
+		if rule := rules.Evaluate("user", "Hello   world"); rule != nil {
+			t.Errorf("message %d should not be seen as repeated", i+1)
+		}
+	}
+
+	if rule := rules.Evaluate("another user", "hello world"); rule != nil {
+		t.Error("messages from other users should not count as repeats")
+	}
+
+	if rule := rules.Evaluate("user", "hello world"); rule == nil {
+		t.Error("third identical message should be seen as repeated")
+	}
+}
+
+func TestInvalidFilterRulesAreSkipped(t *testing.T) {
+	rules := NewChatFilterRules([]models.ChatFilterRule{
+		{ID: 1, Type: models.ChatFilterPattern, Value: "(unclosed", Action: models.ChatFilterActionDrop},
+		{ID: 2, Type: models.ChatFilterBlockedWord, Value: "word", Action: "EXPLODE"},
+		{ID: 3, Type: models.ChatFilterBlockedWord, Value: "word", Action: models.ChatFilterActionTimeout},
+		{ID: 4, Type: models.ChatFilterBlockedWord, Value: "word", Action: models.ChatFilterActionTimeout, TimeoutSeconds: int(maxTimeoutDuration.Seconds()) + 1},
+		{ID: 5, Type: models.ChatFilterBlockedWord, Value: "   ", Action: models.ChatFilterActionDrop},
+	})
+
+	if rule := rules.Evaluate("user", "(unclosed word"); rule != nil {
+		t.Errorf("invalid rule %d should have been skipped", rule.ID)
+	}
+
+	if rule := rules.Evaluate("user", "two  spaces"); rule != nil {
+		t.Errorf("invalid rule %d should have been skipped", rule.ID)
+	}
+}
+
+func TestRepeatedMessagesArePruned(t *testing.T) {
+	rules := NewChatFilterRules([]models.ChatFilterRule{
+		{ID: 1, Type: models.ChatFilterRepeatedMessage, Threshold: 2, Action: models.ChatFilterActionDrop},
+	})
+
+	rules.Evaluate("user", "hello")
+	rules.Evaluate("another user", "hello")
+
+	rules.pruneRecentMessages(time.Now())
+	if len(rules.recentMessages) != 2 {
+		t.Errorf("recent messages should be kept, but %d users remain", len(rules.recentMessages))
+	}
+
+	rules.pruneRecentMessages(time.Now().Add(repeatedMessageWindow + time.Second))
+	if len(rules.recentMessages) != 0 {
+		t.Errorf("old messages should be pruned, but %d users remain", len(rules.recentMessages))
+	}
+
+	if rule := rules.Evaluate("user", "hello"); rule != nil {
+		t.Error("a pruned message should not count as a repeat")
+	}
+}
@@ -0,0 +1,168 @@
+package chat
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"math"
+	"regexp"
+	"time"
+	"unicode"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/configrepository"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxSlowModeSeconds is the longest slow mode interval that can be set.
+const maxSlowModeSeconds = 3600
+
+var (
+	_emojiImageTagMatch = regexp.MustCompile(`<img[^>]*class="emoji"[^>]*>`)
+	_htmlTagMatch       = regexp.MustCompile(`<[^>]*>`)
+)
+
+// GetChatModes will return the chat modes currently applied.
+func GetChatModes() models.ChatModes {
+	configRepository := configrepository.Get()
+	return configRepository.GetChatModes()
+}
+
+// SetChatModes will save new chat modes and let all the connected clients know.
+func SetChatModes(modes models.ChatModes) error {
+	if modes.SlowModeSeconds < 0 || modes.SlowModeSeconds > maxSlowModeSeconds {
+		return fmt.Errorf("slow mode must be between 0 and %d seconds", maxSlowModeSeconds)
+	}
+
+	configRepository := configrepository.Get()
+	if err := configRepository.SetChatModes(modes); err != nil {
+		return err
+	}
+
+	event := events.ChatModesEvent{ChatModes: modes}
+	event.SetDefaults()
+
+	return _server.Broadcast(event.GetBroadcastPayload())
+}
+
+// ResetChatModes will turn off all chat modes. Modes only apply to a
+// single broadcast so they are reset when the stream ends.
+func ResetChatModes() {
+	_server.mu.Lock()
+	_server.lastMessageTimes = map[string]time.Time{}
+	_server.mu.Unlock()
+
+	if GetChatModes() == (models.ChatModes{}) {
+		return
+	}
+
+	if err := SetChatModes(models.ChatModes{}); err != nil {
+		log.Errorln("error resetting chat modes", err)
+	}
+}
+
+func (s *Server) chatModesUpdated(eventData chatClientEvent) {
+	if !eventData.client.User.IsModerator() {
+		log.Debugln(logSanitize(eventData.client.User.DisplayName), "attempted to change chat modes without moderator access")
+		return
+	}
+
+	var receivedEvent events.ChatModesEvent
+	if err := json.Unmarshal(eventData.data, &receivedEvent); err != nil {
+		log.Errorln("error unmarshalling to ChatModesEvent", err)
+		return
+	}
+
+	if err := SetChatModes(receivedEvent.ChatModes); err != nil {
+		s.sendActionToClient(eventData.client, err.Error())
+	}
+}
+
+// passesChatModes will test if a message is allowed under the current chat
+// modes. If it is not, the returned string explains why to the sender.
+func (s *Server) passesChatModes(c *Client, event *events.UserMessageEvent) (bool, string) {
+	if c.User.IsModerator() {
+		return true, ""
+	}
+
+	modes := GetChatModes()
+
+	if modes.AuthenticatedOnly && !c.User.Authenticated {
+		return false, "Chat is in authenticated users only mode. Please authenticate to take part in chat."
+	}
+
+	if modes.EmoteOnly && !isEmoteOnly(event.Body) {
+		return false, "Chat is in emote only mode. Only emoji are allowed right now."
+	}
+
+	if modes.SlowModeSeconds > 0 {
+		s.mu.RLock()
+		lastMessageTime := s.lastMessageTimes[c.User.ID]
+		s.mu.RUnlock()
+
+		interval := time.Duration(modes.SlowModeSeconds) * time.Second
+		if remaining := interval - time.Since(lastMessageTime); remaining > 0 {
+			seconds := int(math.Ceil(remaining.Seconds()))
+			return false, fmt.Sprintf("Slow mode is enabled. You can send another message in %d seconds.", seconds)
+		}
+	}
+
+	return true, ""
+}
+
+// recordMessageSent will start a user's slow mode interval once their
+// message has been sent. Times are only kept while slow mode is enabled.
+func (s *Server) recordMessageSent(userID string) {
+	if GetChatModes().SlowModeSeconds == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	s.lastMessageTimes[userID] = time.Now()
+	s.mu.Unlock()
+}
+
+func (c *Client) sendChatModes(modes models.ChatModes) {
+	event := events.ChatModesEvent{ChatModes: modes}
+	event.SetDefaults()
+	c.sendPayload(event.GetBroadcastPayload())
+}
+
+// isEmoteOnly will return if a rendered message body is made up of nothing
+// but custom emoji images and unicode emoji.
+func isEmoteOnly(body string) bool {
+	hasEmoji := false
+
+	text := _emojiImageTagMatch.ReplaceAllStringFunc(body, func(string) string {
+		hasEmoji = true
+		return ""
+	})
+	text = html.UnescapeString(_htmlTagMatch.ReplaceAllString(text, ""))
+
+	for _, r := range text {
+		switch {
+		case unicode.IsSpace(r):
+			continue
+		case isEmojiRune(r):
+			hasEmoji = true
+		default:
+			return false
+		}
+	}
+
+	return hasEmoji
+}
+
+func isEmojiRune(r rune) bool {
+	switch {
+	case unicode.Is(unicode.So, r):
+		return true
+	case r >= 0x1F3FB && r <= 0x1F3FF: // Skin tone modifiers
+		return true
+	case r == 0x200D || r == 0xFE0F || r == 0x20E3: // Joiners, variation selector and keycaps
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,90 @@
+package chat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/userrepository"
+)
+
+func TestEmoteOnly(t *testing.T) {
+	emoteOnlyMessages := []string{
+		"<p>😀</p>",
+		"<p>👍🏽 🎉</p>",
+		"<p>👨‍👩‍👧</p>",
+		`<p><img src="/img/emoji/blob/party.gif" class="emoji" alt=":party:" title=":party:"></p>`,
+		`<p><img src="/img/emoji/blob/party.gif" class="emoji" alt=":party:" title=":party:"> ❤️</p>`,
+	}
+
+	nonEmoteOnlyMessages := []string{
+		"<p>hello 😀</p>",
+		"<p></p>",
+		"<p>   </p>",
+		`<p><img src="/img/emoji/blob/party.gif" class="emoji" alt=":party:" title=":party:"> party</p>`,
+		"<p>&lt;3</p>",
+	}
+
+	for _, m := range emoteOnlyMessages {
+		if !isEmoteOnly(m) {
+			t.Errorf("%s should be seen as an emote only message", m)
+		}
+	}
+
+	for _, m := range nonEmoteOnlyMessages {
+		if isEmoteOnly(m) {
+			t.Errorf("%s should not be seen as an emote only message", m)
+		}
+	}
+}
+
+func TestSlowModeStartsWhenMessageIsSent(t *testing.T) {
+	user, token, err := userrepository.Get().CreateAnonymousUser("slow-mode-user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newTestClient(t, user)
+	client.accessToken = token
+
+	_server.mu.Lock()
+	_server.filterRules = NewChatFilterRules([]models.ChatFilterRule{
+		{ID: 1, Type: models.ChatFilterBlockedWord, Value: "blocked", Action: models.ChatFilterActionDrop},
+	})
+	_server.mu.Unlock()
+
+	t.Cleanup(func() {
+		_server.mu.Lock()
+		_server.filterRules = nil
+		_server.mu.Unlock()
+		ResetChatModes()
+	})
+
+	if err := SetChatModes(models.ChatModes{SlowModeSeconds: 60}); err != nil {
+		t.Fatal(err)
+	}
+
+	send := func(body string) {
+		data, _ := json.Marshal(map[string]string{"type": events.MessageSent, "body": body})
+		_server.userMessageSent(chatClientEvent{client: client, data: data})
+	}
+
+	send("this is blocked")
+	if allowed, _ := _server.passesChatModes(client, &events.UserMessageEvent{}); !allowed {
+		t.Error("a message rejected by a filter rule should not start slow mode")
+	}
+
+	send("hello")
+	if allowed, _ := _server.passesChatModes(client, &events.UserMessageEvent{}); allowed {
+		t.Error("a sent message should start slow mode")
+	}
+
+	ResetChatModes()
+
+	_server.mu.RLock()
+	remaining := len(_server.lastMessageTimes)
+	_server.mu.RUnlock()
+	if remaining != 0 {
+		t.Errorf("resetting chat modes should forget when messages were sent, but %d remain", remaining)
+	}
+}
@@ -5,18 +5,30 @@ import (
 
 	"github.com/owncast/owncast/core/data"
 	"github.com/owncast/owncast/persistence/authrepository"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
 	"github.com/owncast/owncast/persistence/tables"
+	log "github.com/sirupsen/logrus"
 )
 
 var _datastore *data.Datastore
 
-const (
-	maxBacklogHours = 2 // Keep backlog max hours worth of messages
-)
-
 func setupPersistence() {
 	_datastore = data.GetDatastore()
 	tables.CreateMessagesTable(_datastore.DB)
+	tables.CreateMessageReactionsTable(_datastore.DB)
+	tables.CreateMessageEditsTable(_datastore.DB)
+	tables.CreateDirectMessagesTable(_datastore.DB)
+	tables.CreateChatFilterRulesTable(_datastore.DB)
+	tables.CreateChatArchivesTable(_datastore.DB)
+	tables.CreatePollsTables(_datastore.DB)
+
+	// The search index requires SQLite to be built with FTS5. Without it,
+	// searching chat falls back to slower pattern matching.
+	if err := tables.CreateMessagesSearchIndex(_datastore.DB); err != nil {
+		log.Warnln("Chat messages will be searched without a full-text index:", err)
+	} else if err := chatmessagerepository.Get().EnableSearchIndex(); err != nil {
+		log.Errorln("error building chat search index", err)
+	}
 
 	authRepository := authrepository.Get()
 	authRepository.CreateBanIPTable(_datastore.DB)
@@ -24,8 +36,15 @@ func setupPersistence() {
 	chatDataPruner := time.NewTicker(5 * time.Minute)
 	go func() {
 		runPruner()
+		pruneSearchIndex()
+		pruneDirectMessages()
+		pruneExpiredTimeouts()
 		for range chatDataPruner.C {
 			runPruner()
+			pruneSearchIndex()
+			pruneDirectMessages()
+			pruneExpiredTimeouts()
+			pruneFilterRuleHistory()
 		}
 	}()
 }
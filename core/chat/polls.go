@@ -0,0 +1,257 @@
+package chat
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/core/webhooks"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/pollrepository"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/teris-io/shortid"
+)
+
+const (
+	minPollOptions        = 2
+	maxPollOptions        = 10
+	maxPollQuestionLength = 300
+	maxPollOptionLength   = 100
+	minPollDuration       = 10 * time.Second
+	maxPollDuration       = 24 * time.Hour
+	// The most often the live results of a poll are sent to chat.
+	pollUpdateInterval = time.Second
+)
+
+var (
+	pollLock            sync.Mutex
+	pollEndTimer        *time.Timer
+	pollUpdateScheduled bool
+)
+
+// StartPoll will start a new poll in chat. Only one poll can run at a time.
+func StartPoll(question string, options []string, duration time.Duration, actor models.ModerationActor) (*models.Poll, error) {
+	question = strings.TrimSpace(question)
+	if question == "" || len(question) > maxPollQuestionLength {
+		return nil, fmt.Errorf("a poll question must be between 1 and %d characters", maxPollQuestionLength)
+	}
+
+	if len(options) < minPollOptions || len(options) > maxPollOptions {
+		return nil, fmt.Errorf("a poll must have between %d and %d options", minPollOptions, maxPollOptions)
+	}
+
+	if duration < minPollDuration || duration > maxPollDuration {
+		return nil, fmt.Errorf("a poll must run for between %s and %s", minPollDuration, maxPollDuration)
+	}
+
+	pollOptions := make([]models.PollOption, len(options))
+	for i, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" || len(option) > maxPollOptionLength {
+			return nil, fmt.Errorf("poll options must be between 1 and %d characters", maxPollOptionLength)
+		}
+		pollOptions[i] = models.PollOption{Text: option}
+	}
+
+	pollLock.Lock()
+	defer pollLock.Unlock()
+
+	pollRepository := pollrepository.Get()
+	active, err := pollRepository.GetActivePoll()
+	if err != nil {
+		return nil, err
+	}
+	if active != nil {
+		return nil, errors.New("a poll is already running")
+	}
+
+	now := time.Now()
+	poll := models.Poll{
+		ID:            shortid.MustGenerate(),
+		Question:      question,
+		Options:       pollOptions,
+		CreatedByType: actor.Type,
+		CreatedByID:   actor.ID,
+		CreatedByName: actor.Name,
+		StartedAt:     now,
+		EndsAt:        now.Add(duration),
+	}
+
+	if err := pollRepository.CreatePoll(poll); err != nil {
+		return nil, err
+	}
+
+	schedulePollEnd(poll)
+	broadcastPoll(events.PollStarted, poll)
+	go webhooks.SendChatEventPoll(models.PollStarted, poll)
+
+	return &poll, nil
+}
+
+// EndPoll will stop a poll from accepting votes and send its results.
+func EndPoll(pollID string) (*models.Poll, error) {
+	pollLock.Lock()
+	defer pollLock.Unlock()
+
+	return endPoll(pollID)
+}
+
+func endPoll(pollID string) (*models.Poll, error) {
+	pollRepository := pollrepository.Get()
+	poll, err := pollRepository.GetPoll(pollID)
+	if err != nil {
+		return nil, err
+	}
+	if poll == nil {
+		return nil, errors.New("poll not found")
+	}
+	if !poll.IsActive() {
+		return nil, errors.New("poll has already ended")
+	}
+
+	if pollEndTimer != nil {
+		pollEndTimer.Stop()
+		pollEndTimer = nil
+	}
+
+	now := time.Now()
+	if err := pollRepository.EndPoll(poll.ID, now); err != nil {
+		return nil, err
+	}
+	poll.EndedAt = &now
+
+	broadcastPoll(events.PollEnded, *poll)
+	go webhooks.SendChatEventPoll(models.PollEnded, *poll)
+
+	return poll, nil
+}
+
+// GetActivePoll will return the poll currently accepting votes, or nil if
+// there isn't one.
+func GetActivePoll() *models.Poll {
+	poll, err := pollrepository.Get().GetActivePoll()
+	if err != nil {
+		log.Errorln("error fetching active poll", err)
+		return nil
+	}
+
+	return poll
+}
+
+// resumeActivePoll will schedule the end of a poll that was running when
+// the server stopped.
+func resumeActivePoll() {
+	pollLock.Lock()
+	defer pollLock.Unlock()
+
+	if poll := GetActivePoll(); poll != nil {
+		schedulePollEnd(*poll)
+	}
+}
+
+// schedulePollEnd must be called with the pollLock held.
+func schedulePollEnd(poll models.Poll) {
+	if pollEndTimer != nil {
+		pollEndTimer.Stop()
+	}
+
+	pollEndTimer = time.AfterFunc(time.Until(poll.EndsAt), func() {
+		pollLock.Lock()
+		defer pollLock.Unlock()
+
+		if _, err := endPoll(poll.ID); err != nil {
+			log.Debugln("unable to end poll", poll.ID, err)
+		}
+	})
+}
+
+func (s *Server) pollVoteReceived(eventData chatClientEvent) {
+	c := eventData.client
+
+	var event events.PollVoteEvent
+	if err := json.Unmarshal(eventData.data, &event); err != nil {
+		log.Errorln("error unmarshalling to PollVoteEvent", err)
+		return
+	}
+
+	if c.User == nil {
+		return
+	}
+
+	if GetChatModes().AuthenticatedOnly && !c.User.Authenticated && !c.User.IsModerator() {
+		s.sendActionToClient(c, "Chat is in authenticated users only mode. Please authenticate to vote.")
+		return
+	}
+
+	poll := GetActivePoll()
+	if poll == nil || poll.ID != event.PollID || time.Now().After(poll.EndsAt) {
+		s.sendActionToClient(c, "Sorry, this poll is no longer accepting votes.")
+		return
+	}
+
+	if event.Option < 0 || event.Option >= len(poll.Options) {
+		s.sendActionToClient(c, "Sorry, that is not an option in this poll.")
+		return
+	}
+
+	added, err := pollrepository.Get().AddVote(poll.ID, c.User.ID, event.Option)
+	if err != nil {
+		log.Errorln("error saving poll vote", err)
+		return
+	}
+	if !added {
+		s.sendActionToClient(c, "You have already voted in this poll.")
+		return
+	}
+
+	schedulePollUpdate()
+}
+
+// schedulePollUpdate will send the live results of the active poll to chat,
+// batching votes that arrive close together into a single update.
+func schedulePollUpdate() {
+	pollLock.Lock()
+	defer pollLock.Unlock()
+
+	if pollUpdateScheduled {
+		return
+	}
+	pollUpdateScheduled = true
+
+	time.AfterFunc(pollUpdateInterval, func() {
+		pollLock.Lock()
+		defer pollLock.Unlock()
+
+		pollUpdateScheduled = false
+		if poll := GetActivePoll(); poll != nil {
+			broadcastPoll(events.PollUpdated, *poll)
+		}
+	})
+}
+
+func broadcastPoll(eventType events.EventType, poll models.Poll) {
+	event := events.PollEvent{Poll: poll}
+	event.SetDefaults()
+	event.Type = eventType
+
+	if err := _server.Broadcast(event.GetBroadcastPayload()); err != nil {
+		log.Errorln("error broadcasting PollEvent payload", err)
+	}
+}
+
+// sendActivePoll will let a client know about the poll currently running.
+func (c *Client) sendActivePoll() {
+	poll := GetActivePoll()
+	if poll == nil {
+		return
+	}
+
+	event := events.PollEvent{Poll: *poll}
+	event.SetDefaults()
+	event.Type = events.PollUpdated
+	c.sendPayload(event.GetBroadcastPayload())
+}
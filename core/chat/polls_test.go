@@ -0,0 +1,35 @@
+package chat
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/models"
+)
+
+func TestStartPollValidation(t *testing.T) {
+	actor := models.ModerationActor{Type: models.ModerationActorAdmin, ID: "admin", Name: "admin"}
+	options := []string{"yes", "no"}
+
+	invalid := map[string]struct {
+		question string
+		options  []string
+		duration time.Duration
+	}{
+		"empty question":     {" ", options, time.Minute},
+		"long question":      {strings.Repeat("a", maxPollQuestionLength+1), options, time.Minute},
+		"one option":         {"question", []string{"yes"}, time.Minute},
+		"too many options":   {"question", strings.Split(strings.Repeat("a,", maxPollOptions), ","), time.Minute},
+		"empty option":       {"question", []string{"yes", " "}, time.Minute},
+		"long option":        {"question", []string{"yes", strings.Repeat("a", maxPollOptionLength+1)}, time.Minute},
+		"too short duration": {"question", options, time.Second},
+		"too long duration":  {"question", options, 48 * time.Hour},
+	}
+
+	for name, poll := range invalid {
+		if _, err := StartPoll(poll.question, poll.options, poll.duration, actor); err == nil {
+			t.Errorf("%s should not be able to start a poll", name)
+		}
+	}
+}
@@ -1,34 +1,67 @@
 package chat
 
 import (
-	"fmt"
+	"time"
 
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
+	"github.com/owncast/owncast/persistence/configrepository"
 	log "github.com/sirupsen/logrus"
 )
 
 // Only keep recent messages so we don't keep more chat data than needed
 // for privacy and efficiency reasons.
 func runPruner() {
+	retention := configrepository.Get().GetChatRetention()
+	if retention.Mode == models.ChatRetentionForever {
+		return
+	}
+
+	// Messages of the current broadcast are kept until it is archived.
+	keepSince := time.Now()
+	if retention.ArchiveBroadcasts {
+		if status := getStatus(); status.Online && status.LastConnectTime != nil {
+			keepSince = status.LastConnectTime.Time
+		}
+	}
+
 	_datastore.DbLock.Lock()
 	defer _datastore.DbLock.Unlock()
 
-	log.Traceln("Removing chat messages older than", maxBacklogHours, "hours")
-
-	deleteStatement := `DELETE FROM messages WHERE timestamp <= datetime('now', 'localtime', ?)`
 	tx, err := _datastore.DB.Begin()
 	if err != nil {
 		log.Debugln(err)
 		return
 	}
+	defer tx.Rollback() // nolint
 
-	stmt, err := tx.Prepare(deleteStatement)
+	switch retention.Mode {
+	case models.ChatRetentionMessages:
+		log.Traceln("Removing all but the", retention.Messages, "most recent chat messages")
+		// Only chat messages are counted. Everything from the oldest of them
+		// on is kept, including the joins and other events between them.
+		_, err = tx.Exec(`DELETE FROM messages WHERE timestamp < ? AND timestamp < (SELECT timestamp FROM messages WHERE eventType IN (?, ?) ORDER BY timestamp DESC LIMIT 1 OFFSET ?)`,
+			keepSince, events.MessageSent, events.SystemMessageSent, retention.Messages-1)
+	default:
+		log.Traceln("Removing chat messages older than", retention.Hours, "hours")
+		before := time.Now().Add(-time.Duration(retention.Hours) * time.Hour)
+		if keepSince.Before(before) {
+			before = keepSince
+		}
+		_, err = tx.Exec(`DELETE FROM messages WHERE timestamp <= ?`, before)
+	}
 	if err != nil {
 		log.Debugln(err)
 		return
 	}
-	defer stmt.Close()
 
-	if _, err = stmt.Exec(fmt.Sprintf("-%d hours", maxBacklogHours)); err != nil {
+	// Remove the reactions to and edits of the messages that were removed.
+	if _, err = tx.Exec(`DELETE FROM message_reactions WHERE message_id NOT IN (SELECT id FROM messages)`); err != nil {
+		log.Debugln(err)
+		return
+	}
+	if _, err = tx.Exec(`DELETE FROM message_edits WHERE message_id NOT IN (SELECT id FROM messages)`); err != nil {
 		log.Debugln(err)
 		return
 	}
@@ -37,3 +70,11 @@ func runPruner() {
 		return
 	}
 }
+
+// pruneSearchIndex will remove pruned messages from the search index.
+func pruneSearchIndex() {
+	chatMessageRepository := chatmessagerepository.Get()
+	if err := chatMessageRepository.PruneSearchIndex(); err != nil {
+		log.Debugln(err)
+	}
+}
@@ -0,0 +1,120 @@
+package chat
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/owncast/owncast/persistence/tables"
+)
+
+// useTestMessagesTable will point the pruner at an empty messages table
+// for the rest of a test, so it doesn't remove other tests' messages.
+func useTestMessagesTable(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each connection to an in-memory database is a different database.
+	db.SetMaxOpenConns(1)
+
+	tables.CreateMessagesTable(db)
+	tables.CreateMessageReactionsTable(db)
+	tables.CreateMessageEditsTable(db)
+
+	datastore := _datastore
+	_datastore = &data.Datastore{DB: db, DbLock: &sync.Mutex{}}
+	t.Cleanup(func() {
+		_datastore = datastore
+		db.Close()
+	})
+
+	return db
+}
+
+// useTestChatRetention will change the chat retention settings for the
+// rest of a test.
+func useTestChatRetention(t *testing.T, retention models.ChatRetention) {
+	t.Helper()
+
+	configRepository := configrepository.Get()
+	previous := configRepository.GetChatRetention()
+	t.Cleanup(func() {
+		_ = configRepository.SetChatRetention(previous)
+	})
+	if err := configRepository.SetChatRetention(retention); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPruneKeepsRecentChatMessages(t *testing.T) {
+	db := useTestMessagesTable(t)
+
+	useTestChatRetention(t, models.ChatRetention{Mode: models.ChatRetentionMessages, Messages: 3})
+
+	// Oldest first. Only the chat and system messages count towards the
+	// number kept.
+	saved := []struct {
+		id        string
+		eventType string
+		kept      bool
+	}{
+		{"oldest-message", events.MessageSent, false},
+		{"old-join", events.UserJoined, false},
+		{"kept-message", events.MessageSent, true},
+		{"kept-join", events.UserJoined, true},
+		{"kept-name-change", events.UserNameChanged, true},
+		{"kept-system-message", events.SystemMessageSent, true},
+		{"kept-action", events.ChatActionSent, true},
+		{"newest-message", events.MessageSent, true},
+	}
+
+	start := time.Now().Add(-time.Hour)
+	for i, m := range saved {
+		if _, err := db.Exec("INSERT INTO messages(id, body, eventType, timestamp) VALUES(?, ?, ?, ?)", m.id, "", m.eventType, start.Add(time.Duration(i)*time.Minute)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	runPruner()
+
+	for _, m := range saved {
+		var count int
+		if err := db.QueryRow("SELECT COUNT(*) FROM messages WHERE id = ?", m.id).Scan(&count); err != nil {
+			t.Fatal(err)
+		}
+		if kept := count == 1; kept != m.kept {
+			t.Errorf("%s: expected kept to be %t", m.id, m.kept)
+		}
+	}
+}
+
+func TestPruneKeepsEverythingUnderTheLimit(t *testing.T) {
+	db := useTestMessagesTable(t)
+
+	useTestChatRetention(t, models.ChatRetention{Mode: models.ChatRetentionMessages, Messages: 3})
+
+	start := time.Now().Add(-time.Hour)
+	for i, eventType := range []string{events.UserJoined, events.MessageSent, events.UserJoined, events.MessageSent} {
+		if _, err := db.Exec("INSERT INTO messages(id, body, eventType, timestamp) VALUES(?, ?, ?, ?)", eventType+string(rune('a'+i)), "", eventType, start.Add(time.Duration(i)*time.Minute)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	runPruner()
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM messages").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Errorf("nothing should be removed with fewer chat messages than the limit, %d of 4 kept", count)
+	}
+}
@@ -0,0 +1,145 @@
+package chat
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/core/webhooks"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// The most different reactions a single user can add to a message.
+	maxReactionsPerUserPerMessage = 5
+	// The longest a unicode emoji reaction can be, in bytes. Allows for
+	// sequences joined with zero width joiners and skin tone modifiers.
+	maxUnicodeReactionLength = 32
+)
+
+// newReactionRateLimiter will return the limiter applied to a client's
+// reactions. Allows a burst of 5 reactions then one every two seconds.
+func newReactionRateLimiter() *rate.Limiter {
+	return rate.NewLimiter(rate.Every(2*time.Second), 5)
+}
+
+func (s *Server) messageReactionReceived(eventData chatClientEvent) {
+	c := eventData.client
+
+	var event events.MessageReactionEvent
+	if err := json.Unmarshal(eventData.data, &event); err != nil {
+		log.Errorln("error unmarshalling to MessageReactionEvent", err)
+		return
+	}
+
+	if c.User == nil {
+		return
+	}
+
+	if !c.User.IsModerator() {
+		if GetChatModes().AuthenticatedOnly && !c.User.Authenticated {
+			s.sendActionToClient(c, "Chat is in authenticated users only mode. Please authenticate to take part in chat.")
+			return
+		}
+
+		if !c.reactionRateLimiter.Allow() {
+			s.sendActionToClient(c, "You are reacting too quickly. Please wait a moment and try again.")
+			return
+		}
+	}
+
+	emoji, valid := normalizeReaction(event.Emoji)
+	if !valid {
+		s.sendActionToClient(c, "Sorry, that reaction is not a supported emoji.")
+		return
+	}
+
+	chatMessageRepository := chatmessagerepository.Get()
+	if !chatMessageRepository.MessageExists(event.MessageID) {
+		s.sendActionToClient(c, "Sorry, the message you reacted to could not be found.")
+		return
+	}
+
+	if event.Removed {
+		if err := chatMessageRepository.RemoveReaction(event.MessageID, c.User.ID, emoji); err != nil {
+			log.Errorln("error removing message reaction", err)
+			return
+		}
+	} else {
+		count, err := chatMessageRepository.GetReactionCountFromUser(event.MessageID, c.User.ID)
+		if err != nil {
+			log.Errorln("error fetching message reactions", err)
+			return
+		}
+
+		if count >= maxReactionsPerUserPerMessage {
+			s.sendActionToClient(c, fmt.Sprintf("You can only add %d reactions to a message.", maxReactionsPerUserPerMessage))
+			return
+		}
+
+		if err := chatMessageRepository.AddReaction(event.MessageID, c.User.ID, emoji); err != nil {
+			log.Errorln("error saving message reaction", err)
+			return
+		}
+	}
+
+	event.SetDefaults()
+	event.User = c.User
+	event.Emoji = emoji
+
+	if err := s.Broadcast(event.GetBroadcastPayload()); err != nil {
+		log.Errorln("error broadcasting MessageReactionEvent payload", err)
+		return
+	}
+
+	webhooks.SendChatEventMessageReaction(event)
+}
+
+// normalizeReaction will return the reaction to save for the provided emoji
+// and if it is allowed. Reactions are either unicode emoji or the name of a
+// custom emoji.
+func normalizeReaction(emoji string) (string, bool) {
+	emoji = strings.TrimSpace(emoji)
+	if emoji == "" {
+		return "", false
+	}
+
+	for _, customEmoji := range data.GetEmojiList() {
+		if customEmoji.Name != nil && *customEmoji.Name == emoji {
+			return emoji, true
+		}
+	}
+
+	if len(emoji) > maxUnicodeReactionLength {
+		return "", false
+	}
+
+	for _, r := range emoji {
+		if !isEmojiRune(r) {
+			return "", false
+		}
+	}
+
+	return emoji, true
+}
+
+// validReplyTo will return the message ID a message is replying to, or an
+// empty string if the message it replies to does not exist.
+func validReplyTo(messageID string) string {
+	messageID = strings.TrimSpace(messageID)
+	if messageID == "" {
+		return ""
+	}
+
+	chatMessageRepository := chatmessagerepository.Get()
+	if !chatMessageRepository.MessageExists(messageID) {
+		return ""
+	}
+
+	return messageID
+}
@@ -0,0 +1,38 @@
+package chat
+
+import "testing"
+
+func TestNormalizeReaction(t *testing.T) {
+	allowed := map[string]string{
+		"👍":   "👍",
+		" 🎉 ": "🎉",
+		"👍🏽":  "👍🏽",
+		"👩‍💻": "👩‍💻",
+		"❤️":  "❤️",
+	}
+
+	for emoji, expected := range allowed {
+		result, valid := normalizeReaction(emoji)
+		if !valid {
+			t.Errorf("%q should be an allowed reaction", emoji)
+		}
+		if result != expected {
+			t.Errorf("%q should be normalized to %q, got %q", emoji, expected, result)
+		}
+	}
+
+	rejected := []string{
+		"",
+		"   ",
+		"hello",
+		"👍 nice",
+		"<img src=x>",
+		"👍👍👍👍👍👍👍👍👍",
+	}
+
+	for _, emoji := range rejected {
+		if _, valid := normalizeReaction(emoji); valid {
+			t.Errorf("%q should not be an allowed reaction", emoji)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package chat
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/owncast/owncast/config"
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/owncast/owncast/persistence/moderationrepository"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxHeldMessages is the most messages kept waiting for review. When it is
+// exceeded the oldest held message is dropped.
+const maxHeldMessages = 200
+
+// GetHeldMessages will return the messages waiting for a moderator to review.
+func GetHeldMessages() []events.HeldMessageEvent {
+	return _server.getHeldMessages()
+}
+
+// ReviewHeldMessage will approve or reject a message held for review.
+// Approved messages are sent to chat.
+func ReviewHeldMessage(messageID string, approved bool, actor models.ModerationActor) error {
+	return _server.reviewHeldMessage(messageID, approved, actor)
+}
+
+// shouldHoldMessage will return if a message needs to be reviewed by a
+// moderator before it is sent, and why.
+func (s *Server) shouldHoldMessage(c *Client, event *events.UserMessageEvent) (bool, string) {
+	if c.User.IsModerator() {
+		return false, ""
+	}
+
+	configRepository := configrepository.Get()
+	queue := configRepository.GetChatReviewQueue()
+
+	if queue.HoldLinks && len(linkHosts(event.RawBody)) > 0 {
+		return true, "Message contains a link."
+	}
+
+	if queue.HoldNewUserMessages && isFirstMessageFromNewUser(c.User) {
+		return true, "First message from a new user."
+	}
+
+	return false, ""
+}
+
+func isFirstMessageFromNewUser(u *models.User) bool {
+	if time.Since(u.CreatedAt) > config.GetDefaults().ChatEstablishedUserModeTimeDuration {
+		return false
+	}
+
+	chatMessageRepository := chatmessagerepository.Get()
+	messageIDs, err := chatMessageRepository.GetMessageIdsForUserID(u.ID)
+	if err != nil {
+		log.Errorln("error fetching user messages", err)
+		return false
+	}
+
+	return len(messageIDs) == 0
+}
+
+// holdMessage will keep a message back from chat and send it to the
+// connected moderators to review.
+func (s *Server) holdMessage(event *events.UserMessageEvent, reason string) {
+	held := &events.HeldMessageEvent{
+		UserMessageEvent: *event,
+		Reason:           reason,
+	}
+
+	s.mu.Lock()
+	s.heldMessages = append(s.heldMessages, held)
+	if len(s.heldMessages) > maxHeldMessages {
+		s.heldMessages = s.heldMessages[len(s.heldMessages)-maxHeldMessages:]
+	}
+	s.mu.Unlock()
+
+	payload := held.GetBroadcastPayload()
+	for _, client := range s.getModeratorClients() {
+		client.sendPayload(payload)
+	}
+}
+
+func (s *Server) getHeldMessages() []events.HeldMessageEvent {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	held := make([]events.HeldMessageEvent, 0, len(s.heldMessages))
+	for _, message := range s.heldMessages {
+		held = append(held, *message)
+	}
+
+	return held
+}
+
+func (s *Server) reviewHeldMessage(messageID string, approved bool, actor models.ModerationActor) error {
+	var held *events.HeldMessageEvent
+
+	s.mu.Lock()
+	for i, message := range s.heldMessages {
+		if message.ID == messageID {
+			held = message
+			s.heldMessages = append(s.heldMessages[:i], s.heldMessages[i+1:]...)
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	if held == nil {
+		return errors.New("held message not found")
+	}
+
+	if approved {
+		if err := s.publishUserMessage(&held.UserMessageEvent); err != nil {
+			return err
+		}
+	}
+
+	action := models.ModerationActionRejectMessage
+	if approved {
+		action = models.ModerationActionApproveMessage
+	}
+
+	moderationRepository := moderationrepository.Get()
+	if err := moderationRepository.RecordAction(models.ModerationAction{
+		ActorType: actor.Type,
+		ActorID:   actor.ID,
+		ActorName: actor.Name,
+		Action:    action,
+		Target:    messageID,
+	}); err != nil {
+		log.Errorln("error recording moderation action", err)
+	}
+
+	reviewed := events.HeldMessageReviewedEvent{
+		MessageID: messageID,
+		Approved:  approved,
+	}
+	reviewed.SetDefaults()
+
+	payload := reviewed.GetBroadcastPayload()
+	for _, client := range s.getModeratorClients() {
+		client.sendPayload(payload)
+	}
+
+	return nil
+}
+
+func (s *Server) heldMessageReviewed(eventData chatClientEvent) {
+	u := eventData.client.User
+	if !u.IsModerator() {
+		log.Debugln(logSanitize(u.DisplayName), "attempted to review a held message without moderator access")
+		return
+	}
+
+	var receivedEvent events.HeldMessageReviewedEvent
+	if err := json.Unmarshal(eventData.data, &receivedEvent); err != nil {
+		log.Errorln("error unmarshalling to HeldMessageReviewedEvent", err)
+		return
+	}
+
+	actor := models.ModerationActor{
+		Type: models.ModerationActorModerator,
+		ID:   u.ID,
+		Name: u.DisplayName,
+	}
+
+	if err := s.reviewHeldMessage(receivedEvent.MessageID, receivedEvent.Approved, actor); err != nil {
+		s.sendActionToClient(eventData.client, err.Error())
+	}
+}
+
+// sendHeldMessages will send a moderator all the messages currently
+// waiting for review.
+func (c *Client) sendHeldMessages() {
+	for _, held := range c.server.getHeldMessages() {
+		c.sendPayload(held.GetBroadcastPayload())
+	}
+}
@@ -0,0 +1,98 @@
+package chat
+
+import (
+	"testing"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/owncast/owncast/persistence/moderationrepository"
+)
+
+// heldMessagesFrom will return the held messages sent by a user.
+func heldMessagesFrom(userID string) []events.HeldMessageEvent {
+	held := []events.HeldMessageEvent{}
+	for _, message := range GetHeldMessages() {
+		if message.User.ID == userID {
+			held = append(held, message)
+		}
+	}
+
+	return held
+}
+
+func TestReviewHeldMessages(t *testing.T) {
+	configRepository := configrepository.Get()
+	if err := configRepository.SetChatReviewQueue(models.ChatReviewQueue{HoldLinks: true}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = configRepository.SetChatReviewQueue(models.ChatReviewQueue{})
+	})
+
+	moderatorUser, moderator := newTestModerator(t, "review-moderator")
+	sender, viewer := newTestChatter(t, "review-sender")
+
+	sendTestEvent(t, viewer, map[string]interface{}{"type": events.MessageSent, "body": "approve https://example.com"})
+	sendTestEvent(t, viewer, map[string]interface{}{"type": events.MessageSent, "body": "reject https://example.com"})
+	sendTestEvent(t, viewer, map[string]interface{}{"type": events.MessageSent, "body": "no link"})
+
+	held := heldMessagesFrom(sender.ID)
+	if len(held) != 2 {
+		t.Fatalf("Expected the two messages with links to be held but %d were", len(held))
+	}
+	if got := len(receivedEventsOfType(t, moderator, events.HeldMessage)); got != 2 {
+		t.Errorf("Expected moderators to be sent the 2 held messages but got %d", got)
+	}
+	if chat := receivedEventsOfType(t, viewer, events.MessageSent); len(chat) != 1 || chat[0]["body"] != "<p>no link</p>" {
+		t.Errorf("Expected only the message without a link to be sent to chat but got %v", chat)
+	}
+
+	// Only moderators can review held messages.
+	sendTestEvent(t, viewer, map[string]interface{}{"type": events.HeldMessageReviewed, "messageId": held[0].ID, "approved": true})
+	if len(heldMessagesFrom(sender.ID)) != 2 {
+		t.Fatal("Expected a review from a user who isn't a moderator to be ignored")
+	}
+
+	actor := models.ModerationActor{Type: models.ModerationActorModerator, ID: moderatorUser.ID, Name: moderatorUser.DisplayName}
+	if err := ReviewHeldMessage(held[0].ID, true, actor); err != nil {
+		t.Fatal(err)
+	}
+	sendTestEvent(t, moderator, map[string]interface{}{"type": events.HeldMessageReviewed, "messageId": held[1].ID, "approved": false})
+
+	if remaining := heldMessagesFrom(sender.ID); len(remaining) != 0 {
+		t.Errorf("Expected reviewed messages to no longer be held but %d are", len(remaining))
+	}
+	if got := len(receivedEventsOfType(t, moderator, events.HeldMessageReviewed)); got != 2 {
+		t.Errorf("Expected moderators to be told about 2 reviews but got %d", got)
+	}
+	if chat := receivedEventsOfType(t, viewer, events.MessageSent); len(chat) != 1 || chat[0]["id"] != held[0].ID {
+		t.Errorf("Expected only the approved message to be sent to chat but got %v", chat)
+	}
+
+	chatMessageRepository := chatmessagerepository.Get()
+	if _, err := chatMessageRepository.GetMessageByID(held[0].ID); err != nil {
+		t.Errorf("Expected the approved message to be saved. %v", err)
+	}
+	if _, err := chatMessageRepository.GetMessageByID(held[1].ID); err == nil {
+		t.Error("Expected the rejected message to not be saved")
+	}
+
+	for id, action := range map[string]models.ModerationActionType{
+		held[0].ID: models.ModerationActionApproveMessage,
+		held[1].ID: models.ModerationActionRejectMessage,
+	} {
+		actions, _, err := moderationrepository.Get().GetActions(models.ModerationActionFilter{Target: id}, 0, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(actions) != 1 || actions[0].Action != action || actions[0].ActorID != moderatorUser.ID {
+			t.Errorf("Expected a %s moderation action by the moderator for %s but got %+v", action, id, actions)
+		}
+	}
+
+	if err := ReviewHeldMessage(held[0].ID, true, actor); err == nil {
+		t.Error("Expected a message that was already reviewed to not be found")
+	}
+}
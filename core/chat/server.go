@@ -1,6 +1,7 @@
 package chat
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -14,12 +15,15 @@ import (
 	"github.com/owncast/owncast/config"
 	"github.com/owncast/owncast/core/chat/events"
 	"github.com/owncast/owncast/core/webhooks"
+	"github.com/owncast/owncast/metrics/collectors"
 	"github.com/owncast/owncast/models"
 	"github.com/owncast/owncast/persistence/authrepository"
 	"github.com/owncast/owncast/persistence/configrepository"
 	"github.com/owncast/owncast/persistence/userrepository"
 	"github.com/owncast/owncast/services/geoip"
+	"github.com/owncast/owncast/tracing"
 	"github.com/owncast/owncast/utils"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var _server *Server
@@ -40,7 +44,17 @@ type Server struct {
 	geoipClient *geoip.Client
 
 	// a map of user IDs and timers that fire for chat part messages.
-	userPartedTimers         map[string]*time.Ticker
+	userPartedTimers map[string]*time.Ticker
+
+	// a map of user IDs and when they last sent a message, for slow mode.
+	lastMessageTimes map[string]time.Time
+
+	// the admin managed rules applied to chat messages.
+	filterRules *ChatFilterRules
+
+	// messages waiting for a moderator to approve or reject them.
+	heldMessages []*events.HeldMessageEvent
+
 	seq                      uint
 	maxSocketConnectionLimit uint64
 
@@ -60,6 +74,7 @@ func NewChat() *Server {
 		maxSocketConnectionLimit: maximumConcurrentConnectionLimit,
 		geoipClient:              geoip.NewClient(),
 		userPartedTimers:         map[string]*time.Ticker{},
+		lastMessageTimes:         map[string]time.Time{},
 	}
 
 	return server
@@ -71,6 +86,7 @@ func (s *Server) Run() {
 		select {
 		case clientID := <-s.unregister:
 			if client, ok := s.clients[clientID]; ok {
+				collectors.ChatConnections.WithLabelValues(collectors.ChatDisconnected).Inc()
 				s.handleClientDisconnected(client)
 				s.mu.Lock()
 				delete(s.clients, clientID)
@@ -125,12 +141,34 @@ func (s *Server) Addclient(conn *websocket.Conn, user *models.User, accessToken
 	s.mu.Unlock()
 
 	log.Traceln("Adding client", client.Id, "total count:", len(s.clients))
+	collectors.ChatConnections.WithLabelValues(collectors.ChatConnected).Inc()
 
 	go client.writePump()
 	go client.readPump()
 
 	client.sendConnectedClientInfo()
 
+	// Let the client know if any chat modes are currently applied.
+	if modes := configRepository.GetChatModes(); modes != (models.ChatModes{}) {
+		client.sendChatModes(modes)
+	}
+
+	// Let moderators know about messages waiting for them to review.
+	if user.IsModerator() {
+		client.sendHeldMessages()
+	}
+
+	// Let the user catch up on their direct messages.
+	client.sendDirectMessages()
+
+	// Let the client vote in the poll that is currently running.
+	client.sendActivePoll()
+
+	// Let a timed out user know how long they have left.
+	if timeout := userrepository.Get().GetTimeout(user.ID); timeout != nil {
+		client.sendTimeout(timeout)
+	}
+
 	if getStatus().Online {
 		if shouldSendJoinedMessages {
 			s.sendUserJoinedMessage(client)
@@ -206,7 +244,14 @@ func (s *Server) HandleClientConnection(w http.ResponseWriter, r *http.Request)
 	configRepository := configrepository.Get()
 	authRepository := authrepository.Get()
 
+	ctx, span := tracing.Start(tracing.Extract(r), "chat.HandleClientConnection")
+	defer span.End()
+	rejected := func(reason string) {
+		span.SetAttributes(attribute.String("chat.rejected", reason))
+	}
+
 	if configRepository.GetChatDisabled() {
+		rejected("chat disabled")
 		_, _ = w.Write([]byte(events.ChatDisabled))
 		return
 	}
@@ -214,17 +259,19 @@ func (s *Server) HandleClientConnection(w http.ResponseWriter, r *http.Request)
 	ipAddress := utils.GetIPAddressFromRequest(r)
 	// Check if this client's IP address is banned. If so send a rejection.
 	if blocked, err := authRepository.IsIPAddressBanned(ipAddress); blocked {
-		log.Debugln("Client ip address has been blocked. Rejecting.")
+		rejected("ip address banned")
+		log.WithContext(ctx).Debugln("Client ip address has been blocked. Rejecting.")
 
 		w.WriteHeader(http.StatusForbidden)
 		return
 	} else if err != nil {
-		log.Errorln("error determining if IP address is blocked: ", err)
+		log.WithContext(ctx).Errorln("error determining if IP address is blocked: ", err)
 	}
 
 	// Limit concurrent chat connections
 	if uint64(len(s.clients)) >= s.maxSocketConnectionLimit {
-		log.Warnln("rejecting incoming client connection as it exceeds the max client count of", s.maxSocketConnectionLimit)
+		rejected("too many connections")
+		log.WithContext(ctx).Warnln("rejecting incoming client connection as it exceeds the max client count of", s.maxSocketConnectionLimit)
 		_, _ = w.Write([]byte(events.ErrorMaxConnectionsExceeded))
 		return
 	}
@@ -236,13 +283,15 @@ func (s *Server) HandleClientConnection(w http.ResponseWriter, r *http.Request)
 
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
-		log.Debugln(err)
+		tracing.End(span, err)
+		log.WithContext(ctx).Debugln(err)
 		return
 	}
 
 	accessToken := r.URL.Query().Get("accessToken")
 	if accessToken == "" {
-		log.Errorln("Access token is required")
+		rejected("no access token")
+		log.WithContext(ctx).Errorln("Access token is required")
 		// Return HTTP status code
 		_ = conn.Close()
 		return
@@ -254,6 +303,7 @@ func (s *Server) HandleClientConnection(w http.ResponseWriter, r *http.Request)
 	user := userRepository.GetUserByToken(accessToken)
 
 	if user == nil {
+		rejected("unregistered")
 		// Send error that registration is required
 		_ = conn.WriteJSON(events.EventPayload{
 			"type": events.ErrorNeedsRegistration,
@@ -264,7 +314,8 @@ func (s *Server) HandleClientConnection(w http.ResponseWriter, r *http.Request)
 
 	// User is disabled therefore we should disconnect.
 	if user.DisabledAt != nil {
-		log.Traceln("Disabled user", user.ID, user.DisplayName, "rejected")
+		rejected("user disabled")
+		log.WithContext(ctx).Traceln("Disabled user", user.ID, user.DisplayName, "rejected")
 		_ = conn.WriteJSON(events.EventPayload{
 			"type": events.ErrorUserDisabled,
 		})
@@ -274,7 +325,8 @@ func (s *Server) HandleClientConnection(w http.ResponseWriter, r *http.Request)
 
 	userAgent := r.UserAgent()
 
-	s.Addclient(conn, user, accessToken, userAgent, ipAddress)
+	client := s.Addclient(conn, user, accessToken, userAgent, ipAddress)
+	span.SetAttributes(attribute.String("chat.user_id", user.ID), attribute.Int("chat.client_id", int(client.Id)))
 }
 
 // Broadcast sends message to all connected clients.
@@ -390,6 +442,15 @@ func (s *Server) eventReceived(event chatClientEvent) {
 	c := event.client
 	u := c.User
 
+	_, span := tracing.Start(context.Background(), "chat.eventReceived",
+		attribute.Int("chat.client_id", int(c.Id)),
+		attribute.Int64("chat.queued_ms", time.Since(event.receivedAt).Milliseconds()),
+	)
+	defer span.End()
+	if u != nil {
+		span.SetAttributes(attribute.String("chat.user_id", u.ID))
+	}
+
 	// If established chat user only mode is enabled and the user is not old
 	// enough then reject this event and send them an informative message.
 	if u != nil && configRepository.GetChatEstbalishedUsersOnlyMode() && time.Since(event.client.User.CreatedAt) < config.GetDefaults().ChatEstablishedUserModeTimeDuration && !u.IsModerator() {
@@ -403,9 +464,23 @@ func (s *Server) eventReceived(event chatClientEvent) {
 	}
 
 	eventType := typecheck["type"]
+	span.SetAttributes(attribute.String("chat.event_type", fmt.Sprint(eventType)))
+
+	// If the user has been timed out then reject this event and let them
+	// know how long they have left.
+	if u != nil && isTimeoutRestrictedEvent(eventType) {
+		if allowed, reason := passesTimeout(u); !allowed {
+			s.sendSanitizedActionToClient(c, reason)
+			return
+		}
+	}
 
 	switch eventType {
 	case events.MessageSent:
+		// Messages starting with a slash are chat commands.
+		if s.handleCommand(event) {
+			return
+		}
 		s.userMessageSent(event)
 
 	case events.UserNameChanged:
@@ -413,6 +488,28 @@ func (s *Server) eventReceived(event chatClientEvent) {
 
 	case events.UserColorChanged:
 		s.userColorChanged(event)
+
+	case events.ChatModesUpdate:
+		s.chatModesUpdated(event)
+
+	case events.HeldMessageReviewed:
+		s.heldMessageReviewed(event)
+
+	case events.MessageReaction:
+		s.messageReactionReceived(event)
+
+	case events.MessageEdited:
+		s.messageEditReceived(event)
+
+	case events.MessageDeleted:
+		s.messageDeleteReceived(event)
+
+	case events.DirectMessage:
+		s.directMessageReceived(event)
+
+	case events.PollVote:
+		s.pollVoteReceived(event)
+
 	default:
 		log.Debugln(logSanitize(fmt.Sprint(eventType)), "event not found:", logSanitize(fmt.Sprint(typecheck)))
 	}
@@ -460,6 +557,22 @@ func (s *Server) sendSystemMessageToClient(c *Client, message string) {
 	s.Send(clientMessage.GetBroadcastPayload(), c)
 }
 
+// sendSanitizedActionToClient will send an action to a single client
+// whose text includes content that was not written by the server.
+func (s *Server) sendSanitizedActionToClient(c *Client, message string) {
+	clientMessage := events.ActionEvent{
+		MessageEvent: events.MessageEvent{
+			Body: message,
+		},
+		Event: events.Event{
+			Type: events.ChatActionSent,
+		},
+	}
+	clientMessage.SetDefaults()
+	clientMessage.RenderAndSanitizeMessageBody()
+	s.Send(clientMessage.GetBroadcastPayload(), c)
+}
+
 func (s *Server) sendActionToClient(c *Client, message string) {
 	clientMessage := events.ActionEvent{
 		MessageEvent: events.MessageEvent{
@@ -0,0 +1,155 @@
+package chat
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/userrepository"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxTimeoutDuration is the longest a user can be timed out of chat for.
+const maxTimeoutDuration = models.MaxUserTimeout
+
+// TimeoutUser will prevent a user from taking part in chat for the
+// provided duration and let them know how long they have to wait.
+func TimeoutUser(userID string, duration time.Duration, reason string) error {
+	if duration <= 0 || duration > maxTimeoutDuration {
+		return fmt.Errorf("timeout must be between 1 second and %d hours", int(maxTimeoutDuration.Hours()))
+	}
+
+	userRepository := userrepository.Get()
+	user := userRepository.GetUserByID(userID)
+	if user == nil {
+		return errors.New("user not found")
+	}
+
+	if user.IsModerator() {
+		return errors.New("moderators cannot be timed out")
+	}
+
+	timeout := &models.UserTimeout{
+		User:      user,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().Add(duration),
+	}
+
+	if err := userRepository.SetTimeout(userID, reason, timeout.ExpiresAt); err != nil {
+		return errors.Wrap(err, "error saving user timeout")
+	}
+
+	sendTimeoutToUser(timeout)
+
+	return SendSystemAction(fmt.Sprintf("**%s** has been timed out for %s.", user.DisplayName, formatTimeoutDuration(duration)), true)
+}
+
+// RemoveTimeout will allow a timed out user to take part in chat again.
+func RemoveTimeout(userID string) error {
+	userRepository := userrepository.Get()
+	user := userRepository.GetUserByID(userID)
+	if user == nil {
+		return errors.New("user not found")
+	}
+
+	if err := userRepository.RemoveTimeout(userID); err != nil {
+		return errors.Wrap(err, "error removing user timeout")
+	}
+
+	// An expiry of now lets the clients know the timeout is over.
+	sendTimeoutToUser(&models.UserTimeout{User: user, ExpiresAt: time.Now()})
+
+	return nil
+}
+
+// sendTimeoutToUser will let all the clients of a timed out user know
+// when their timeout expires.
+func sendTimeoutToUser(timeout *models.UserTimeout) {
+	clients, err := GetClientsForUser(timeout.User.ID)
+	if err != nil {
+		log.Errorln("error fetching clients for user:", err)
+		return
+	}
+
+	for _, client := range clients {
+		client.sendTimeout(timeout)
+	}
+}
+
+func (c *Client) sendTimeout(timeout *models.UserTimeout) {
+	event := events.UserTimeoutEvent{
+		UserEvent: events.UserEvent{User: timeout.User},
+		ExpiresAt: timeout.ExpiresAt,
+		Reason:    timeout.Reason,
+	}
+	event.SetDefaults()
+	c.sendPayload(event.GetBroadcastPayload())
+}
+
+// timeoutRestrictedEvents are the events a timed out user can't send.
+// Everything else, such as changing their name, is still allowed.
+var timeoutRestrictedEvents = map[string]bool{
+	events.MessageSent:     true,
+	events.MessageEdited:   true,
+	events.MessageReaction: true,
+	events.PollVote:        true,
+	events.DirectMessage:   true,
+}
+
+func isTimeoutRestrictedEvent(eventType interface{}) bool {
+	t, ok := eventType.(string)
+	return ok && timeoutRestrictedEvents[t]
+}
+
+// passesTimeout will test if a user is currently allowed to take part in
+// chat. If they are not, the returned string explains why to the sender.
+// The reason is set by whoever timed the user out, so the string must be
+// sanitized before it is sent.
+func passesTimeout(u *models.User) (bool, string) {
+	if u.IsModerator() {
+		return true, ""
+	}
+
+	userRepository := userrepository.Get()
+	timeout := userRepository.GetTimeout(u.ID)
+	if timeout == nil || !timeout.IsActive() {
+		return true, ""
+	}
+
+	message := fmt.Sprintf("You have been timed out of chat. You can take part again in %s.", formatTimeoutDuration(time.Until(timeout.ExpiresAt)))
+	if timeout.Reason != "" {
+		message = fmt.Sprintf("%s Reason: %s", message, timeout.Reason)
+	}
+
+	return false, message
+}
+
+// pruneExpiredTimeouts will remove timeouts that no longer apply.
+func pruneExpiredTimeouts() {
+	userRepository := userrepository.Get()
+	if err := userRepository.RemoveExpiredTimeouts(); err != nil {
+		log.Debugln(err)
+	}
+}
+
+func formatTimeoutDuration(d time.Duration) string {
+	switch {
+	case d >= time.Hour:
+		return pluralize(int(math.Ceil(d.Hours())), "hour")
+	case d >= time.Minute:
+		return pluralize(int(math.Ceil(d.Minutes())), "minute")
+	default:
+		return pluralize(int(math.Ceil(d.Seconds())), "second")
+	}
+}
+
+func pluralize(count int, unit string) string {
+	if count == 1 {
+		return fmt.Sprintf("1 %s", unit)
+	}
+	return fmt.Sprintf("%d %ss", count, unit)
+}
@@ -0,0 +1,121 @@
+package chat
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/persistence/userrepository"
+)
+
+func TestTimeoutUser(t *testing.T) {
+	user := newTestUser(t, "timeout-user")
+	client := newTestClient(t, user)
+
+	if err := TimeoutUser(user.ID, 0, ""); err == nil {
+		t.Error("expected an error for a timeout with no duration")
+	}
+	if err := TimeoutUser(user.ID, maxTimeoutDuration+time.Second, ""); err == nil {
+		t.Error("expected an error for a timeout longer than the maximum")
+	}
+	if err := TimeoutUser("not-a-user", time.Minute, ""); err == nil {
+		t.Error("expected an error for a user that does not exist")
+	}
+
+	if err := TimeoutUser(user.ID, 10*time.Minute, "spamming"); err != nil {
+		t.Fatal(err)
+	}
+
+	allowed, message := passesTimeout(user)
+	if allowed {
+		t.Fatal("a timed out user should not pass the timeout check")
+	}
+	if !strings.Contains(message, "10 minutes") || !strings.Contains(message, "Reason: spamming") {
+		t.Errorf("unexpected timeout message %q", message)
+	}
+
+	received := receivedEvents(t, client)
+	if len(received) == 0 || received[0]["type"] != events.UserTimedOut {
+		t.Errorf("expected the user's client to be told about the timeout, got %v", received)
+	}
+}
+
+func TestTimeoutExpiry(t *testing.T) {
+	user := newTestUser(t, "expired-timeout-user")
+	userRepository := userrepository.Get()
+
+	if err := userRepository.SetTimeout(user.ID, "", time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+
+	if allowed, _ := passesTimeout(user); !allowed {
+		t.Error("an expired timeout should not prevent taking part in chat")
+	}
+
+	pruneExpiredTimeouts()
+	for _, timeout := range userRepository.GetTimeouts() {
+		if timeout.User.ID == user.ID {
+			t.Error("expired timeouts should be pruned")
+		}
+	}
+}
+
+func TestRemoveTimeout(t *testing.T) {
+	user := newTestUser(t, "removed-timeout-user")
+	client := newTestClient(t, user)
+
+	if err := TimeoutUser(user.ID, time.Hour, ""); err != nil {
+		t.Fatal(err)
+	}
+	receivedEvents(t, client)
+
+	if err := RemoveTimeout(user.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if allowed, _ := passesTimeout(user); !allowed {
+		t.Error("a user whose timeout was removed should pass the timeout check")
+	}
+
+	received := receivedEvents(t, client)
+	if len(received) != 1 || received[0]["type"] != events.UserTimedOut {
+		t.Errorf("expected the user's client to be told the timeout is over, got %v", received)
+	}
+}
+
+func TestTimeoutReasonIsSanitized(t *testing.T) {
+	user := newTestUser(t, "sanitized-timeout-user")
+	client := newTestClient(t, user)
+
+	if err := TimeoutUser(user.ID, time.Hour, `<script>alert(1)</script> [click](javascript:alert(1))`); err != nil {
+		t.Fatal(err)
+	}
+	receivedEvents(t, client)
+
+	_, message := passesTimeout(user)
+	_server.sendSanitizedActionToClient(client, message)
+
+	received := receivedEvents(t, client)
+	if len(received) != 1 {
+		t.Fatalf("expected a single action, got %v", received)
+	}
+	body, _ := received[0]["body"].(string)
+	if strings.Contains(body, "<script") || strings.Contains(body, "javascript:") {
+		t.Errorf("timeout reason was not sanitized: %s", body)
+	}
+}
+
+func TestTimeoutRestrictedEvents(t *testing.T) {
+	for _, eventType := range []string{events.MessageSent, events.MessageReaction, events.PollVote, events.DirectMessage} {
+		if !isTimeoutRestrictedEvent(eventType) {
+			t.Errorf("%s should not be allowed while timed out", eventType)
+		}
+	}
+
+	for _, eventType := range []interface{}{events.UserNameChanged, events.UserColorChanged, nil} {
+		if isTimeoutRestrictedEvent(eventType) {
+			t.Errorf("%v should be allowed while timed out", eventType)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/owncast/owncast/core/chat"
 	"github.com/owncast/owncast/core/data"
 	"github.com/owncast/owncast/core/rtmp"
+	"github.com/owncast/owncast/core/schedule"
 	"github.com/owncast/owncast/core/transcoder"
 	"github.com/owncast/owncast/core/webhooks"
 	"github.com/owncast/owncast/models"
@@ -85,6 +86,8 @@ func Start() error {
 
 	notifications.Setup(data.GetStore())
 
+	schedule.Start(GetStatus)
+
 	return nil
 }
 
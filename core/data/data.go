@@ -19,7 +19,7 @@ import (
 )
 
 const (
-	schemaVersion = 7
+	schemaVersion = 12
 )
 
 var (
@@ -77,8 +77,16 @@ func SetupPersistence(file string) error {
 
 	tables.CreateConfigTable(db)
 	tables.CreateWebhooksTable(db)
+	tables.CreateWebhookDeliveriesTable(db)
+	tables.CreateScheduledStreamsTable(db)
+	tables.CreateBroadcastsTable(db)
+	tables.CreateStreamReportsTable(db)
+	tables.CreateViewerSessionsTable(db)
+	tables.CreateAlertsTable(db)
 	tables.CreateUsersTable(db)
 	tables.CreateAccessTokenTable(db)
+	tables.CreateUserTimeoutsTable(db)
+	tables.CreateModerationActionsTable(db)
 
 	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS config (
 		"key" string NOT NULL PRIMARY KEY,
@@ -0,0 +1,95 @@
+package rtmp
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nareix/joy5/av"
+	"github.com/owncast/owncast/metrics/collectors"
+)
+
+// A stream that hasn't finished a group of pictures in this long has
+// effectively stopped sending video.
+const ingestBitrateMaxAge = 30 * time.Second
+
+// _ingestMetrics is the measurement of the current inbound stream.
+var _ingestMetrics atomic.Pointer[ingestMetrics]
+
+// ingestMetrics measures the video received between keyframes.
+type ingestMetrics struct {
+	measuredAt       time.Time
+	lastKeyframeTime time.Duration
+	currentKbps      float64
+	totalKilobits    float64
+	totalSeconds     float64
+	videoBytes       int
+	hasSeenKeyframe  bool
+	mu               sync.Mutex
+}
+
+// GetIngestBitrate will return the most recent inbound video bitrate and its
+// average since the broadcaster connected, in kbps, and false if there is
+// no inbound stream to measure.
+func GetIngestBitrate() (current float64, average float64, ok bool) {
+	m := _ingestMetrics.Load()
+	if m == nil || !_hasInboundRTMPConnection {
+		return 0, 0, false
+	}
+
+	return m.bitrates(time.Now())
+}
+
+func (m *ingestMetrics) bitrates(now time.Time) (current float64, average float64, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.totalSeconds == 0 {
+		return 0, 0, false
+	}
+
+	current = m.currentKbps
+	if now.Sub(m.measuredAt) > ingestBitrateMaxAge {
+		current = 0
+	}
+
+	return current, m.totalKilobits / m.totalSeconds, true
+}
+
+// record will add a packet received from the broadcaster to the ingest
+// metrics.
+func (m *ingestMetrics) record(pkt av.Packet) {
+	switch pkt.Type {
+	case av.AAC:
+		collectors.IngestBytes.WithLabelValues(collectors.TrackAudio).Add(float64(len(pkt.Data)))
+		return
+	case av.H264:
+		collectors.IngestBytes.WithLabelValues(collectors.TrackVideo).Add(float64(len(pkt.Data)))
+	default:
+		return
+	}
+
+	if !pkt.IsKeyFrame {
+		m.videoBytes += len(pkt.Data)
+		return
+	}
+
+	// Packet times are the stream's own timestamps, so the interval is
+	// what the encoder is set to regardless of network jitter.
+	if interval := pkt.Time - m.lastKeyframeTime; m.hasSeenKeyframe && interval > 0 {
+		kbps := float64(m.videoBytes*8) / 1000 / interval.Seconds()
+		collectors.IngestKeyframeInterval.Observe(interval.Seconds())
+		collectors.IngestBitrate.Observe(kbps)
+
+		m.mu.Lock()
+		m.currentKbps = kbps
+		m.totalKilobits += float64(m.videoBytes*8) / 1000
+		m.totalSeconds += interval.Seconds()
+		m.measuredAt = time.Now()
+		m.mu.Unlock()
+	}
+
+	m.hasSeenKeyframe = true
+	m.lastKeyframeTime = pkt.Time
+	m.videoBytes = len(pkt.Data)
+}
@@ -0,0 +1,43 @@
+package rtmp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nareix/joy5/av"
+	"github.com/owncast/owncast/metrics/collectors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestIngestMetrics(t *testing.T) {
+	m := ingestMetrics{}
+	videoBefore := testutil.ToFloat64(collectors.IngestBytes.WithLabelValues(collectors.TrackVideo))
+	audioBefore := testutil.ToFloat64(collectors.IngestBytes.WithLabelValues(collectors.TrackAudio))
+
+	m.record(av.Packet{Type: av.H264, IsKeyFrame: true, Time: 0, Data: make([]byte, 100)})
+	m.record(av.Packet{Type: av.H264, Time: time.Second, Data: make([]byte, 50)})
+	m.record(av.Packet{Type: av.AAC, Time: time.Second, Data: make([]byte, 10)})
+	m.record(av.Packet{Type: av.H264, IsKeyFrame: true, Time: 2 * time.Second, Data: make([]byte, 200)})
+
+	if video := testutil.ToFloat64(collectors.IngestBytes.WithLabelValues(collectors.TrackVideo)) - videoBefore; video != 350 {
+		t.Errorf("Expected 350 video bytes but got %f", video)
+	}
+	if audio := testutil.ToFloat64(collectors.IngestBytes.WithLabelValues(collectors.TrackAudio)) - audioBefore; audio != 10 {
+		t.Errorf("Expected 10 audio bytes but got %f", audio)
+	}
+
+	// The latest keyframe starts a new group of pictures.
+	if m.lastKeyframeTime != 2*time.Second || m.videoBytes != 200 {
+		t.Errorf("Expected the group of pictures to start at 2s with 200 bytes but got %s with %d", m.lastKeyframeTime, m.videoBytes)
+	}
+
+	// 150 bytes of video over the two second group of pictures.
+	current, average, ok := m.bitrates(time.Now())
+	if !ok || current != 1.2/2 || average != 1.2/2 {
+		t.Errorf("Expected a current and average bitrate of 0.6 kbps but got %f and %f", current, average)
+	}
+
+	if current, _, _ := m.bitrates(time.Now().Add(time.Minute)); current != 0 {
+		t.Errorf("Expected a stalled stream to have no current bitrate but got %f", current)
+	}
+}
@@ -113,6 +113,8 @@ func HandleConn(c *rtmp.Conn, nc net.Conn) {
 	_rtmpConnection = nc
 
 	w := flv.NewMuxer(rtmpIn)
+	metrics := &ingestMetrics{}
+	_ingestMetrics.Store(metrics)
 
 	for {
 		if !_hasInboundRTMPConnection {
@@ -139,6 +141,8 @@ func HandleConn(c *rtmp.Conn, nc net.Conn) {
 			return
 		}
 
+		metrics.record(pkt)
+
 		if err := w.WritePacket(pkt); err != nil {
 			log.Errorln("unable to write rtmp packet", err)
 			handleDisconnect(nc)
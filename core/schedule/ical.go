@@ -0,0 +1,95 @@
+package schedule
+
+import (
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/owncast/owncast/models"
+)
+
+const (
+	// Streams don't have a set end, so calendars show them as this long.
+	calendarEventDuration = time.Hour
+
+	// iCalendar lines longer than this many octets must be folded.
+	maxCalendarLineLength = 75
+
+	calendarTimeFormat = "20060102T150405Z"
+)
+
+var calendarTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// MakeCalendar will return an iCalendar (RFC 5545) feed of scheduled
+// streams that calendar apps can subscribe to.
+func MakeCalendar(serverName, serverURL string, streams []models.ScheduledStream, now time.Time) string {
+	host := "owncast"
+	if parsed, err := url.Parse(serverURL); err == nil && parsed.Host != "" {
+		host = parsed.Host
+	}
+
+	var calendar strings.Builder
+	writeLine := func(line string) {
+		calendar.WriteString(foldCalendarLine(line))
+		calendar.WriteString("\r\n")
+	}
+
+	writeLine("BEGIN:VCALENDAR")
+	writeLine("VERSION:2.0")
+	writeLine("PRODID:-//Owncast//Schedule//EN")
+	writeLine("CALSCALE:GREGORIAN")
+	writeLine("METHOD:PUBLISH")
+	writeLine("X-WR-CALNAME:" + calendarTextEscaper.Replace(serverName))
+
+	for _, stream := range streams {
+		writeLine("BEGIN:VEVENT")
+		writeLine("UID:" + stream.ID + "@" + host)
+		writeLine("DTSTAMP:" + now.UTC().Format(calendarTimeFormat))
+		writeLine("DTSTART:" + stream.StartTime.UTC().Format(calendarTimeFormat))
+		writeLine("DTEND:" + stream.StartTime.Add(calendarEventDuration).UTC().Format(calendarTimeFormat))
+		writeLine("SUMMARY:" + calendarTextEscaper.Replace(stream.Title))
+		if stream.Description != "" {
+			writeLine("DESCRIPTION:" + calendarTextEscaper.Replace(stream.Description))
+		}
+		if serverURL != "" {
+			writeLine("URL:" + serverURL)
+			writeLine("LOCATION:" + calendarTextEscaper.Replace(serverURL))
+		}
+		if stream.Thumbnail != "" {
+			writeLine("IMAGE;VALUE=URI;DISPLAY=THUMBNAIL:" + stream.Thumbnail)
+		}
+		writeLine("END:VEVENT")
+	}
+
+	writeLine("END:VCALENDAR")
+
+	return calendar.String()
+}
+
+// foldCalendarLine will split a line into lines of at most 75 octets,
+// without splitting a character, with each continuation starting with a
+// space.
+func foldCalendarLine(line string) string {
+	if len(line) <= maxCalendarLineLength {
+		return line
+	}
+
+	var folded strings.Builder
+	limit := maxCalendarLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		folded.WriteString(line[:cut])
+		folded.WriteString("\r\n ")
+		line = line[cut:]
+
+		// Continuation lines lose an octet to the leading space.
+		limit = maxCalendarLineLength - 1
+	}
+	folded.WriteString(line)
+
+	return folded.String()
+}
@@ -0,0 +1,98 @@
+package schedule
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/models"
+)
+
+func TestMakeCalendar(t *testing.T) {
+	start := time.Date(2026, 3, 14, 18, 30, 0, 0, time.FixedZone("EST", -5*60*60))
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	streams := []models.ScheduledStream{
+		{
+			ID:          "abc123",
+			Title:       "Pi day; with friends, maybe",
+			Description: "Line one\nLine two",
+			Thumbnail:   "https://example.com/pie.jpg",
+			StartTime:   start,
+		},
+	}
+
+	calendar := MakeCalendar("My Server", "https://live.example.com", streams, now)
+
+	expectedLines := []string{
+		"BEGIN:VCALENDAR",
+		"X-WR-CALNAME:My Server",
+		"BEGIN:VEVENT",
+		"UID:abc123@live.example.com",
+		"DTSTAMP:20260301T120000Z",
+		"DTSTART:20260314T233000Z",
+		"DTEND:20260315T003000Z",
+		`SUMMARY:Pi day\; with friends\, maybe`,
+		`DESCRIPTION:Line one\nLine two`,
+		"URL:https://live.example.com",
+		"IMAGE;VALUE=URI;DISPLAY=THUMBNAIL:https://example.com/pie.jpg",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}
+
+	lines := strings.Split(strings.TrimSuffix(calendar, "\r\n"), "\r\n")
+	for _, expected := range expectedLines {
+		found := false
+		for _, line := range lines {
+			if line == expected {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("Expected calendar to contain %q:\n%s", expected, calendar)
+		}
+	}
+}
+
+func TestFoldCalendarLine(t *testing.T) {
+	line := "DESCRIPTION:" + strings.Repeat("ü", 80)
+
+	folded := foldCalendarLine(line)
+	parts := strings.Split(folded, "\r\n")
+	if len(parts) < 2 {
+		t.Fatalf("Expected the line to be folded: %q", folded)
+	}
+
+	for i, part := range parts {
+		if len(part) > maxCalendarLineLength {
+			t.Errorf("Line %d is %d octets long", i, len(part))
+		}
+		if i > 0 && !strings.HasPrefix(part, " ") {
+			t.Errorf("Expected continuation line %d to start with a space", i)
+		}
+		if !strings.HasPrefix(strings.TrimPrefix(part, " "), "ü") && i > 0 {
+			t.Errorf("Expected continuation line %d to not split a character: %q", i, part)
+		}
+	}
+
+	unfolded := strings.ReplaceAll(folded, "\r\n ", "")
+	if unfolded != line {
+		t.Errorf("Expected unfolding to give back the original line")
+	}
+
+	if short := foldCalendarLine("SUMMARY:Short"); short != "SUMMARY:Short" {
+		t.Errorf("Expected short lines to be left alone but got %q", short)
+	}
+}
+
+func TestReminderMessage(t *testing.T) {
+	now := time.Now()
+
+	if message := reminderMessage(models.ScheduledStream{StartTime: now.Add(14*time.Minute + 30*time.Second)}, now); message != "Going live in 15 minutes!" {
+		t.Errorf("Unexpected reminder message %q", message)
+	}
+	if message := reminderMessage(models.ScheduledStream{StartTime: now.Add(30 * time.Second)}, now); message != "Going live in 1 minute!" {
+		t.Errorf("Unexpected reminder message %q", message)
+	}
+}
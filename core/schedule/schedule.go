@@ -0,0 +1,185 @@
+package schedule
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/owncast/owncast/activitypub"
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/notifications"
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/owncast/owncast/persistence/schedulerepository"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/teris-io/shortid"
+)
+
+const (
+	// How often upcoming streams are checked for reminders to send.
+	reminderCheckInterval = time.Minute
+
+	maxTitleLength       = 200
+	maxDescriptionLength = 2000
+)
+
+var _getStatus func() models.Status
+
+// Start will begin sending reminders before scheduled streams.
+func Start(getStatusFunc func() models.Status) {
+	_getStatus = getStatusFunc
+
+	reminderTimer := time.NewTicker(reminderCheckInterval)
+	go func() {
+		for range reminderTimer.C {
+			sendReminders(time.Now())
+		}
+	}()
+}
+
+// CreateScheduledStream will save a new upcoming stream and announce it to
+// followers.
+func CreateScheduledStream(title, description, thumbnail string, startTime time.Time) (*models.ScheduledStream, error) {
+	stream := models.ScheduledStream{
+		ID:          shortid.MustGenerate(),
+		Title:       strings.TrimSpace(title),
+		Description: strings.TrimSpace(description),
+		Thumbnail:   strings.TrimSpace(thumbnail),
+		StartTime:   startTime,
+		CreatedAt:   time.Now(),
+	}
+
+	if err := validate(stream); err != nil {
+		return nil, err
+	}
+
+	if err := schedulerepository.Get().CreateScheduledStream(stream); err != nil {
+		return nil, err
+	}
+
+	if configrepository.Get().GetFederationEnabled() {
+		go func() {
+			if err := activitypub.SendScheduledStream(stream); err != nil {
+				log.Errorln("unable to send scheduled stream to followers", err)
+			}
+		}()
+	}
+
+	return &stream, nil
+}
+
+// UpdateScheduledStream will change the details of an upcoming stream.
+func UpdateScheduledStream(id, title, description, thumbnail string, startTime time.Time) (*models.ScheduledStream, error) {
+	repository := schedulerepository.Get()
+
+	stream, err := repository.GetScheduledStream(id)
+	if err != nil {
+		return nil, err
+	}
+	if stream == nil {
+		return nil, errors.New("scheduled stream not found")
+	}
+
+	stream.Title = strings.TrimSpace(title)
+	stream.Description = strings.TrimSpace(description)
+	stream.Thumbnail = strings.TrimSpace(thumbnail)
+	stream.StartTime = startTime
+
+	if err := validate(*stream); err != nil {
+		return nil, err
+	}
+
+	if err := repository.UpdateScheduledStream(*stream); err != nil {
+		return nil, err
+	}
+
+	return repository.GetScheduledStream(id)
+}
+
+// DeleteScheduledStream will remove an upcoming stream.
+func DeleteScheduledStream(id string) error {
+	return schedulerepository.Get().DeleteScheduledStream(id)
+}
+
+// GetUpcomingScheduledStreams will return the streams that haven't started
+// yet, soonest first.
+func GetUpcomingScheduledStreams() ([]models.ScheduledStream, error) {
+	return schedulerepository.Get().GetUpcomingScheduledStreams(time.Now())
+}
+
+func validate(stream models.ScheduledStream) error {
+	if stream.Title == "" {
+		return errors.New("a scheduled stream requires a title")
+	}
+	if len(stream.Title) > maxTitleLength {
+		return fmt.Errorf("the title can't be longer than %d characters", maxTitleLength)
+	}
+	if len(stream.Description) > maxDescriptionLength {
+		return fmt.Errorf("the description can't be longer than %d characters", maxDescriptionLength)
+	}
+	if !stream.StartTime.After(time.Now()) {
+		return errors.New("a scheduled stream must start in the future")
+	}
+
+	if stream.Thumbnail != "" {
+		thumbnailURL, err := url.Parse(stream.Thumbnail)
+		if err != nil || (thumbnailURL.Scheme != "http" && thumbnailURL.Scheme != "https") || thumbnailURL.Host == "" {
+			return errors.New("the thumbnail must be an http or https url")
+		}
+	}
+
+	return nil
+}
+
+// sendReminders will notify every channel about streams starting within
+// the configured reminder time.
+func sendReminders(now time.Time) {
+	minutes := configrepository.Get().GetScheduleReminderMinutes()
+	if minutes <= 0 {
+		return
+	}
+
+	repository := schedulerepository.Get()
+	streams, err := repository.GetScheduledStreamsNeedingReminder(now, now.Add(time.Duration(minutes)*time.Minute))
+	if err != nil {
+		log.Errorln("unable to fetch scheduled streams for reminders", err)
+		return
+	}
+	if len(streams) == 0 {
+		return
+	}
+
+	// No need to remind anyone about a stream that has already started.
+	if _getStatus != nil && _getStatus().Online {
+		return
+	}
+
+	notifier, err := notifications.New(data.GetDatastore())
+	if err != nil {
+		log.Errorln("unable to send scheduled stream reminders", err)
+		return
+	}
+
+	for _, stream := range streams {
+		// Mark the reminder as sent first so a failing channel can't cause
+		// the reminder to be sent again.
+		if err := repository.SetReminderSent(stream.ID, now); err != nil {
+			log.Errorln("unable to save scheduled stream reminder", err)
+			continue
+		}
+
+		notifier.NotifyMessage(reminderMessage(stream, now), stream.Title)
+	}
+}
+
+func reminderMessage(stream models.ScheduledStream, now time.Time) string {
+	minutes := int(math.Ceil(stream.StartTime.Sub(now).Minutes()))
+	if minutes == 1 {
+		return "Going live in 1 minute!"
+	}
+
+	return fmt.Sprintf("Going live in %d minutes!", minutes)
+}
@@ -26,6 +26,7 @@ func setupStats() error {
 	go func() {
 		for range statsSaveTimer.C {
 			saveStats()
+			saveViewerSessions(false)
 		}
 	}()
 
@@ -86,6 +87,8 @@ func SetViewerActive(viewer *models.Viewer) {
 	} else {
 		_stats.Viewers[viewer.ClientID] = viewer
 	}
+	trackViewerSession(viewer, time.Now())
+
 	_stats.SessionMaxViewerCount = int(math.Max(float64(len(_stats.Viewers)), float64(_stats.SessionMaxViewerCount)))
 	_stats.OverallMaxViewerCount = int(math.Max(float64(_stats.SessionMaxViewerCount), float64(_stats.OverallMaxViewerCount)))
 }
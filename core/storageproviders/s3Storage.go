@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/owncast/owncast/metrics/collectors"
 	"github.com/owncast/owncast/persistence/configrepository"
 	"github.com/owncast/owncast/utils"
 	"github.com/pkg/errors"
@@ -195,8 +196,16 @@ func (s *S3Storage) Save(filePath string, retryCount int) (string, error) {
 		uploadInput.ACL = aws.String("public-read")
 	}
 
+	fileType := collectors.FileTypeSegment
+	if path.Ext(filePath) == ".m3u8" {
+		fileType = collectors.FileTypePlaylist
+	}
+
+	start := time.Now()
 	response, err := s.uploader.Upload(uploadInput)
+	collectors.StorageUploadSeconds.WithLabelValues(fileType).Observe(time.Since(start).Seconds())
 	if err != nil {
+		collectors.StorageUploadFailures.WithLabelValues(fileType).Inc()
 		log.Traceln("error uploading segment", err.Error())
 		if retryCount < 4 {
 			log.Traceln("Retrying...")
@@ -10,14 +10,15 @@ import (
 	"github.com/owncast/owncast/activitypub"
 	"github.com/owncast/owncast/config"
 	"github.com/owncast/owncast/core/chat"
-	"github.com/owncast/owncast/core/data"
 	"github.com/owncast/owncast/core/rtmp"
 	"github.com/owncast/owncast/core/transcoder"
 	"github.com/owncast/owncast/core/webhooks"
 	"github.com/owncast/owncast/models"
 	"github.com/owncast/owncast/notifications"
+	"github.com/owncast/owncast/persistence/broadcastrepository"
 	"github.com/owncast/owncast/persistence/configrepository"
 	"github.com/owncast/owncast/utils"
+	"github.com/teris-io/shortid"
 )
 
 // After the stream goes offline this timer fires a full cleanup after N min.
@@ -30,7 +31,22 @@ var _currentBroadcast *models.CurrentBroadcast
 
 var _onlineTimerCancelFunc context.CancelFunc
 
-var _lastNotified *time.Time
+var _streamEndedHandler func(stream EndedStream)
+
+// EndedStream describes a stream that has just stopped.
+type EndedStream struct {
+	StartedAt       time.Time
+	BroadcastID     string
+	EndedAt         time.Time
+	Broadcaster     *models.Broadcaster
+	StreamTitle     string
+	PeakViewerCount int
+}
+
+// SetStreamEndedHandler will set the function called after a stream stops.
+func SetStreamEndedHandler(handler func(stream EndedStream)) {
+	_streamEndedHandler = handler
+}
 
 // setStreamAsConnected sets the stream as connected.
 func setStreamAsConnected(rtmpOut *io.PipeReader) {
@@ -43,9 +59,11 @@ func setStreamAsConnected(rtmpOut *io.PipeReader) {
 	configRepository := configrepository.Get()
 
 	_currentBroadcast = &models.CurrentBroadcast{
+		ID:             shortid.MustGenerate(),
 		LatencyLevel:   configRepository.GetStreamLatencyLevel(),
 		OutputSettings: configRepository.GetStreamOutputVariants(),
 	}
+	startBroadcastHistory(_currentBroadcast, now.Time)
 
 	StopOfflineCleanupTimer()
 	startOnlineCleanupTimer()
@@ -85,12 +103,35 @@ func setStreamAsConnected(rtmpOut *io.PipeReader) {
 // SetStreamAsDisconnected sets the stream as disconnected.
 func SetStreamAsDisconnected() {
 	_ = chat.SendSystemAction("The stream is ending.", true)
+	chat.ResetChatModes()
 
 	now := utils.NullTime{Time: time.Now(), Valid: true}
 	if _onlineTimerCancelFunc != nil {
 		_onlineTimerCancelFunc()
 	}
 
+	if _stats.LastConnectTime != nil {
+		go chat.ArchiveBroadcast(_stats.LastConnectTime.Time, now.Time)
+
+		var broadcastID string
+		if _currentBroadcast != nil {
+			broadcastID = _currentBroadcast.ID
+			endBroadcastHistory(broadcastID, now.Time, _broadcaster, _stats.SessionMaxViewerCount)
+			saveViewerSessions(true)
+		}
+
+		if _streamEndedHandler != nil {
+			go _streamEndedHandler(EndedStream{
+				BroadcastID:     broadcastID,
+				StartedAt:       _stats.LastConnectTime.Time,
+				EndedAt:         now.Time,
+				Broadcaster:     _broadcaster,
+				StreamTitle:     configrepository.Get().GetStreamTitle(),
+				PeakViewerCount: _stats.SessionMaxViewerCount,
+			})
+		}
+	}
+
 	_stats.StreamConnected = false
 	_stats.LastDisconnectTime = &now
 	_stats.LastConnectTime = nil
@@ -168,38 +209,41 @@ func stopOnlineCleanupTimer() {
 	}
 }
 
-func startLiveStreamNotificationsTimer() context.CancelFunc {
-	// Send delayed notification messages.
-	c, cancelFunc := context.WithCancel(context.Background())
-	_onlineTimerCancelFunc = cancelFunc
-	go func(c context.Context) {
-		select {
-		case <-time.After(time.Minute * 2.0):
-			if _lastNotified != nil && time.Since(*_lastNotified) < 10*time.Minute {
-				return
-			}
+// startBroadcastHistory will add the broadcast that just started to the
+// broadcast history.
+func startBroadcastHistory(broadcast *models.CurrentBroadcast, startedAt time.Time) {
+	configRepository := configrepository.Get()
 
-			configRepository := configrepository.Get()
-			// Send Fediverse message.
-			if configRepository.GetFederationEnabled() {
-				log.Traceln("Sending Federated Go Live message.")
-				if err := activitypub.SendLive(); err != nil {
-					log.Errorln(err)
-				}
-			}
+	tags := configRepository.GetServerMetadataTags()
+	if tags == nil {
+		tags = []string{}
+	}
 
-			// Send notification to those who have registered for them.
-			if notifier, err := notifications.New(data.GetDatastore()); err != nil {
-				log.Errorln(err)
-			} else {
-				notifier.Notify()
-			}
+	if err := broadcastrepository.Get().StartBroadcast(models.Broadcast{
+		ID:             broadcast.ID,
+		StartedAt:      startedAt,
+		Title:          configRepository.GetStreamTitle(),
+		Tags:           tags,
+		OutputSettings: broadcast.OutputSettings,
+		LatencyLevel:   broadcast.LatencyLevel.Level,
+	}); err != nil {
+		log.Errorln("unable to save broadcast to history", err)
+	}
+}
 
-			now := time.Now()
-			_lastNotified = &now
-		case <-c.Done():
-		}
-	}(c)
+// endBroadcastHistory will record the end of a broadcast in the broadcast
+// history.
+func endBroadcastHistory(id string, endedAt time.Time, broadcaster *models.Broadcaster, peakViewerCount int) {
+	if err := broadcastrepository.Get().EndBroadcast(id, endedAt, broadcaster, peakViewerCount); err != nil {
+		log.Errorln("unable to save the end of the broadcast to history", err)
+	}
+}
+
+func startLiveStreamNotificationsTimer() context.CancelFunc {
+	// Send delayed notification messages, following each channel's rule.
+	c, cancelFunc := context.WithCancel(context.Background())
+	_onlineTimerCancelFunc = cancelFunc
+	go notifications.NotifyGoLive(c, time.Now(), activitypub.SendLive)
 
 	return cancelFunc
 }
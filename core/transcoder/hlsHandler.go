@@ -1,16 +1,29 @@
 package transcoder
 
 import (
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/owncast/owncast/metrics/collectors"
 	"github.com/owncast/owncast/models"
 )
 
+// maxSegmentGenerationTime is the longest gap between segments that is
+// measured. Anything longer is the stream restarting.
+const maxSegmentGenerationTime = time.Minute
+
 // HLSHandler gets told about available HLS playlists and segments.
 type HLSHandler struct {
 	Storage models.StorageProvider
+
+	lastSegmentWritten map[string]time.Time
+	mu                 sync.Mutex
 }
 
 // SegmentWritten is fired when a HLS segment is written to disk.
 func (h *HLSHandler) SegmentWritten(localFilePath string) {
+	h.recordSegmentGenerationTime(localFilePath)
 	h.Storage.SegmentWritten(localFilePath)
 }
 
@@ -23,3 +36,25 @@ func (h *HLSHandler) VariantPlaylistWritten(localFilePath string) {
 func (h *HLSHandler) MasterPlaylistWritten(localFilePath string) {
 	h.Storage.MasterPlaylistWritten(localFilePath)
 }
+
+// recordSegmentGenerationTime will measure the time since the last segment
+// of the same variant was written. Segments are written to a directory
+// named after their variant's index.
+func (h *HLSHandler) recordSegmentGenerationTime(localFilePath string) {
+	variant := filepath.Base(filepath.Dir(localFilePath))
+	now := time.Now()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.lastSegmentWritten == nil {
+		h.lastSegmentWritten = map[string]time.Time{}
+	}
+
+	if last, ok := h.lastSegmentWritten[variant]; ok {
+		if elapsed := now.Sub(last); elapsed < maxSegmentGenerationTime {
+			collectors.SegmentGenerationSeconds.WithLabelValues(variant).Observe(elapsed.Seconds())
+		}
+	}
+	h.lastSegmentWritten[variant] = now
+}
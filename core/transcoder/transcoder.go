@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"io"
+	"os"
 	"os/exec"
 	"strconv"
 	"strings"
@@ -26,6 +27,9 @@ type Transcoder struct {
 
 	stdin *io.PipeReader
 
+	// Where ffmpeg reports its progress, if anywhere.
+	progressOutput string
+
 	TranscoderCompleted  func(error)
 	playlistOutputPath   string
 	ffmpegPath           string
@@ -114,6 +118,16 @@ func (t *Transcoder) Stop() {
 func (t *Transcoder) Start(shouldLog bool) {
 	_lastTranscoderLogMessage = ""
 
+	// Progress is reported on its own pipe so it doesn't get mixed in with
+	// the warnings and errors written to stderr.
+	progressReader, progressWriter, err := os.Pipe()
+	if err != nil {
+		log.Warnln("unable to follow transcoder progress", err)
+		t.progressOutput = ""
+	} else {
+		t.progressOutput = "pipe:3"
+	}
+
 	command := t.getString()
 	if shouldLog {
 		log.Infof("Processing video using codec %s with %d output qualities configured.", t.codec.DisplayName(), len(t.variants))
@@ -130,6 +144,11 @@ func (t *Transcoder) Start(shouldLog bool) {
 		_commandExec.Stdin = t.stdin
 	}
 
+	if progressWriter != nil {
+		// The first extra file is file descriptor 3 in the child process.
+		_commandExec.ExtraFiles = []*os.File{progressWriter}
+	}
+
 	stdout, err := _commandExec.StderrPipe()
 	if err != nil {
 		log.Fatalln(err)
@@ -148,6 +167,19 @@ func (t *Transcoder) Start(shouldLog bool) {
 		}
 	}()
 
+	if progressReader != nil {
+		// Only the transcoder writes progress, so the pipe closes with it.
+		progressWriter.Close()
+
+		go func() {
+			defer progressReader.Close()
+			scanner := bufio.NewScanner(progressReader)
+			for scanner.Scan() {
+				handleTranscoderProgress(scanner.Text())
+			}
+		}()
+	}
+
 	err = _commandExec.Wait()
 	if t.TranscoderCompleted != nil {
 		t.TranscoderCompleted(err)
@@ -168,6 +200,17 @@ func (t *Transcoder) SetIsEvent(isEvent bool) {
 	t.isEvent = isEvent
 }
 
+// getLoggingFlags will return the flags that control what ffmpeg reports
+// while it runs.
+func (t *Transcoder) getLoggingFlags() string {
+	flags := "-loglevel warning"
+	if t.progressOutput != "" {
+		flags += " -progress " + t.progressOutput
+	}
+
+	return flags
+}
+
 func (t *Transcoder) getString() string {
 	port := t.internalListenerPort
 	localListenerAddress := "http://127.0.0.1:" + port
@@ -201,7 +244,7 @@ func (t *Transcoder) getString() string {
 		fmt.Sprintf(`FFREPORT=file="%s":level=32`, logging.GetTranscoderLogFilePath()),
 		t.ffmpegPath,
 		"-hide_banner",
-		"-loglevel warning",
+		t.getLoggingFlags(),
 		t.codec.GlobalFlags(),
 		"-fflags +genpts", // Generate presentation time stamp if missing
 		"-flags +cgop",    // Force closed GOPs
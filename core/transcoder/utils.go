@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/owncast/owncast/config"
 	"github.com/owncast/owncast/persistence/configrepository"
@@ -18,6 +19,15 @@ var (
 	l                         = &sync.RWMutex{}
 )
 
+var (
+	_lastTranscoderSpeed    float64
+	_lastTranscoderSpeedSet time.Time
+)
+
+// Progress is reported every half second, so anything older means the
+// transcoder has stopped or stalled.
+const transcoderSpeedMaxAge = 10 * time.Second
+
 var errorMap = map[string]string{
 	"Unrecognized option 'vaapi_device'":        "you are likely trying to utilize a vaapi codec, but your version of ffmpeg or your hardware doesn't support it. change your codec to libx264 and restart your stream",
 	"unable to open display":                    "your copy of ffmpeg is likely installed via snap packages. please uninstall and re-install via a non-snap method.  https://owncast.online/docs/troubleshooting/#misc-video-issues",
@@ -98,6 +108,40 @@ func handleTranscoderMessage(message string) {
 	_lastTranscoderLogMessage = message
 }
 
+// handleTranscoderProgress will record the speed from a line of the
+// transcoder's progress report, which is made up of key=value lines.
+func handleTranscoderProgress(line string) {
+	key, value, _ := strings.Cut(line, "=")
+	if key != "speed" {
+		return
+	}
+
+	// Speed is reported as "1.01x", or "N/A" before any frames are written.
+	speed, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(value, "x")), 64)
+	if err != nil {
+		return
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	_lastTranscoderSpeed = speed
+	_lastTranscoderSpeedSet = time.Now()
+}
+
+// GetTranscoderSpeed will return how fast the transcoder is working compared
+// to real time, and false if it hasn't recently reported its progress.
+func GetTranscoderSpeed() (float64, bool) {
+	l.RLock()
+	defer l.RUnlock()
+
+	if time.Since(_lastTranscoderSpeedSet) > transcoderSpeedMaxAge {
+		return 0, false
+	}
+
+	return _lastTranscoderSpeed, true
+}
+
 func createVariantDirectories() {
 	// Create private hls data dirs
 	utils.CleanupDirectory(config.HLSStoragePath)
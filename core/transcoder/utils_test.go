@@ -0,0 +1,33 @@
+package transcoder
+
+import "testing"
+
+func TestHandleTranscoderProgress(t *testing.T) {
+	handleTranscoderProgress("frame=120")
+	handleTranscoderProgress("speed=N/A")
+	if _, ok := GetTranscoderSpeed(); ok {
+		t.Error("Expected no speed before one is reported")
+	}
+
+	handleTranscoderProgress("speed=   1x")
+	if speed, ok := GetTranscoderSpeed(); !ok || speed != 1 {
+		t.Errorf("Expected a speed of 1 but got %f", speed)
+	}
+
+	handleTranscoderProgress("speed=0.85x")
+	if speed, _ := GetTranscoderSpeed(); speed != 0.85 {
+		t.Errorf("Expected a speed of 0.85 but got %f", speed)
+	}
+}
+
+func TestProgressLoggingFlags(t *testing.T) {
+	transcoder := new(Transcoder)
+	if flags := transcoder.getLoggingFlags(); flags != "-loglevel warning" {
+		t.Errorf("Expected no progress to be reported but got %q", flags)
+	}
+
+	transcoder.progressOutput = "pipe:3"
+	if flags := transcoder.getLoggingFlags(); flags != "-loglevel warning -progress pipe:3" {
+		t.Errorf("Expected progress to be reported to its own pipe but got %q", flags)
+	}
+}
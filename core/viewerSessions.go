@@ -0,0 +1,95 @@
+package core
+
+import (
+	"time"
+
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/viewersessionrepository"
+	"github.com/owncast/owncast/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/teris-io/shortid"
+)
+
+// viewerSession tracks a viewer of the current broadcast. Only the
+// anonymous models.ViewerSession is ever saved.
+type viewerSession struct {
+	lastSeen  time.Time
+	ipAddress string
+	session   models.ViewerSession
+}
+
+// _viewerSessions are the viewers of the current broadcast, by client ID.
+// Guarded by l.
+var _viewerSessions = map[string]*viewerSession{}
+
+// trackViewerSession will record that a viewer is watching the current
+// broadcast. Must be called with l held.
+func trackViewerSession(viewer *models.Viewer, now time.Time) {
+	if _currentBroadcast == nil || utils.IsUserAgentABot(viewer.UserAgent) {
+		return
+	}
+
+	if s, exists := _viewerSessions[viewer.ClientID]; exists {
+		// Time away from the stream doesn't count as watching it.
+		if since := now.Sub(s.lastSeen); since < _activeViewerPurgeTimeout {
+			s.session.WatchSeconds += int(since.Seconds())
+		}
+		s.lastSeen = now
+		if s.session.Referrer == "" {
+			s.session.Referrer = viewer.Referrer
+		}
+		return
+	}
+
+	browser, os, deviceClass := utils.GetUserAgentDetails(viewer.UserAgent)
+	_viewerSessions[viewer.ClientID] = &viewerSession{
+		lastSeen:  now,
+		ipAddress: viewer.IPAddress,
+		session: models.ViewerSession{
+			ID:          shortid.MustGenerate(),
+			BroadcastID: _currentBroadcast.ID,
+			FirstSeen:   now,
+			Browser:     browser,
+			OS:          os,
+			DeviceClass: deviceClass,
+			Referrer:    viewer.Referrer,
+		},
+	}
+}
+
+// saveViewerSessions will save the viewers of the current broadcast. Viewers
+// who have left are then forgotten, as is everyone once the broadcast ends.
+func saveViewerSessions(broadcastEnded bool) {
+	now := time.Now()
+
+	l.Lock()
+	sessions := make([]viewerSession, 0, len(_viewerSessions))
+	for clientID, s := range _viewerSessions {
+		sessions = append(sessions, *s)
+		if now.Sub(s.lastSeen) >= _activeViewerPurgeTimeout {
+			delete(_viewerSessions, clientID)
+		}
+	}
+	if broadcastEnded {
+		_viewerSessions = map[string]*viewerSession{}
+	}
+	l.Unlock()
+
+	if len(sessions) == 0 {
+		return
+	}
+
+	toSave := make([]models.ViewerSession, 0, len(sessions))
+	for _, s := range sessions {
+		// Locations are looked up when saving as the lookup can be slow.
+		if geo := _geoIPClient.GetGeoFromIP(s.ipAddress); geo != nil {
+			s.session.CountryCode = geo.CountryCode
+			s.session.RegionName = geo.RegionName
+		}
+		toSave = append(toSave, s.session)
+	}
+
+	if err := viewersessionrepository.Get().SaveViewerSessions(toSave); err != nil {
+		log.Errorln("unable to save viewer sessions", err)
+	}
+}
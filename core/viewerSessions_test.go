@@ -0,0 +1,112 @@
+package core
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/viewersessionrepository"
+	"github.com/teris-io/shortid"
+)
+
+func TestMain(m *testing.M) {
+	dbFile, err := os.CreateTemp(os.TempDir(), "owncast-core-test-db.db")
+	if err != nil {
+		panic(err)
+	}
+	dbFile.Close()
+	defer os.Remove(dbFile.Name())
+
+	if err := data.SetupPersistence(dbFile.Name()); err != nil {
+		panic(err)
+	}
+
+	m.Run()
+}
+
+// startTestViewerSessions will reset the tracked viewers for a new
+// broadcast.
+func startTestViewerSessions() string {
+	_currentBroadcast = &models.CurrentBroadcast{ID: shortid.MustGenerate()}
+	_viewerSessions = map[string]*viewerSession{}
+
+	return _currentBroadcast.ID
+}
+
+func newTestViewer(clientID string) *models.Viewer {
+	return &models.Viewer{
+		ClientID:  clientID,
+		IPAddress: "127.0.0.1",
+		UserAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+	}
+}
+
+func TestViewerSessionWatchTime(t *testing.T) {
+	startTestViewerSessions()
+	viewer := newTestViewer("watching")
+	start := time.Now()
+
+	trackViewerSession(viewer, start)
+	trackViewerSession(viewer, start.Add(10*time.Second))
+	trackViewerSession(viewer, start.Add(20*time.Second))
+
+	// Time spent away from the stream is not counted.
+	trackViewerSession(viewer, start.Add(20*time.Second+time.Hour))
+	trackViewerSession(viewer, start.Add(30*time.Second+time.Hour))
+
+	session := _viewerSessions[viewer.ClientID].session
+	if session.WatchSeconds != 30 {
+		t.Errorf("expected 30 seconds watched, got %d", session.WatchSeconds)
+	}
+	if !session.FirstSeen.Equal(start) {
+		t.Errorf("session first seen at %s, expected %s", session.FirstSeen, start)
+	}
+	if session.Browser == "" || session.DeviceClass == "" {
+		t.Errorf("expected the viewer's browser and device, got %+v", session)
+	}
+}
+
+func TestViewerSessionsIgnoreBots(t *testing.T) {
+	startTestViewerSessions()
+	viewer := newTestViewer("bot")
+	viewer.UserAgent = "Googlebot/2.1 (+http://www.google.com/bot.html)"
+
+	trackViewerSession(viewer, time.Now())
+
+	if len(_viewerSessions) != 0 {
+		t.Error("bots should not be tracked as viewers")
+	}
+}
+
+func TestViewerSessionsClosedWhenViewersLeave(t *testing.T) {
+	broadcastID := startTestViewerSessions()
+	now := time.Now()
+
+	trackViewerSession(newTestViewer("left"), now.Add(-time.Minute))
+	trackViewerSession(newTestViewer("watching"), now)
+
+	saveViewerSessions(false)
+
+	if _, exists := _viewerSessions["left"]; exists {
+		t.Error("a viewer who left should no longer be tracked")
+	}
+	if _, exists := _viewerSessions["watching"]; !exists {
+		t.Error("a viewer who is still watching should still be tracked")
+	}
+
+	saveViewerSessions(true)
+
+	if len(_viewerSessions) != 0 {
+		t.Errorf("no viewers should be tracked once the broadcast ends, got %d", len(_viewerSessions))
+	}
+
+	analytics, err := viewersessionrepository.Get().GetViewerAnalytics(models.ViewerAnalyticsFilter{BroadcastID: broadcastID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if analytics.UniqueViewers != 2 {
+		t.Errorf("expected both viewers to be saved, got %d", analytics.UniqueViewers)
+	}
+}
@@ -0,0 +1,17 @@
+package webhooks
+
+import (
+	"github.com/owncast/owncast/models"
+)
+
+// SendAlertEvent will send all webhook destinations an alert that fired or
+// resolved.
+func SendAlertEvent(eventType models.EventType, alert models.Alert, message string) {
+	SendEventToWebhooks(WebhookEvent{
+		Type: eventType,
+		EventData: map[string]interface{}{
+			"alert":   alert,
+			"message": message,
+		},
+	})
+}
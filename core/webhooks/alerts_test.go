@@ -0,0 +1,37 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/models"
+)
+
+func TestSendAlertEvent(t *testing.T) {
+	alert := models.Alert{
+		ID:         "id",
+		RuleID:     "cpu",
+		Metric:     models.AlertMetricCPU,
+		Comparison: models.AlertAbove,
+		Severity:   models.AlertSeverityWarning,
+		Value:      92.5,
+		Threshold:  85,
+		FiredAt:    time.Unix(72, 6).UTC(),
+	}
+
+	checkPayload(t, models.AlertFired, func() {
+		SendAlertEvent(models.AlertFired, alert, "CPU utilization is high")
+	}, `{
+		"alert": {
+			"comparison": "above",
+			"firedAt": "1970-01-01T00:01:12.000000006Z",
+			"id": "id",
+			"metric": "cpu",
+			"ruleId": "cpu",
+			"severity": "warning",
+			"threshold": 85,
+			"value": 92.5
+		},
+		"message": "CPU utilization is high"
+	}`)
+}
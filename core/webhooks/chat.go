@@ -15,6 +15,7 @@ func SendChatEvent(chatEvent *events.UserMessageEvent) {
 			ClientID:  chatEvent.ClientID,
 			RawBody:   chatEvent.RawBody,
 			ID:        chatEvent.ID,
+			ReplyTo:   chatEvent.ReplyTo,
 			Visible:   chatEvent.HiddenAt == nil,
 			Timestamp: &chatEvent.Timestamp,
 		},
@@ -63,3 +64,48 @@ func SendChatEventSetMessageVisibility(event events.SetMessageVisibilityEvent) {
 
 	SendEventToWebhooks(webhookEvent)
 }
+
+// SendChatEventMessageReaction sends a webhook notifying that a user has
+// added or removed a reaction to a chat message.
+func SendChatEventMessageReaction(event events.MessageReactionEvent) {
+	eventType := models.MessageReactionAdded
+	if event.Removed {
+		eventType = models.MessageReactionRemoved
+	}
+
+	webhookEvent := WebhookEvent{
+		Type:      eventType,
+		EventData: event,
+	}
+
+	SendEventToWebhooks(webhookEvent)
+}
+
+// SendChatEventMessageEdited sends a webhook notifying that a user has
+// edited their chat message.
+func SendChatEventMessageEdited(event events.MessageEditedEvent) {
+	webhookEvent := WebhookEvent{
+		Type: models.MessageEdited,
+		EventData: &WebhookChatMessage{
+			User:      event.User,
+			Body:      event.Body,
+			RawBody:   event.RawBody,
+			ID:        event.MessageID,
+			Visible:   true,
+			Timestamp: &event.Timestamp,
+		},
+	}
+
+	SendEventToWebhooks(webhookEvent)
+}
+
+// SendChatEventPoll sends a webhook notifying that a chat poll has started
+// or ended.
+func SendChatEventPoll(eventType models.EventType, poll models.Poll) {
+	webhookEvent := WebhookEvent{
+		Type:      eventType,
+		EventData: poll,
+	}
+
+	SendEventToWebhooks(webhookEvent)
+}
@@ -0,0 +1,92 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/owncast/owncast/models"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// chatBotResponseTimeout is the longest a chat bot has to respond.
+	chatBotResponseTimeout = 5 * time.Second
+	// maxChatBotResponseSize is the most of a chat bot response that is read.
+	maxChatBotResponseSize = 64 * 1024
+)
+
+var (
+	chatBotActionHandler func(accessToken string, actions []models.ChatBotAction)
+
+	chatBotRateLimiters     = map[int]*rate.Limiter{}
+	chatBotRateLimitersLock sync.Mutex
+)
+
+// SetChatBotActionHandler will set the function that performs the chat
+// actions that chat bots respond to webhooks with.
+func SetChatBotActionHandler(handler func(accessToken string, actions []models.ChatBotAction)) {
+	chatBotActionHandler = handler
+}
+
+// newChatBotRateLimiter will return the limiter applied to the actions of a
+// single chat bot. Allows a burst of 5 actions then one every second.
+func newChatBotRateLimiter() *rate.Limiter {
+	return rate.NewLimiter(rate.Every(time.Second), 5)
+}
+
+func getChatBotRateLimiter(webhookID int) *rate.Limiter {
+	chatBotRateLimitersLock.Lock()
+	defer chatBotRateLimitersLock.Unlock()
+
+	limiter, ok := chatBotRateLimiters[webhookID]
+	if !ok {
+		limiter = newChatBotRateLimiter()
+		chatBotRateLimiters[webhookID] = limiter
+	}
+
+	return limiter
+}
+
+// handleChatBotResponse will perform the actions a chat bot responded to
+// a webhook with.
+func handleChatBotResponse(webhook models.Webhook, resp *http.Response) error {
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxChatBotResponseSize))
+	if err != nil {
+		return errors.Wrap(err, "unable to read chat bot response")
+	}
+
+	if len(body) == 0 {
+		return nil
+	}
+
+	var response models.ChatBotResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return errors.Wrap(err, "invalid chat bot response")
+	}
+
+	limiter := getChatBotRateLimiter(webhook.ID)
+	actions := make([]models.ChatBotAction, 0, len(response.Actions))
+	for _, action := range response.Actions {
+		if !limiter.Allow() {
+			log.Warnf("Chat bot %s is sending actions too quickly. %d actions were dropped.", webhook.URL, len(response.Actions)-len(actions))
+			break
+		}
+		actions = append(actions, action)
+	}
+
+	if chatBotActionHandler != nil && len(actions) > 0 {
+		// Actions can send webhooks of their own, so don't hold up this worker.
+		go chatBotActionHandler(webhook.AccessToken, actions)
+	}
+
+	return nil
+}
@@ -14,17 +14,21 @@ func SendStreamStatusEvent(eventType models.EventType) {
 }
 
 func sendStreamStatusEvent(eventType models.EventType, id string, timestamp time.Time) {
-	configRepository := configrepository.Get()
-
 	SendEventToWebhooks(WebhookEvent{
-		Type: eventType,
-		EventData: map[string]interface{}{
-			"id":          id,
-			"name":        configRepository.GetServerName(),
-			"summary":     configRepository.GetServerSummary(),
-			"streamTitle": configRepository.GetStreamTitle(),
-			"status":      getStatus(),
-			"timestamp":   timestamp,
-		},
+		Type:      eventType,
+		EventData: streamStatusEventData(id, timestamp),
 	})
 }
+
+func streamStatusEventData(id string, timestamp time.Time) map[string]interface{} {
+	configRepository := configrepository.Get()
+
+	return map[string]interface{}{
+		"id":          id,
+		"name":        configRepository.GetServerName(),
+		"summary":     configRepository.GetServerSummary(),
+		"streamTitle": configRepository.GetStreamTitle(),
+		"status":      getStatus(),
+		"timestamp":   timestamp,
+	}
+}
@@ -0,0 +1,160 @@
+package webhooks
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/owncast/owncast/models"
+	"github.com/pkg/errors"
+)
+
+// templateFuncs are available to webhook payload templates and filters,
+// along with the text/template builtins.
+var templateFuncs = template.FuncMap{
+	// json will encode a value so it can be safely placed in a JSON payload.
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	// isModerator will return if a user from an event is a moderator.
+	"isModerator": func(user interface{}) bool {
+		u, ok := user.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		scopes, _ := u["scopes"].([]interface{})
+		for _, scope := range scopes {
+			if scope == "MODERATOR" {
+				return true
+			}
+		}
+		return false
+	},
+	"contains":  strings.Contains,
+	"hasPrefix": strings.HasPrefix,
+	"lower":     strings.ToLower,
+	"upper":     strings.ToUpper,
+}
+
+// payloadTemplates are the parsed template and filter of a webhook, along
+// with the text they were parsed from.
+type payloadTemplates struct {
+	template     *template.Template
+	filter       *template.Template
+	templateText string
+	filterText   string
+}
+
+var (
+	// Parsed templates keyed by webhook ID.
+	parsedTemplates     = map[int]*payloadTemplates{}
+	parsedTemplatesLock sync.Mutex
+)
+
+// ValidateTemplate will return an error if a webhook payload template or
+// filter can't be used.
+func ValidateTemplate(text string) error {
+	_, err := parseTemplate(text)
+	return err
+}
+
+// ForgetPayloadTemplates will stop reusing the parsed template and filter
+// of a webhook that was changed or deleted.
+func ForgetPayloadTemplates(webhookID int) {
+	parsedTemplatesLock.Lock()
+	defer parsedTemplatesLock.Unlock()
+
+	delete(parsedTemplates, webhookID)
+}
+
+func parseTemplate(text string) (*template.Template, error) {
+	return template.New("webhook").Funcs(templateFuncs).Option("missingkey=zero").Parse(text)
+}
+
+// getPayloadTemplates will return the parsed template and filter of a
+// webhook, reusing them across events until they change.
+func getPayloadTemplates(webhook models.Webhook) (*payloadTemplates, error) {
+	parsedTemplatesLock.Lock()
+	defer parsedTemplatesLock.Unlock()
+
+	if t, ok := parsedTemplates[webhook.ID]; ok && t.templateText == webhook.Template && t.filterText == webhook.Filter {
+		return t, nil
+	}
+
+	t := &payloadTemplates{templateText: webhook.Template, filterText: webhook.Filter}
+
+	var err error
+	if webhook.Filter != "" {
+		if t.filter, err = parseTemplate(webhook.Filter); err != nil {
+			return nil, errors.Wrap(err, "unable to run webhook filter")
+		}
+	}
+	if webhook.Template != "" {
+		if t.template, err = parseTemplate(webhook.Template); err != nil {
+			return nil, errors.Wrap(err, "unable to render webhook template")
+		}
+	}
+
+	parsedTemplates[webhook.ID] = t
+
+	return t, nil
+}
+
+func executeTemplate(t *template.Template, data interface{}) ([]byte, error) {
+	var out bytes.Buffer
+	if err := t.Execute(&out, data); err != nil {
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
+// renderPayload will return the body to send a webhook for an event, and
+// false if the webhook's filter doesn't want the event.
+//
+// Templates and filters are run against the event as it would be sent as
+// JSON, so they use the same field names, e.g. {{.eventData.user.displayName}}.
+func renderPayload(webhook models.Webhook, payload WebhookEvent) ([]byte, bool, error) {
+	jsonText, err := json.Marshal(payload)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if webhook.Template == "" && webhook.Filter == "" {
+		return jsonText, true, nil
+	}
+
+	templates, err := getPayloadTemplates(webhook)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(jsonText, &data); err != nil {
+		return nil, false, err
+	}
+
+	if templates.filter != nil {
+		result, err := executeTemplate(templates.filter, data)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "unable to run webhook filter")
+		}
+		if strings.TrimSpace(string(result)) != "true" {
+			return nil, false, nil
+		}
+	}
+
+	if templates.template == nil {
+		return jsonText, true, nil
+	}
+
+	body, err := executeTemplate(templates.template, data)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "unable to render webhook template")
+	}
+
+	return body, true, nil
+}
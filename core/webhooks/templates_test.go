@@ -0,0 +1,94 @@
+package webhooks
+
+import (
+	"testing"
+
+	"github.com/owncast/owncast/models"
+)
+
+func testChatPayload(user *models.User) WebhookEvent {
+	return WebhookEvent{
+		Type: models.MessageSent,
+		EventData: &WebhookChatMessage{
+			User:    user,
+			Body:    "<p>hello \"world\"</p>",
+			RawBody: "hello \"world\"",
+		},
+	}
+}
+
+func TestRenderPayloadTemplate(t *testing.T) {
+	webhook := models.Webhook{
+		Template: `{"text": {{json (printf "%s: %s" .eventData.user.displayName .eventData.rawBody)}}}`,
+	}
+
+	body, wanted, err := renderPayload(webhook, testChatPayload(&models.User{DisplayName: "Bob"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !wanted {
+		t.Fatal("Expected an unfiltered webhook to want the event")
+	}
+
+	if expected := `{"text": "Bob: hello \"world\""}`; string(body) != expected {
+		t.Errorf("Expected %s but got %s", expected, body)
+	}
+}
+
+func TestRenderPayloadFilter(t *testing.T) {
+	webhook := models.Webhook{
+		Filter: `{{isModerator .eventData.user}}`,
+	}
+
+	moderator := &models.User{DisplayName: "Mod", Scopes: []string{"MODERATOR"}}
+	if _, wanted, err := renderPayload(webhook, testChatPayload(moderator)); err != nil || !wanted {
+		t.Errorf("Expected messages from moderators to be sent. %v", err)
+	}
+
+	user := &models.User{DisplayName: "Bob"}
+	if _, wanted, err := renderPayload(webhook, testChatPayload(user)); err != nil || wanted {
+		t.Errorf("Expected messages from other users to be filtered. %v", err)
+	}
+
+	webhook.Filter = `{{eq .eventData.user.displayName "Bob"}}`
+	if _, wanted, err := renderPayload(webhook, testChatPayload(user)); err != nil || !wanted {
+		t.Errorf("Expected messages from Bob to be sent. %v", err)
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	if err := ValidateTemplate(`{{.eventData.body}}`); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateTemplate(`{{.eventData.body`); err == nil {
+		t.Error("Expected an unterminated action to be invalid")
+	}
+	if err := ValidateTemplate(`{{unknownFunc .type}}`); err == nil {
+		t.Error("Expected an unknown function to be invalid")
+	}
+}
+
+func TestPayloadTemplatesAreCachedByWebhook(t *testing.T) {
+	webhook := models.Webhook{ID: 1234, Template: `{{.type}}`}
+
+	first, err := getPayloadTemplates(webhook)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second, _ := getPayloadTemplates(webhook); second != first {
+		t.Error("Expected the parsed template to be reused")
+	}
+
+	webhook.Template = `{{.eventData}}`
+	if changed, _ := getPayloadTemplates(webhook); changed == first || changed.templateText != webhook.Template {
+		t.Error("Expected a changed template to be parsed again")
+	}
+
+	ForgetPayloadTemplates(webhook.ID)
+	parsedTemplatesLock.Lock()
+	_, cached := parsedTemplates[webhook.ID]
+	parsedTemplatesLock.Unlock()
+	if cached {
+		t.Error("Expected the templates of a forgotten webhook to be removed")
+	}
+}
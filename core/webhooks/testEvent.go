@@ -0,0 +1,92 @@
+package webhooks
+
+import (
+	"time"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/models"
+	"github.com/teris-io/shortid"
+)
+
+// TestEventResult is what a webhook was sent for a test event.
+type TestEventResult struct {
+	ContentType string `json:"contentType"`
+	Body        string `json:"body"`
+	// Sent is false when the webhook's filter didn't want the event.
+	Sent bool `json:"sent"`
+}
+
+// SendTestEvent will send a webhook an example of an event, rendered with
+// its template and filter, and return what was sent.
+func SendTestEvent(webhook models.Webhook, eventType models.EventType) (*TestEventResult, error) {
+	payload := WebhookEvent{
+		Type:      eventType,
+		EventData: testEventData(eventType),
+	}
+
+	body, wanted, err := renderPayload(webhook, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TestEventResult{
+		ContentType: "application/json",
+		Body:        string(body),
+		Sent:        wanted,
+	}
+	if webhook.ContentType != "" {
+		result.ContentType = webhook.ContentType
+	}
+
+	if wanted {
+		addToQueue(webhook, eventType, body, nil)
+	}
+
+	return result, nil
+}
+
+// testEventData will return example data shaped like the real data sent
+// for an event type.
+func testEventData(eventType models.EventType) interface{} {
+	now := time.Now()
+	user := &models.User{
+		ID:           "test-user",
+		DisplayName:  "Test User",
+		DisplayColor: 3,
+		CreatedAt:    now,
+	}
+	event := events.Event{
+		Type:      eventType,
+		ID:        shortid.MustGenerate(),
+		Timestamp: now,
+	}
+
+	switch eventType {
+	case models.StreamStarted, models.StreamStopped, models.StreamTitleUpdated:
+		return streamStatusEventData(event.ID, now)
+	case models.UserJoined:
+		return events.UserJoinedEvent{Event: event, UserEvent: events.UserEvent{User: user}}
+	case models.UserParted:
+		return events.UserPartEvent{Event: event, UserEvent: events.UserEvent{User: user}}
+	case models.UserNameChanged:
+		return events.NameChangeEvent{Event: event, UserEvent: events.UserEvent{User: user}, NewName: "New Test User"}
+	case models.PollStarted, models.PollEnded:
+		return models.Poll{
+			ID:         event.ID,
+			Question:   "Is this a test?",
+			Options:    []models.PollOption{{Text: "Yes", Votes: 2}, {Text: "No", Votes: 1}},
+			TotalVotes: 3,
+			StartedAt:  now,
+			EndsAt:     now.Add(time.Minute),
+		}
+	default:
+		return &WebhookChatMessage{
+			User:      user,
+			Body:      "<p>This is a test message from Owncast.</p>",
+			RawBody:   "This is a test message from Owncast.",
+			ID:        event.ID,
+			Timestamp: &now,
+			Visible:   true,
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/owncast/owncast/models"
 	"github.com/owncast/owncast/persistence/webhookrepository"
+	log "github.com/sirupsen/logrus"
 )
 
 // WebhookEvent represents an event sent as a webhook.
@@ -21,6 +22,7 @@ type WebhookChatMessage struct {
 	Body      string       `json:"body,omitempty"`
 	RawBody   string       `json:"rawBody,omitempty"`
 	ID        string       `json:"id,omitempty"`
+	ReplyTo   string       `json:"replyTo,omitempty"`
 	ClientID  uint         `json:"clientId,omitempty"`
 	Visible   bool         `json:"visible"`
 }
@@ -35,10 +37,20 @@ func sendEventToWebhooks(payload WebhookEvent, wg *sync.WaitGroup) {
 	webhooks := webhooksRepo.GetWebhooksForEvent(payload.Type)
 
 	for _, webhook := range webhooks {
+		body, wanted, err := renderPayload(webhook, payload)
+		if err != nil {
+			log.Errorf("Event: %s could not be sent to webhook: %s Error: %s", payload.Type, webhook.URL, err)
+			continue
+		}
+		if !wanted {
+			log.Tracef("Event %s filtered out for Webhook %s", payload.Type, webhook.URL)
+			continue
+		}
+
 		// Use wg to track the number of notifications to be sent.
 		if wg != nil {
 			wg.Add(1)
 		}
-		addToQueue(webhook, payload, wg)
+		addToQueue(webhook, payload.Type, body, wg)
 	}
 }
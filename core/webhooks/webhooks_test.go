@@ -2,8 +2,12 @@ package webhooks
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -143,6 +147,237 @@ func TestRouting(t *testing.T) {
 	}
 }
 
+// Make sure chat bot responses are handed off as actions, and limited.
+func TestChatBotResponse(t *testing.T) {
+	response := models.ChatBotResponse{}
+	for i := 0; i < 7; i++ {
+		response.Actions = append(response.Actions, models.ChatBotAction{
+			Type: models.ChatBotActionReply,
+			Body: fmt.Sprintf("reply %d", i),
+		})
+	}
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer svr.Close()
+
+	received := make(chan []models.ChatBotAction, 1)
+	SetChatBotActionHandler(func(accessToken string, actions []models.ChatBotAction) {
+		if accessToken != "bot-token" {
+			t.Errorf("Expected actions for bot-token but got %q", accessToken)
+		}
+		received <- actions
+	})
+	defer SetChatBotActionHandler(nil)
+
+	webhooksRepo := webhookrepository.Get()
+	hook, err := webhooksRepo.InsertChatBotWebhook(svr.URL, []models.EventType{models.MessageSent}, "bot-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := webhooksRepo.DeleteWebhook(hook); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	var wg sync.WaitGroup
+	sendEventToWebhooks(WebhookEvent{EventData: struct{}{}, Type: models.MessageSent}, &wg)
+	wg.Wait()
+
+	select {
+	case actions := <-received:
+		if len(actions) != 5 {
+			t.Errorf("Expected the bot to be limited to 5 actions but got %d", len(actions))
+		}
+		if actions[0].Body != "reply 0" {
+			t.Errorf("Expected actions in order but got %q first", actions[0].Body)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Chat bot actions were never handled")
+	}
+}
+
+// Make sure payloads are signed with the webhook secret and logged.
+func TestSignedDelivery(t *testing.T) {
+	var signature, deliveryID string
+	var body []byte
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get(webhookSignatureHeader)
+		deliveryID = r.Header.Get(webhookDeliveryHeader)
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer svr.Close()
+
+	webhooksRepo := webhookrepository.Get()
+	hook, err := webhooksRepo.InsertWebhook(svr.URL, []models.EventType{models.StreamTitleUpdated})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := webhooksRepo.DeleteWebhook(hook); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	webhook, err := webhooksRepo.GetWebhook(hook)
+	if err != nil || webhook == nil || webhook.Secret == "" {
+		t.Fatalf("Expected the webhook to have a secret. %v", err)
+	}
+
+	var wg sync.WaitGroup
+	sendEventToWebhooks(WebhookEvent{EventData: struct{}{}, Type: models.StreamTitleUpdated}, &wg)
+	wg.Wait()
+
+	mac := hmac.New(sha256.New, []byte(webhook.Secret))
+	mac.Write(body)
+	if expected := "sha256=" + hex.EncodeToString(mac.Sum(nil)); signature != expected {
+		t.Errorf("Expected signature %s but got %s", expected, signature)
+	}
+
+	deliveries, total, err := webhooksRepo.GetDeliveries(hook, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 {
+		t.Fatalf("Expected 1 logged delivery but got %d", total)
+	}
+	delivery := deliveries[0]
+	if fmt.Sprint(delivery.ID) != deliveryID {
+		t.Errorf("Expected delivery header %d but got %s", delivery.ID, deliveryID)
+	}
+	if !delivery.Success || delivery.StatusCode != http.StatusOK || delivery.Attempts != 1 {
+		t.Errorf("Unexpected delivery log %+v", delivery)
+	}
+	if !bytes.Equal(delivery.Payload, body) {
+		t.Errorf("Expected the logged payload to match what was sent")
+	}
+}
+
+// Make sure failed deliveries are retried until they succeed.
+func TestDeliveryRetries(t *testing.T) {
+	originalBackoff := webhookRetryBackoff
+	webhookRetryBackoff = 10 * time.Millisecond
+	defer func() { webhookRetryBackoff = originalBackoff }()
+
+	var calls int32
+	var deliveryIDs sync.Map
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deliveryIDs.Store(r.Header.Get(webhookDeliveryHeader), true)
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer svr.Close()
+
+	webhooksRepo := webhookrepository.Get()
+	hook, err := webhooksRepo.InsertWebhook(svr.URL, []models.EventType{models.StreamTitleUpdated})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := webhooksRepo.DeleteWebhook(hook); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	var wg sync.WaitGroup
+	sendEventToWebhooks(WebhookEvent{EventData: struct{}{}, Type: models.StreamTitleUpdated}, &wg)
+	wg.Wait()
+
+	// Retries happen after the first attempt is done with.
+	deadline := time.Now().Add(5 * time.Second)
+	for atomic.LoadInt32(&calls) < 3 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	if calls != 3 {
+		t.Errorf("Expected 3 attempts but got %d", calls)
+	}
+
+	uniqueIDs := 0
+	deliveryIDs.Range(func(_, _ interface{}) bool {
+		uniqueIDs++
+		return true
+	})
+	if uniqueIDs != 1 {
+		t.Errorf("Expected every attempt to share a delivery ID but got %d IDs", uniqueIDs)
+	}
+
+	deliveries, _, err := webhooksRepo.GetDeliveries(hook, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 || !deliveries[0].Success || deliveries[0].Attempts != 3 {
+		t.Errorf("Unexpected delivery log %+v", deliveries)
+	}
+}
+
+// Make sure failures that won't go away on their own aren't retried.
+func TestPermanentFailuresAreNotRetried(t *testing.T) {
+	originalBackoff := webhookRetryBackoff
+	webhookRetryBackoff = 10 * time.Millisecond
+	defer func() { webhookRetryBackoff = originalBackoff }()
+
+	var calls int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer svr.Close()
+
+	webhooksRepo := webhookrepository.Get()
+	hook, err := webhooksRepo.InsertWebhook(svr.URL, []models.EventType{models.StreamTitleUpdated})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := webhooksRepo.DeleteWebhook(hook); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	var wg sync.WaitGroup
+	sendEventToWebhooks(WebhookEvent{EventData: struct{}{}, Type: models.StreamTitleUpdated}, &wg)
+	wg.Wait()
+	time.Sleep(100 * time.Millisecond)
+
+	if calls != 1 {
+		t.Errorf("Expected a single attempt but got %d", calls)
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		attempts   int
+		webhook    models.WebhookType
+		want       bool
+	}{
+		{0, 1, models.WebhookTypeStandard, true},
+		{http.StatusServiceUnavailable, 1, models.WebhookTypeStandard, true},
+		{http.StatusTooManyRequests, 1, models.WebhookTypeStandard, true},
+		{http.StatusBadRequest, 1, models.WebhookTypeStandard, false},
+		{http.StatusGone, 1, models.WebhookTypeStandard, false},
+		{http.StatusInternalServerError, webhookMaxAttempts, models.WebhookTypeStandard, false},
+		{http.StatusInternalServerError, 1, models.WebhookTypeChatBot, false},
+	}
+
+	for _, test := range tests {
+		job := Job{
+			webhook:  models.Webhook{Type: test.webhook},
+			delivery: models.WebhookDelivery{StatusCode: test.statusCode, Attempts: test.attempts},
+		}
+		if got := shouldRetry(job); got != test.want {
+			t.Errorf("shouldRetry(%d, attempt %d, %s) = %v, want %v", test.statusCode, test.attempts, test.webhook, got, test.want)
+		}
+	}
+}
+
 // Make sure that events are sent to all interested endpoints.
 func TestMultiple(t *testing.T) {
 	const times = 2
@@ -2,25 +2,54 @@ package webhooks
 
 import (
 	"bytes"
-	"encoding/json"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"net/http"
 	"runtime"
+	"strconv"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/owncast/owncast/metrics/collectors"
 	"github.com/owncast/owncast/models"
 	"github.com/owncast/owncast/persistence/webhookrepository"
+	"github.com/owncast/owncast/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
 // webhookWorkerPoolSize defines the number of concurrent HTTP webhook requests.
 var webhookWorkerPoolSize = runtime.GOMAXPROCS(0)
 
+const (
+	// webhookMaxAttempts is how many times an event is sent to a webhook
+	// before giving up on it.
+	webhookMaxAttempts = 5
+	// webhookRequestTimeout is the longest a single attempt can take.
+	webhookRequestTimeout = 30 * time.Second
+	// webhookSignatureHeader holds the HMAC-SHA256 signature of the payload,
+	// made with the webhook's secret.
+	webhookSignatureHeader = "X-Owncast-Signature"
+	// webhookDeliveryHeader holds the delivery ID, which is the same across
+	// retries so receivers can ignore duplicates.
+	webhookDeliveryHeader = "X-Owncast-Delivery"
+)
+
+// webhookRetryBackoff is how long to wait before the first retry. It is
+// doubled for each retry after that.
+var webhookRetryBackoff = 5 * time.Second
+
 // Job struct bundling the webhook and the payload in one struct.
 type Job struct {
-	wg      *sync.WaitGroup
-	payload WebhookEvent
-	webhook models.Webhook
+	wg       *sync.WaitGroup
+	webhook  models.Webhook
+	delivery models.WebhookDelivery
 }
 
 var (
@@ -44,45 +73,165 @@ func initWorkerPool() {
 	}
 }
 
-func addToQueue(webhook models.Webhook, payload WebhookEvent, wg *sync.WaitGroup) {
-	log.Tracef("Queued Event %s for Webhook %s", payload.Type, webhook.URL)
-	queue <- Job{wg, payload, webhook}
+func addToQueue(webhook models.Webhook, eventType models.EventType, payload []byte, wg *sync.WaitGroup) {
+	log.Tracef("Queued Event %s for Webhook %s", eventType, webhook.URL)
+
+	queue <- Job{
+		wg:      wg,
+		webhook: webhook,
+		delivery: models.WebhookDelivery{
+			WebhookID: webhook.ID,
+			EventType: eventType,
+			Payload:   payload,
+		},
+	}
 }
 
 func worker(workerID int, queue <-chan Job) {
 	log.Debugf("Started Webhook worker %d", workerID)
 
 	for job := range queue {
-		log.Debugf("Event %s sent to Webhook %s using worker %d", job.payload.Type, job.webhook.URL, workerID)
+		log.Debugf("Event %s sent to Webhook %s using worker %d", job.delivery.EventType, job.webhook.URL, workerID)
 
-		if err := sendWebhook(job); err != nil {
-			log.Errorf("Event: %s failed to send to webhook: %s Error: %s", job.payload.Type, job.webhook.URL, err)
+		err := sendWebhook(&job)
+		if err != nil {
+			log.Errorf("Event: %s failed to send to webhook: %s Error: %s", job.delivery.EventType, job.webhook.URL, err)
 		}
-		log.Tracef("Done with Event %s to Webhook %s using worker %d", job.payload.Type, job.webhook.URL, workerID)
+
+		// Retries happen in the background, so whoever is waiting on the
+		// event is only kept waiting for the first attempt.
+		log.Tracef("Done with Event %s to Webhook %s using worker %d", job.delivery.EventType, job.webhook.URL, workerID)
 		if job.wg != nil {
 			job.wg.Done()
+			job.wg = nil
+		}
+
+		if err != nil && shouldRetry(job) {
+			scheduleRetry(job, webhookRetryBackoff<<(job.delivery.Attempts-1))
 		}
 	}
 }
 
-func sendWebhook(job Job) error {
-	jsonText, err := json.Marshal(job.payload)
+// shouldRetry will return if a failed delivery should be attempted again.
+// Only failures that may be temporary are retried. Chat bot actions are
+// only useful right away, so they are never retried.
+func shouldRetry(job Job) bool {
+	if job.webhook.Type == models.WebhookTypeChatBot || job.delivery.Attempts >= webhookMaxAttempts {
+		return false
+	}
+
+	// A status code of 0 means there was no response at all.
+	statusCode := job.delivery.StatusCode
+	return statusCode == 0 || statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// scheduleRetry will queue a delivery again after a delay. If every worker
+// is busy by then it waits again rather than holding on to a goroutine.
+func scheduleRetry(job Job, delay time.Duration) {
+	log.Debugf("Retrying Event %s to Webhook %s in %s", job.delivery.EventType, job.webhook.URL, delay)
+
+	time.AfterFunc(delay, func() {
+		select {
+		case queue <- job:
+		default:
+			scheduleRetry(job, webhookRetryBackoff)
+		}
+	})
+}
+
+// signPayload will return the signature of a payload made with a webhook
+// secret, in the form "sha256=<hex digest>".
+func signPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// sendWebhook will make a single attempt at a delivery and record its
+// outcome in the delivery log.
+func sendWebhook(job *Job) error {
+	webhooksRepo := webhookrepository.Get()
+
+	if job.delivery.ID == 0 {
+		id, err := webhooksRepo.InsertDelivery(job.delivery)
+		if err != nil {
+			log.Warnln("unable to log webhook delivery", err)
+		}
+		job.delivery.ID = id
+	}
+
+	job.delivery.Attempts++
+
+	ctx, span := tracing.Start(context.Background(), "webhooks.send",
+		attribute.String("webhook.event_type", job.delivery.EventType),
+		attribute.Int("webhook.id", job.webhook.ID),
+		attribute.Int("webhook.delivery_id", job.delivery.ID),
+		attribute.Int("webhook.attempt", job.delivery.Attempts),
+	)
+	statusCode, latency, err := attemptDelivery(ctx, job)
+	span.SetAttributes(semconv.HTTPResponseStatusCode(statusCode))
+	tracing.End(span, err)
+
+	recordDeliveryMetrics(job.delivery.EventType, latency, err)
+	job.delivery.StatusCode = statusCode
+	job.delivery.LatencyMs = latency.Milliseconds()
+	job.delivery.Success = err == nil
+	job.delivery.Error = ""
 	if err != nil {
-		return err
+		job.delivery.Error = err.Error()
+	}
+
+	if job.delivery.ID != 0 {
+		if err := webhooksRepo.UpdateDelivery(job.delivery); err != nil {
+			log.Warnln("unable to log webhook delivery", err)
+		}
 	}
 
-	req, err := http.NewRequest("POST", job.webhook.URL, bytes.NewReader(jsonText))
+	return err
+}
+
+func recordDeliveryMetrics(eventType models.EventType, latency time.Duration, err error) {
+	result := collectors.ResultSuccess
 	if err != nil {
-		return err
+		result = collectors.ResultFailure
 	}
 
-	req.Header.Set("Content-Type", "application/json")
+	collectors.WebhookDeliveries.WithLabelValues(eventType, result).Inc()
+	collectors.WebhookDeliverySeconds.Observe(latency.Seconds())
+}
 
-	client := &http.Client{}
+func attemptDelivery(ctx context.Context, job *Job) (int, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", job.webhook.URL, bytes.NewReader(job.delivery.Payload))
+	if err != nil {
+		return 0, 0, err
+	}
+	tracing.Inject(ctx, req)
+
+	contentType := "application/json"
+	if job.webhook.ContentType != "" {
+		contentType = job.webhook.ContentType
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	if job.delivery.ID != 0 {
+		req.Header.Set(webhookDeliveryHeader, strconv.Itoa(job.delivery.ID))
+	}
+	if job.webhook.Secret != "" {
+		req.Header.Set(webhookSignatureHeader, signPayload(job.webhook.Secret, job.delivery.Payload))
+	}
+
+	client := &http.Client{Timeout: webhookRequestTimeout}
 
+	// Chat bots respond with actions, so they need to respond quickly.
+	if job.webhook.Type == models.WebhookTypeChatBot {
+		client.Timeout = chatBotResponseTimeout
+	}
+
+	start := time.Now()
 	resp, err := client.Do(req)
+	latency := time.Since(start)
 	if err != nil {
-		return err
+		return 0, latency, err
 	}
 
 	defer resp.Body.Close()
@@ -92,5 +241,48 @@ func sendWebhook(job Job) error {
 		log.Warnln(err)
 	}
 
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, latency, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	if job.webhook.Type == models.WebhookTypeChatBot {
+		return resp.StatusCode, latency, handleChatBotResponse(job.webhook, resp)
+	}
+
+	return resp.StatusCode, latency, nil
+}
+
+// ReplayDelivery will send the payload of a logged delivery to its webhook
+// again, as a new delivery.
+func ReplayDelivery(id int) error {
+	webhooksRepo := webhookrepository.Get()
+
+	delivery, err := webhooksRepo.GetDelivery(id)
+	if err != nil {
+		return err
+	}
+	if delivery == nil {
+		return errors.New("delivery not found")
+	}
+
+	webhook, err := webhooksRepo.GetWebhook(delivery.WebhookID)
+	if err != nil {
+		return err
+	}
+	if webhook == nil {
+		return errors.New("webhook no longer exists")
+	}
+
+	log.Tracef("Replaying delivery %d of Event %s to Webhook %s", id, delivery.EventType, webhook.URL)
+
+	queue <- Job{
+		webhook: *webhook,
+		delivery: models.WebhookDelivery{
+			WebhookID: webhook.ID,
+			EventType: delivery.EventType,
+			Payload:   delivery.Payload,
+		},
+	}
+
 	return nil
 }
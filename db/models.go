@@ -63,6 +63,9 @@ type Message struct {
 	Subtitle  sql.NullString
 	Image     sql.NullString
 	Link      sql.NullString
+	ReplyTo   sql.NullString
+	EditedAt  sql.NullTime
+	DeletedAt sql.NullTime
 }
 
 type Notification struct {
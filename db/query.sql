@@ -5,6 +5,9 @@
 -- name: GetFollowerCount :one
 SElECT count(*) FROM ap_followers WHERE approved_at is not null;
 
+-- name: GetFollowerCountApprovedBetween :one
+SELECT count(*) FROM ap_followers WHERE approved_at >= $1 AND approved_at <= $2 AND disabled_at is null;
+
 -- name: GetLocalPostCount :one
 SElECT count(*) FROM ap_outbox;
 
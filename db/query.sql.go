@@ -333,6 +333,22 @@ func (q *Queries) GetFollowerCount(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const getFollowerCountApprovedBetween = `-- name: GetFollowerCountApprovedBetween :one
+SELECT count(*) FROM ap_followers WHERE approved_at >= $1 AND approved_at <= $2 AND disabled_at is null
+`
+
+type GetFollowerCountApprovedBetweenParams struct {
+	ApprovedAt   sql.NullTime
+	ApprovedAt_2 sql.NullTime
+}
+
+func (q *Queries) GetFollowerCountApprovedBetween(ctx context.Context, arg GetFollowerCountApprovedBetweenParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getFollowerCountApprovedBetween, arg.ApprovedAt, arg.ApprovedAt_2)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getIPAddressBans = `-- name: GetIPAddressBans :many
 SELECT ip_address, notes, created_at FROM ip_bans
 `
@@ -91,6 +91,9 @@ CREATE TABLE IF NOT EXISTS messages (
     "subtitle" TEXT,
     "image" TEXT,
     "link" TEXT,
+    "reply_to" TEXT,
+    "edited_at" DATE,
+    "deleted_at" DATE,
 		PRIMARY KEY (id)
 	);CREATE INDEX index ON messages (id, user_id, hidden_at, timestamp);
 	CREATE INDEX id ON messages (id);
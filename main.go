@@ -1,9 +1,13 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/owncast/owncast/logging"
 	"github.com/owncast/owncast/persistence/configrepository"
@@ -13,6 +17,8 @@ import (
 	"github.com/owncast/owncast/core"
 	"github.com/owncast/owncast/core/data"
 	"github.com/owncast/owncast/metrics"
+	"github.com/owncast/owncast/reports"
+	"github.com/owncast/owncast/tracing"
 	"github.com/owncast/owncast/utils"
 	"github.com/owncast/owncast/webserver/router"
 )
@@ -29,6 +35,7 @@ var (
 	webServerPortOverride = flag.String("webserverport", "", "Force the web server to listen on a specific port")
 	webServerIPOverride   = flag.String("webserverip", "", "Force web server to listen on this IP address")
 	rtmpPortOverride      = flag.Int("rtmpport", 0, "Set listen port for the RTMP server")
+	otelEndpoint          = flag.String("otelEndpoint", "", "Send OpenTelemetry traces to this OTLP HTTP endpoint, e.g. http://localhost:4318")
 )
 
 // nolint:cyclop
@@ -72,6 +79,14 @@ func main() {
 	configureLogging(*enableDebugOptions, *enableVerboseLogging)
 	log.Infoln(config.GetReleaseString())
 
+	shutdownTracing, err := tracing.Setup(*otelEndpoint)
+	if err != nil {
+		log.Errorln("unable to set up OpenTelemetry tracing", err)
+	}
+	if shutdownTracing != nil {
+		shutdownTracingOnExit(shutdownTracing)
+	}
+
 	// Allows a user to restore a specific database backup
 	if *restoreDatabaseFile != "" {
 		databaseFile := config.DatabaseFilePath
@@ -105,12 +120,33 @@ func main() {
 	}
 
 	go metrics.Start(core.GetStatus)
+	reports.Start()
 
 	if err := router.Start(*enableVerboseLogging); err != nil {
 		log.Fatalln("failed to start/run the router", err)
 	}
 }
 
+// shutdownTracingOnExit will send any traces that are still buffered when
+// Owncast exits or is stopped.
+func shutdownTracingOnExit(shutdown func(context.Context) error) {
+	log.RegisterExitHandler(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := shutdown(ctx); err != nil {
+			log.Errorln("unable to send the remaining traces", err)
+		}
+	})
+
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		<-signals
+		log.Exit(0)
+	}()
+}
+
 func handleCommandLineFlags() {
 	configRepository := configrepository.Get()
 
@@ -1,88 +1,232 @@
 package metrics
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/owncast/owncast/core/rtmp"
+	"github.com/owncast/owncast/core/transcoder"
+	"github.com/owncast/owncast/core/webhooks"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/notifications"
+	"github.com/owncast/owncast/persistence/alertrepository"
+	"github.com/owncast/owncast/persistence/configrepository"
 	log "github.com/sirupsen/logrus"
+	"github.com/teris-io/shortid"
 )
 
-const (
-	maxCPUAlertingThresholdPCT  = 85
-	maxRAMAlertingThresholdPCT  = 85
-	maxDiskAlertingThresholdPCT = 90
-)
-
-var (
-	inCPUAlertingState  = false
-	inRAMAlertingState  = false
-	inDiskAlertingState = false
-)
+// How often alert rules are evaluated. Rule durations are only as precise
+// as this.
+const alertingPollingInterval = 30 * time.Second
+
+// alertMetricDetails describes a metric for use in alert messages.
+var alertMetricDetails = map[models.AlertMetric]struct {
+	name string
+	unit string
+}{
+	models.AlertMetricCPU:               {"CPU utilization", "%"},
+	models.AlertMetricMemory:            {"Memory utilization", "%"},
+	models.AlertMetricDisk:              {"Disk utilization", "%"},
+	models.AlertMetricPlaybackErrors:    {"Playback errors", ""},
+	models.AlertMetricStreamHealth:      {"Stream health", "%"},
+	models.AlertMetricTranscoderSpeed:   {"Transcoder speed", "x"},
+	models.AlertMetricIngestBitrateDrop: {"Inbound video bitrate drop", "%"},
+}
 
-var errorResetDuration = time.Minute * 5
+const alertingTroubleshooting = "Visit the documentation at http://owncast.online/docs/troubleshooting/ if you are experiencing issues."
 
-const alertingError = "The %s utilization of %f%% could cause problems with video generation and delivery. Visit the documentation at http://owncast.online/docs/troubleshooting/ if you are experiencing issues."
+// alertState is the progress of a single rule towards firing.
+type alertState struct {
+	pendingSince time.Time
+	alert        *models.Alert
+	rule         models.AlertRule
+}
 
-func handleAlerting() {
-	handleCPUAlerting()
-	handleRAMAlerting()
-	handleDiskAlerting()
+// alertEvent is an alert that fired or resolved during an evaluation.
+type alertEvent struct {
+	alert    models.Alert
+	rule     models.AlertRule
+	resolved bool
 }
 
-func handleCPUAlerting() {
-	if len(metrics.CPUUtilizations) < 2 {
-		return
+// Alert states keyed by rule ID. Only used by the alerting goroutine.
+var alertStates = map[string]*alertState{}
+
+func startAlerting() {
+	// Alerts that were open when the server stopped can't be tracked.
+	if err := alertrepository.Get().ResolveOpenAlerts(time.Now()); err != nil {
+		log.Errorln("unable to resolve alerts left open", err)
+	}
+
+	for range time.Tick(alertingPollingInterval) {
+		handleAlerting()
 	}
+}
 
-	avg := recentAverage(metrics.CPUUtilizations)
-	if avg > maxCPUAlertingThresholdPCT && !inCPUAlertingState {
-		log.Warnf(alertingError, "CPU", avg)
-		inCPUAlertingState = true
+func handleAlerting() {
+	rules := configrepository.Get().GetAlertRules()
+	events := evaluateAlertRules(rules, getAlertMetricValues(), time.Now())
 
-		resetTimer := time.NewTimer(errorResetDuration)
-		go func() {
-			<-resetTimer.C
-			inCPUAlertingState = false
-		}()
+	for _, event := range events {
+		handleAlertEvent(event)
 	}
 }
 
-func handleRAMAlerting() {
-	if len(metrics.RAMUtilizations) < 2 {
-		return
+// getAlertMetricValues will return the most recent value of every metric
+// that currently has one.
+func getAlertMetricValues() map[models.AlertMetric]float64 {
+	values := map[models.AlertMetric]float64{}
+
+	metrics.m.Lock()
+	if len(metrics.CPUUtilizations) > 0 {
+		values[models.AlertMetricCPU] = metrics.CPUUtilizations[len(metrics.CPUUtilizations)-1].Value
+	}
+	if len(metrics.RAMUtilizations) > 0 {
+		values[models.AlertMetricMemory] = metrics.RAMUtilizations[len(metrics.RAMUtilizations)-1].Value
+	}
+	if len(metrics.DiskUtilizations) > 0 {
+		values[models.AlertMetricDisk] = metrics.DiskUtilizations[len(metrics.DiskUtilizations)-1].Value
+	}
+	if len(metrics.errorCount) > 0 {
+		values[models.AlertMetricPlaybackErrors] = metrics.errorCount[len(metrics.errorCount)-1].Value
+	}
+	// The overview isn't cleared when a stream ends.
+	if metrics.streamHealthOverview != nil && _getStatus().Online {
+		values[models.AlertMetricStreamHealth] = float64(metrics.streamHealthOverview.HealthyPercentage)
 	}
+	metrics.m.Unlock()
 
-	avg := recentAverage(metrics.RAMUtilizations)
-	if avg > maxRAMAlertingThresholdPCT && !inRAMAlertingState {
-		log.Warnf(alertingError, "memory", avg)
-		inRAMAlertingState = true
+	if speed, ok := transcoder.GetTranscoderSpeed(); ok {
+		values[models.AlertMetricTranscoderSpeed] = speed
+	}
 
-		resetTimer := time.NewTimer(errorResetDuration)
-		go func() {
-			<-resetTimer.C
-			inRAMAlertingState = false
-		}()
+	if current, average, ok := rtmp.GetIngestBitrate(); ok && average > 0 {
+		values[models.AlertMetricIngestBitrateDrop] = max(0, (average-current)/average*100)
 	}
+
+	return values
 }
 
-func handleDiskAlerting() {
-	if len(metrics.DiskUtilizations) < 2 {
-		return
+// evaluateAlertRules will compare the rules against the metric values and
+// return the alerts that fired or resolved as a result.
+func evaluateAlertRules(rules []models.AlertRule, values map[models.AlertMetric]float64, now time.Time) []alertEvent {
+	events := []alertEvent{}
+	evaluated := map[string]bool{}
+
+	for _, rule := range rules {
+		evaluated[rule.ID] = true
+		state := alertStates[rule.ID]
+
+		value, hasValue := values[rule.Metric]
+		if !rule.Enabled || !hasValue || !rule.IsMet(value) {
+			if state != nil && state.alert != nil {
+				events = append(events, resolveAlert(state, now))
+			}
+			delete(alertStates, rule.ID)
+			continue
+		}
+
+		// A rule changed while pending starts waiting again.
+		if state == nil || state.rule != rule {
+			if state != nil && state.alert != nil {
+				events = append(events, resolveAlert(state, now))
+			}
+			state = &alertState{pendingSince: now, rule: rule}
+			alertStates[rule.ID] = state
+		}
+
+		if state.alert == nil && now.Sub(state.pendingSince) >= rule.Duration() {
+			state.alert = &models.Alert{
+				ID:         shortid.MustGenerate(),
+				RuleID:     rule.ID,
+				Metric:     rule.Metric,
+				Comparison: rule.Comparison,
+				Severity:   rule.Severity,
+				Value:      value,
+				Threshold:  rule.Threshold,
+				FiredAt:    now,
+			}
+			events = append(events, alertEvent{alert: *state.alert, rule: rule})
+		}
 	}
 
-	avg := recentAverage(metrics.DiskUtilizations)
+	// Alerts for rules that were removed can never clear on their own.
+	for id, state := range alertStates {
+		if evaluated[id] {
+			continue
+		}
+		if state.alert != nil {
+			events = append(events, resolveAlert(state, now))
+		}
+		delete(alertStates, id)
+	}
+
+	return events
+}
+
+// resolveAlert will clear a fired alert. The alert keeps the value it
+// fired with.
+func resolveAlert(state *alertState, now time.Time) alertEvent {
+	alert := *state.alert
+	alert.ResolvedAt = &now
+
+	return alertEvent{alert: alert, rule: state.rule, resolved: true}
+}
 
-	if avg > maxDiskAlertingThresholdPCT && !inDiskAlertingState {
-		log.Warnf(alertingError, "disk", avg)
-		inDiskAlertingState = true
+// handleAlertEvent will record an alert and deliver it wherever its rule
+// asks for.
+func handleAlertEvent(event alertEvent) {
+	message := alertMessage(event)
+	alertRepository := alertrepository.Get()
+
+	eventType := models.AlertFired
+	if event.resolved {
+		eventType = models.AlertResolved
+		if err := alertRepository.ResolveAlert(event.alert.ID, *event.alert.ResolvedAt); err != nil {
+			log.Errorln(err)
+		}
+		log.Infoln(message)
+	} else {
+		if err := alertRepository.CreateAlert(event.alert); err != nil {
+			log.Errorln(err)
+		}
+		switch event.alert.Severity {
+		case models.AlertSeverityCritical:
+			log.Errorln(message)
+		case models.AlertSeverityWarning:
+			log.Warnln(message)
+		default:
+			log.Infoln(message)
+		}
+	}
 
-		resetTimer := time.NewTimer(errorResetDuration)
+	if event.rule.Webhooks {
+		webhooks.SendAlertEvent(eventType, event.alert, message)
+	}
+
+	if event.rule.Channel != "" {
 		go func() {
-			<-resetTimer.C
-			inDiskAlertingState = false
+			if err := notifications.SendAdminNotification(event.rule.Channel, message); err != nil {
+				log.Errorln("unable to send alert to", event.rule.Channel, err)
+			}
 		}()
 	}
 }
 
-func recentAverage(values []TimestampedValue) float64 {
-	return (values[len(values)-1].Value + values[len(values)-2].Value) / 2
+func alertMessage(event alertEvent) string {
+	details, ok := alertMetricDetails[event.alert.Metric]
+	if !ok {
+		details.name = event.alert.Metric
+	}
+
+	severity := strings.ToUpper(event.alert.Severity)
+	threshold := fmt.Sprintf("%g%s", event.alert.Threshold, details.unit)
+
+	if event.resolved {
+		return fmt.Sprintf("[%s] Resolved: %s is no longer %s %s.", severity, details.name, event.alert.Comparison, threshold)
+	}
+
+	value := fmt.Sprintf("%.4g%s", event.alert.Value, details.unit)
+	return fmt.Sprintf("[%s] %s has been %s %s for %s and is currently %s. %s", severity, details.name, event.alert.Comparison, threshold, event.rule.Duration(), value, alertingTroubleshooting)
 }
@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/models"
+)
+
+func TestEvaluateAlertRules(t *testing.T) {
+	alertStates = map[string]*alertState{}
+	rule := models.AlertRule{
+		ID:              "speed",
+		Metric:          models.AlertMetricTranscoderSpeed,
+		Comparison:      models.AlertBelow,
+		Threshold:       1,
+		DurationSeconds: 60,
+		Severity:        models.AlertSeverityCritical,
+		Enabled:         true,
+	}
+	rules := []models.AlertRule{rule}
+	slow := map[models.AlertMetric]float64{models.AlertMetricTranscoderSpeed: 0.8}
+	start := time.Now()
+
+	if events := evaluateAlertRules(rules, slow, start); len(events) != 0 {
+		t.Fatalf("Expected no alert before the duration has passed but got %d", len(events))
+	}
+
+	events := evaluateAlertRules(rules, slow, start.Add(time.Minute))
+	if len(events) != 1 || events[0].resolved || events[0].alert.Value != 0.8 || events[0].alert.Severity != models.AlertSeverityCritical {
+		t.Fatalf("Expected a critical alert to fire once the duration passed but got %+v", events)
+	}
+	firedID := events[0].alert.ID
+
+	if events := evaluateAlertRules(rules, slow, start.Add(2*time.Minute)); len(events) != 0 {
+		t.Fatalf("Expected a fired alert not to fire again but got %d", len(events))
+	}
+
+	// The transcoder stopping reporting its speed clears the alert.
+	events = evaluateAlertRules(rules, map[models.AlertMetric]float64{}, start.Add(3*time.Minute))
+	if len(events) != 1 || !events[0].resolved || events[0].alert.ID != firedID || events[0].alert.ResolvedAt == nil {
+		t.Fatalf("Expected the alert to resolve but got %+v", events)
+	}
+}
+
+func TestEvaluateAlertRulesResetsWhenConditionClears(t *testing.T) {
+	alertStates = map[string]*alertState{}
+	rules := []models.AlertRule{{
+		ID:              "cpu",
+		Metric:          models.AlertMetricCPU,
+		Comparison:      models.AlertAbove,
+		Threshold:       85,
+		DurationSeconds: 120,
+		Severity:        models.AlertSeverityWarning,
+		Enabled:         true,
+	}}
+	high := map[models.AlertMetric]float64{models.AlertMetricCPU: 90}
+	low := map[models.AlertMetric]float64{models.AlertMetricCPU: 50}
+	start := time.Now()
+
+	evaluateAlertRules(rules, high, start)
+	evaluateAlertRules(rules, low, start.Add(time.Minute))
+	if events := evaluateAlertRules(rules, high, start.Add(2*time.Minute)); len(events) != 0 {
+		t.Fatalf("Expected the duration to restart after the condition cleared but got %d events", len(events))
+	}
+	if events := evaluateAlertRules(rules, high, start.Add(4*time.Minute)); len(events) != 1 {
+		t.Fatalf("Expected an alert after the condition held for the full duration but got %d events", len(events))
+	}
+
+	// Removing a rule resolves its alert.
+	if events := evaluateAlertRules(nil, high, start.Add(5*time.Minute)); len(events) != 1 || !events[0].resolved {
+		t.Fatalf("Expected the alert of a removed rule to resolve but got %+v", events)
+	}
+}
+
+func TestAlertMessage(t *testing.T) {
+	event := alertEvent{
+		alert: models.Alert{Metric: models.AlertMetricDisk, Comparison: models.AlertAbove, Severity: models.AlertSeverityWarning, Value: 93.25, Threshold: 90},
+		rule:  models.AlertRule{DurationSeconds: 120},
+	}
+
+	expected := "[WARNING] Disk utilization has been above 90% for 2m0s and is currently 93.25%. " + alertingTroubleshooting
+	if message := alertMessage(event); message != expected {
+		t.Errorf("Expected %q but got %q", expected, message)
+	}
+
+	event.resolved = true
+	if message := alertMessage(event); message != "[WARNING] Resolved: Disk utilization is no longer above 90%." {
+		t.Errorf("Unexpected resolved message %q", message)
+	}
+}
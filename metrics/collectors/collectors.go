@@ -0,0 +1,139 @@
+// Package collectors holds the Prometheus counters and histograms that are
+// recorded to from across Owncast. It doesn't import anything else in
+// Owncast so any package can use it.
+package collectors
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// IngestBytes is the number of bytes received from the broadcaster.
+	IngestBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "owncast_ingest_received_bytes_total",
+		Help: "Bytes received from the broadcaster, by track.",
+	}, []string{"track"})
+
+	// IngestBitrate is the video bitrate of each group of pictures received
+	// from the broadcaster.
+	IngestBitrate = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "owncast_ingest_video_bitrate_kbps",
+		Help:    "The video bitrate received from the broadcaster, measured between keyframes.",
+		Buckets: []float64{500, 1000, 2000, 3000, 4500, 6000, 8000, 10000, 15000, 20000},
+	})
+
+	// IngestKeyframeInterval is the time between keyframes received from
+	// the broadcaster.
+	IngestKeyframeInterval = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "owncast_ingest_keyframe_interval_seconds",
+		Help:    "The time between keyframes received from the broadcaster.",
+		Buckets: []float64{0.5, 1, 2, 3, 4, 5, 6, 8, 10, 15},
+	})
+
+	// SegmentGenerationSeconds is the time between video segments being
+	// written for each variant.
+	SegmentGenerationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "owncast_segment_generation_seconds",
+		Help:    "The time between video segments being written, by variant.",
+		Buckets: []float64{0.5, 1, 2, 3, 4, 5, 6, 8, 10, 15, 20},
+	}, []string{"variant"})
+
+	// StorageUploadSeconds is how long uploads to external storage take.
+	StorageUploadSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "owncast_storage_upload_seconds",
+		Help:    "How long uploads to external storage take, by type of file.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"type"})
+
+	// StorageUploadFailures is the number of failed attempts to upload to
+	// external storage.
+	StorageUploadFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "owncast_storage_upload_failures_total",
+		Help: "Failed attempts to upload to external storage, by type of file.",
+	}, []string{"type"})
+
+	// ChatMessages is the number of chat messages sent by users.
+	ChatMessages = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "owncast_chat_messages_total",
+		Help: "Chat messages sent by users.",
+	})
+
+	// ChatConnections is the number of chat websocket connects and
+	// disconnects.
+	ChatConnections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "owncast_chat_websocket_events_total",
+		Help: "Chat websocket connects and disconnects.",
+	}, []string{"event"})
+
+	// WebhookDeliveries is the number of attempts to deliver to webhooks.
+	WebhookDeliveries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "owncast_webhook_deliveries_total",
+		Help: "Attempts to deliver events to webhooks, by event and result.",
+	}, []string{"event", "result"})
+
+	// WebhookDeliverySeconds is how long webhook deliveries take.
+	WebhookDeliverySeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "owncast_webhook_delivery_seconds",
+		Help:    "How long attempts to deliver events to webhooks take.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	// ActivityPubQueueDepth is the number of ActivityPub jobs waiting for
+	// a worker.
+	ActivityPubQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "owncast_activitypub_queue_depth",
+		Help: "ActivityPub jobs waiting for a worker, by direction.",
+	}, []string{"direction"})
+
+	// ActivityPubFailures is the number of ActivityPub requests that
+	// failed.
+	ActivityPubFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "owncast_activitypub_failures_total",
+		Help: "ActivityPub requests that failed, by direction and reason.",
+	}, []string{"direction", "reason"})
+
+	// HTTPRequestSeconds is how long HTTP requests take.
+	HTTPRequestSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "owncast_http_request_duration_seconds",
+		Help:    "How long HTTP requests take, by route, method and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+)
+
+// Values of the labels above.
+const (
+	TrackVideo = "video"
+	TrackAudio = "audio"
+
+	FileTypeSegment  = "segment"
+	FileTypePlaylist = "playlist"
+
+	ChatConnected    = "connect"
+	ChatDisconnected = "disconnect"
+
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+
+	DirectionInbound  = "inbound"
+	DirectionOutbound = "outbound"
+)
+
+// Register will export the collectors to Prometheus with the given labels.
+// Values recorded before this are kept.
+func Register(labels map[string]string) {
+	prometheus.WrapRegistererWith(labels, prometheus.DefaultRegisterer).MustRegister(
+		IngestBytes,
+		IngestBitrate,
+		IngestKeyframeInterval,
+		SegmentGenerationSeconds,
+		StorageUploadSeconds,
+		StorageUploadFailures,
+		ChatMessages,
+		ChatConnections,
+		WebhookDeliveries,
+		WebhookDeliverySeconds,
+		ActivityPubQueueDepth,
+		ActivityPubFailures,
+		HTTPRequestSeconds,
+	)
+}
@@ -36,6 +36,19 @@ func collectCPUUtilization() {
 	cpuUsage.Set(metricValue.Value)
 }
 
+// GetAverageCPUUtilization will return the average CPU utilization
+// collected between two times.
+func GetAverageCPUUtilization(start, end time.Time) float64 {
+	if metrics == nil {
+		return 0
+	}
+
+	metrics.m.Lock()
+	defer metrics.m.Unlock()
+
+	return AverageValue(metrics.CPUUtilizations, start, end)
+}
+
 func collectRAMUtilization() {
 	if len(metrics.RAMUtilizations) > maxCollectionValues {
 		metrics.RAMUtilizations = metrics.RAMUtilizations[1:]
@@ -71,6 +71,7 @@ func Start(getStatus func() models.Status) {
 
 	metrics = new(CollectedMetrics)
 	go startViewerCollectionMetrics()
+	go startAlerting()
 
 	go func() {
 		for range time.Tick(hardwareMetricsPollingInterval) {
@@ -93,9 +94,6 @@ func handlePolling() {
 	collectCPUUtilization()
 	collectRAMUtilization()
 	collectDiskUtilization()
-
-	// Alerting
-	handleAlerting()
 }
 
 // GetMetrics will return the collected metrics.
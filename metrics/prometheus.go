@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"github.com/owncast/owncast/metrics/collectors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -52,4 +53,6 @@ func setupPrometheusCollectors() {
 		Help:        "CPU usage as seen internally to Owncast.",
 		ConstLabels: labels,
 	})
+
+	collectors.Register(labels)
 }
@@ -20,3 +20,37 @@ func makeTimestampedValuesFromDatapoints(dp []*tstorage.DataPoint) []Timestamped
 
 	return tv
 }
+
+// AverageValue will return the average of the values collected between two
+// times, or zero if there are none.
+func AverageValue(values []TimestampedValue, start, end time.Time) float64 {
+	total := 0.0
+	count := 0
+	for _, v := range values {
+		if v.Time.Before(start) || v.Time.After(end) {
+			continue
+		}
+		total += v.Value
+		count++
+	}
+
+	if count == 0 {
+		return 0
+	}
+
+	return total / float64(count)
+}
+
+// MaxValue will return the largest value collected between two times, or
+// zero if there are none.
+func MaxValue(values []TimestampedValue, start, end time.Time) float64 {
+	maximum := 0.0
+	for _, v := range values {
+		if v.Time.Before(start) || v.Time.After(end) {
+			continue
+		}
+		maximum = max(maximum, v.Value)
+	}
+
+	return maximum
+}
@@ -0,0 +1,129 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/owncast/owncast/utils"
+)
+
+// AlertMetric is a collected value an alert rule can watch.
+type AlertMetric = string
+
+const (
+	// AlertMetricCPU is the CPU utilization percentage.
+	AlertMetricCPU AlertMetric = "cpu"
+	// AlertMetricMemory is the memory utilization percentage.
+	AlertMetricMemory AlertMetric = "memory"
+	// AlertMetricDisk is the disk utilization percentage.
+	AlertMetricDisk AlertMetric = "disk"
+	// AlertMetricPlaybackErrors is the number of playback errors viewers
+	// reported in the most recent collection window.
+	AlertMetricPlaybackErrors AlertMetric = "playbackErrors"
+	// AlertMetricStreamHealth is the percentage of viewers with healthy
+	// playback.
+	AlertMetricStreamHealth AlertMetric = "streamHealth"
+	// AlertMetricTranscoderSpeed is how fast the transcoder is working
+	// compared to real time, where 1 is exactly real time.
+	AlertMetricTranscoderSpeed AlertMetric = "transcoderSpeed"
+	// AlertMetricIngestBitrateDrop is how far, as a percentage, the inbound
+	// video bitrate has dropped below its average for the stream.
+	AlertMetricIngestBitrateDrop AlertMetric = "ingestBitrateDrop"
+)
+
+// AlertSeverity is how urgent an alert is.
+type AlertSeverity = string
+
+const (
+	// AlertSeverityInfo is for alerts that are worth knowing about.
+	AlertSeverityInfo AlertSeverity = "info"
+	// AlertSeverityWarning is for alerts that may affect viewers.
+	AlertSeverityWarning AlertSeverity = "warning"
+	// AlertSeverityCritical is for alerts that need attention right away.
+	AlertSeverityCritical AlertSeverity = "critical"
+)
+
+// AlertComparison is how a metric is compared to a rule's threshold.
+type AlertComparison = string
+
+const (
+	// AlertAbove fires when the metric is above the threshold.
+	AlertAbove AlertComparison = "above"
+	// AlertBelow fires when the metric is below the threshold.
+	AlertBelow AlertComparison = "below"
+)
+
+var (
+	validAlertMetrics = []AlertMetric{
+		AlertMetricCPU,
+		AlertMetricMemory,
+		AlertMetricDisk,
+		AlertMetricPlaybackErrors,
+		AlertMetricStreamHealth,
+		AlertMetricTranscoderSpeed,
+		AlertMetricIngestBitrateDrop,
+	}
+	validAlertSeverities  = []AlertSeverity{AlertSeverityInfo, AlertSeverityWarning, AlertSeverityCritical}
+	validAlertComparisons = []AlertComparison{AlertAbove, AlertBelow}
+)
+
+// AlertRule is an admin defined condition on a collected metric.
+type AlertRule struct {
+	ID         string          `json:"id"`
+	Metric     AlertMetric     `json:"metric"`
+	Comparison AlertComparison `json:"comparison"`
+	Severity   AlertSeverity   `json:"severity"`
+	// Channel is the notification channel alerts are sent to, if any.
+	Channel string `json:"channel,omitempty"`
+	// Threshold is compared to the metric in the metric's own unit.
+	Threshold float64 `json:"threshold"`
+	// DurationSeconds is how long the condition must hold before firing.
+	DurationSeconds int  `json:"durationSeconds"`
+	Webhooks        bool `json:"webhooks"`
+	Enabled         bool `json:"enabled"`
+}
+
+// Duration will return how long the condition must hold before firing.
+func (r AlertRule) Duration() time.Duration {
+	return time.Duration(r.DurationSeconds) * time.Second
+}
+
+// IsMet will return if a metric value meets the rule's condition.
+func (r AlertRule) IsMet(value float64) bool {
+	if r.Comparison == AlertBelow {
+		return value < r.Threshold
+	}
+	return value > r.Threshold
+}
+
+// Validate will return an error if the rule can't be evaluated.
+func (r AlertRule) Validate() error {
+	if _, found := utils.FindInSlice(validAlertMetrics, r.Metric); !found {
+		return fmt.Errorf("%q is not a metric alerts can be set on", r.Metric)
+	}
+	if _, found := utils.FindInSlice(validAlertComparisons, r.Comparison); !found {
+		return fmt.Errorf("%q is not a valid comparison", r.Comparison)
+	}
+	if _, found := utils.FindInSlice(validAlertSeverities, r.Severity); !found {
+		return fmt.Errorf("%q is not a valid severity", r.Severity)
+	}
+	if r.DurationSeconds < 0 {
+		return errors.New("alert duration can not be negative")
+	}
+
+	return nil
+}
+
+// Alert is a single time an alert rule fired.
+type Alert struct {
+	FiredAt    time.Time       `json:"firedAt"`
+	ResolvedAt *time.Time      `json:"resolvedAt,omitempty"`
+	ID         string          `json:"id"`
+	RuleID     string          `json:"ruleId"`
+	Metric     AlertMetric     `json:"metric"`
+	Comparison AlertComparison `json:"comparison"`
+	Severity   AlertSeverity   `json:"severity"`
+	Value      float64         `json:"value"`
+	Threshold  float64         `json:"threshold"`
+}
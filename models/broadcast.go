@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// Broadcast is the history of a single streaming session.
+type Broadcast struct {
+	StartedAt   time.Time    `json:"startedAt"`
+	EndedAt     *time.Time   `json:"endedAt,omitempty"`
+	Broadcaster *Broadcaster `json:"broadcaster,omitempty"`
+	ID          string       `json:"id"`
+	Title       string       `json:"title,omitempty"`
+	Tags        []string     `json:"tags"`
+	// OutputSettings are the video variants the broadcast was served as.
+	OutputSettings     []StreamOutputVariant `json:"outputSettings"`
+	LatencyLevel       int                   `json:"latencyLevel"`
+	AverageViewerCount float64               `json:"averageViewerCount"`
+	PeakViewerCount    int                   `json:"peakViewerCount"`
+	ChatMessageCount   int                   `json:"chatMessageCount"`
+	// ChatterCount is the number of different users who chatted.
+	ChatterCount int `json:"chatterCount"`
+}
@@ -7,6 +7,10 @@ const (
 	ScopeCanSendSystemMessages = "CAN_SEND_SYSTEM_MESSAGES"
 	// ScopeHasAdminAccess will allow performing administrative actions on the server.
 	ScopeHasAdminAccess = "HAS_ADMIN_ACCESS"
+	// ScopeCanSendDirectMessages will allow sending private messages to chat users as itself.
+	ScopeCanSendDirectMessages = "CAN_SEND_DIRECT_MESSAGES"
+	// ScopeCanManagePolls will allow starting and ending chat polls.
+	ScopeCanManagePolls = "CAN_MANAGE_POLLS"
 
 	ModeratorScopeKey = "MODERATOR"
 )
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// ChatArchive is the saved chat of a single broadcast.
+type ChatArchive struct {
+	StartedAt    time.Time `json:"startedAt"`
+	EndedAt      time.Time `json:"endedAt"`
+	CreatedAt    time.Time `json:"createdAt"`
+	ID           string    `json:"id"`
+	Messages     []byte    `json:"-"`
+	MessageCount int       `json:"messageCount"`
+}
@@ -0,0 +1,35 @@
+package models
+
+// ChatBotActionType is something a chat bot can do in response to a webhook.
+type ChatBotActionType = string
+
+const (
+	// ChatBotActionReply sends a chat message as the bot.
+	ChatBotActionReply ChatBotActionType = "REPLY"
+	// ChatBotActionHideMessage hides a chat message.
+	ChatBotActionHideMessage ChatBotActionType = "HIDE_MESSAGE"
+	// ChatBotActionTimeoutUser times a user out of chat.
+	ChatBotActionTimeoutUser ChatBotActionType = "TIMEOUT_USER"
+)
+
+// ChatBotResponse is the body a chat bot webhook can respond with.
+type ChatBotResponse struct {
+	Actions []ChatBotAction `json:"actions"`
+}
+
+// ChatBotAction is a single action a chat bot wants performed.
+type ChatBotAction struct {
+	Type ChatBotActionType `json:"type"`
+	// Body is the markdown message to send for REPLY actions.
+	Body string `json:"body,omitempty"`
+	// ReplyTo is the ID of the message a REPLY is in response to.
+	ReplyTo string `json:"replyTo,omitempty"`
+	// MessageID is the message to hide for HIDE_MESSAGE actions.
+	MessageID string `json:"messageId,omitempty"`
+	// UserID is the user to time out for TIMEOUT_USER actions.
+	UserID string `json:"userId,omitempty"`
+	// DurationSeconds is how long a TIMEOUT_USER action lasts.
+	DurationSeconds int `json:"durationSeconds,omitempty"`
+	// Reason is shown to the user for TIMEOUT_USER actions.
+	Reason string `json:"reason,omitempty"`
+}
@@ -0,0 +1,12 @@
+package models
+
+// ChatCommand is an admin defined chat command that replies to chat with a
+// configured message.
+type ChatCommand struct {
+	// Name is what is typed after the slash to run the command.
+	Name string `json:"name"`
+	// Response is the markdown sent to chat when the command is run.
+	Response string `json:"response"`
+	// ModeratorOnly limits the command to moderators.
+	ModeratorOnly bool `json:"moderatorOnly"`
+}
@@ -0,0 +1,123 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/owncast/owncast/utils"
+)
+
+// ChatFilterRuleType is the kind of test a chat filter rule performs.
+type ChatFilterRuleType = string
+
+const (
+	// ChatFilterBlockedWord matches messages containing a word.
+	ChatFilterBlockedWord ChatFilterRuleType = "BLOCKED_WORD"
+	// ChatFilterPattern matches messages against a regular expression.
+	ChatFilterPattern ChatFilterRuleType = "PATTERN"
+	// ChatFilterBlockedLinks matches messages linking to a comma separated
+	// list of domains, or to any domain if the list is empty.
+	ChatFilterBlockedLinks ChatFilterRuleType = "BLOCKED_LINKS"
+	// ChatFilterAllowedLinks matches messages linking to any domain not in
+	// a comma separated list of domains.
+	ChatFilterAllowedLinks ChatFilterRuleType = "ALLOWED_LINKS"
+	// ChatFilterCapsRatio matches messages where the ratio of upper case
+	// letters is at or above the threshold.
+	ChatFilterCapsRatio ChatFilterRuleType = "CAPS_RATIO"
+	// ChatFilterEmojiRatio matches messages where the ratio of emoji is at
+	// or above the threshold.
+	ChatFilterEmojiRatio ChatFilterRuleType = "EMOJI_RATIO"
+	// ChatFilterRepeatedMessage matches when a user sends the same message
+	// threshold times in a short period.
+	ChatFilterRepeatedMessage ChatFilterRuleType = "REPEATED_MESSAGE"
+)
+
+// ChatFilterAction is what happens to a message that matches a rule.
+type ChatFilterAction = string
+
+const (
+	// ChatFilterActionDrop will reject the message.
+	ChatFilterActionDrop ChatFilterAction = "DROP"
+	// ChatFilterActionHide will save the message hidden so moderators can review it.
+	ChatFilterActionHide ChatFilterAction = "HIDE"
+	// ChatFilterActionTimeout will reject the message and time out the sender.
+	ChatFilterActionTimeout ChatFilterAction = "TIMEOUT"
+)
+
+var (
+	validChatFilterRuleTypes = []ChatFilterRuleType{
+		ChatFilterBlockedWord,
+		ChatFilterPattern,
+		ChatFilterBlockedLinks,
+		ChatFilterAllowedLinks,
+		ChatFilterCapsRatio,
+		ChatFilterEmojiRatio,
+		ChatFilterRepeatedMessage,
+	}
+
+	validChatFilterActions = []ChatFilterAction{
+		ChatFilterActionDrop,
+		ChatFilterActionHide,
+		ChatFilterActionTimeout,
+	}
+)
+
+// ChatFilterRule is a single admin managed rule applied to chat messages.
+type ChatFilterRule struct {
+	CreatedAt      time.Time          `json:"createdAt"`
+	Type           ChatFilterRuleType `json:"type"`
+	Value          string             `json:"value"`
+	Action         ChatFilterAction   `json:"action"`
+	Threshold      float64            `json:"threshold"`
+	TimeoutSeconds int                `json:"timeoutSeconds"`
+	ID             int                `json:"id"`
+	Enabled        bool               `json:"enabled"`
+}
+
+// Validate will return an error if the rule cannot be applied.
+func (r *ChatFilterRule) Validate() error {
+	if _, valid := utils.FindInSlice(validChatFilterRuleTypes, r.Type); !valid {
+		return fmt.Errorf("invalid chat filter rule type %q", r.Type)
+	}
+
+	if _, valid := utils.FindInSlice(validChatFilterActions, r.Action); !valid {
+		return fmt.Errorf("invalid chat filter action %q", r.Action)
+	}
+
+	switch r.Type {
+	case ChatFilterBlockedWord:
+		if strings.TrimSpace(r.Value) == "" {
+			return errors.New("a blocked word must be provided")
+		}
+	case ChatFilterPattern:
+		if _, err := regexp.Compile(r.Value); err != nil || r.Value == "" {
+			return errors.New("a valid regular expression must be provided")
+		}
+	case ChatFilterAllowedLinks:
+		if r.Value == "" {
+			return errors.New("at least one allowed domain must be provided")
+		}
+	case ChatFilterCapsRatio, ChatFilterEmojiRatio:
+		if r.Threshold <= 0 || r.Threshold > 1 {
+			return errors.New("threshold must be a ratio between 0 and 1")
+		}
+	case ChatFilterRepeatedMessage:
+		if r.Threshold < 2 {
+			return errors.New("threshold must be at least 2 repeated messages")
+		}
+	}
+
+	if r.Action == ChatFilterActionTimeout {
+		if r.TimeoutSeconds <= 0 {
+			return errors.New("a timeout duration must be provided")
+		}
+		if time.Duration(r.TimeoutSeconds)*time.Second > MaxUserTimeout {
+			return fmt.Errorf("a timeout can not be longer than %d hours", int(MaxUserTimeout.Hours()))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// ChatMessageVisibility filters chat messages by if they are visible.
+type ChatMessageVisibility = string
+
+const (
+	// ChatMessageVisibilityAll returns both visible and hidden messages.
+	ChatMessageVisibilityAll ChatMessageVisibility = "all"
+	// ChatMessageVisibilityVisible returns only visible messages.
+	ChatMessageVisibilityVisible ChatMessageVisibility = "visible"
+	// ChatMessageVisibilityHidden returns only hidden messages.
+	ChatMessageVisibilityHidden ChatMessageVisibility = "hidden"
+)
+
+// ChatMessageSearch is the criteria used to search the chat history. Empty
+// fields are not used to narrow the results.
+type ChatMessageSearch struct {
+	Since       *time.Time
+	Until       *time.Time
+	Text        string
+	UserID      string
+	DisplayName string
+	Visibility  ChatMessageVisibility
+}
@@ -0,0 +1,12 @@
+package models
+
+// ChatModes are the moderation modes applied to chat for the current broadcast.
+type ChatModes struct {
+	// SlowModeSeconds is the minimum number of seconds a user must wait
+	// between messages. Zero disables slow mode.
+	SlowModeSeconds int `json:"slowModeSeconds"`
+	// AuthenticatedOnly only allows authenticated users to send messages.
+	AuthenticatedOnly bool `json:"authenticatedOnly"`
+	// EmoteOnly only allows messages made up entirely of emoji.
+	EmoteOnly bool `json:"emoteOnly"`
+}
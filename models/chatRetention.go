@@ -0,0 +1,39 @@
+package models
+
+// ChatRetentionMode is how long chat messages are kept.
+type ChatRetentionMode string
+
+const (
+	// ChatRetentionHours keeps messages for a number of hours.
+	ChatRetentionHours ChatRetentionMode = "HOURS"
+	// ChatRetentionMessages keeps a number of the most recent messages.
+	ChatRetentionMessages ChatRetentionMode = "MESSAGES"
+	// ChatRetentionForever never removes messages.
+	ChatRetentionForever ChatRetentionMode = "FOREVER"
+)
+
+// ChatRetention configures how long chat messages are kept and if a
+// broadcast's chat is archived when it ends.
+type ChatRetention struct {
+	Mode ChatRetentionMode `json:"mode"`
+	// Hours is how many hours of messages are kept in the HOURS mode.
+	Hours int `json:"hours"`
+	// Messages is how many messages are kept in the MESSAGES mode.
+	Messages int `json:"messages"`
+	// ArchiveBroadcasts saves the chat of each broadcast when it ends.
+	ArchiveBroadcasts bool `json:"archiveBroadcasts"`
+}
+
+// IsValid will return if the retention settings can be used.
+func (r ChatRetention) IsValid() bool {
+	switch r.Mode {
+	case ChatRetentionHours:
+		return r.Hours > 0
+	case ChatRetentionMessages:
+		return r.Messages > 0
+	case ChatRetentionForever:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,18 @@
+package models
+
+// ChatReviewQueue configures which chat messages are held for a moderator
+// to approve or reject before they are sent to chat.
+type ChatReviewQueue struct {
+	// HoldFilterMatches holds messages matching a chat filter rule with
+	// the HIDE action.
+	HoldFilterMatches bool `json:"holdFilterMatches"`
+	// HoldNewUserMessages holds the first message from a new user.
+	HoldNewUserMessages bool `json:"holdNewUserMessages"`
+	// HoldLinks holds messages that contain links.
+	HoldLinks bool `json:"holdLinks"`
+}
+
+// IsEnabled will return if any messages are held for review.
+func (q ChatReviewQueue) IsEnabled() bool {
+	return q.HoldFilterMatches || q.HoldNewUserMessages || q.HoldLinks
+}
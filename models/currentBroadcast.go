@@ -2,6 +2,8 @@ package models
 
 // CurrentBroadcast represents the configuration associated with the currently active stream.
 type CurrentBroadcast struct {
+	// ID is the broadcast's entry in the broadcast history.
+	ID             string                `json:"id"`
 	OutputSettings []StreamOutputVariant `json:"outputSettings"`
 	LatencyLevel   LatencyLevel          `json:"latencyLevel"`
 }
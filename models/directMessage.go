@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// DirectMessage is a private chat message sent from one user to another.
+type DirectMessage struct {
+	Timestamp   time.Time `json:"timestamp"`
+	Sender      *User     `json:"user"`
+	ID          string    `json:"id"`
+	RecipientID string    `json:"recipientId"`
+	Body        string    `json:"body"`
+}
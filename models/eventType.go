@@ -14,6 +14,16 @@ const (
 	UserNameChanged EventType = "NAME_CHANGE"
 	// VisibiltyToggled is the event sent when a chat message's visibility changes.
 	VisibiltyToggled EventType = "VISIBILITY-UPDATE"
+	// MessageReactionAdded is the event sent when a user reacts to a chat message.
+	MessageReactionAdded EventType = "MESSAGE_REACTION_ADDED"
+	// MessageReactionRemoved is the event sent when a user removes their reaction to a chat message.
+	MessageReactionRemoved EventType = "MESSAGE_REACTION_REMOVED"
+	// MessageEdited is the event sent when a user edits their chat message.
+	MessageEdited EventType = "MESSAGE_EDITED"
+	// PollStarted is the event sent when a chat poll starts.
+	PollStarted EventType = "POLL_STARTED"
+	// PollEnded is the event sent when a chat poll ends.
+	PollEnded EventType = "POLL_ENDED"
 	// PING is a ping message.
 	PING EventType = "PING"
 	// PONG is a pong message.
@@ -26,6 +36,11 @@ const (
 	StreamTitleUpdated EventType = "STREAM_TITLE_UPDATED"
 	// SystemMessageSent is the event sent when a system message is sent.
 	SystemMessageSent EventType = "SYSTEM"
+	// AlertFired is the event sent when an alert rule's condition has held
+	// for its duration.
+	AlertFired EventType = "ALERT_FIRED"
+	// AlertResolved is the event sent when a fired alert's condition clears.
+	AlertResolved EventType = "ALERT_RESOLVED"
 	// ChatActionSent is a generic chat action that can be used for anything that doesn't need specific handling or formatting.
 	ChatActionSent EventType = "CHAT_ACTION"
 )
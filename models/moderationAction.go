@@ -0,0 +1,72 @@
+package models
+
+import "time"
+
+// ModerationActorType is the kind of account that took a moderation action.
+type ModerationActorType = string
+
+const (
+	// ModerationActorAdmin is the server admin using the admin credentials.
+	ModerationActorAdmin ModerationActorType = "ADMIN"
+	// ModerationActorModerator is a chat user with moderator access.
+	ModerationActorModerator ModerationActorType = "MODERATOR"
+	// ModerationActorIntegration is a 3rd party integration using an access token.
+	ModerationActorIntegration ModerationActorType = "INTEGRATION"
+)
+
+// ModerationActionType is the kind of moderation action taken.
+type ModerationActionType = string
+
+const (
+	// ModerationActionHideMessage is when a chat message is hidden.
+	ModerationActionHideMessage ModerationActionType = "HIDE_MESSAGE"
+	// ModerationActionShowMessage is when a hidden chat message is made visible.
+	ModerationActionShowMessage ModerationActionType = "SHOW_MESSAGE"
+	// ModerationActionDisableUser is when a user is disabled.
+	ModerationActionDisableUser ModerationActionType = "DISABLE_USER"
+	// ModerationActionEnableUser is when a disabled user is enabled.
+	ModerationActionEnableUser ModerationActionType = "ENABLE_USER"
+	// ModerationActionAddModerator is when a user is given moderator access.
+	ModerationActionAddModerator ModerationActionType = "ADD_MODERATOR"
+	// ModerationActionRemoveModerator is when a user has moderator access removed.
+	ModerationActionRemoveModerator ModerationActionType = "REMOVE_MODERATOR"
+	// ModerationActionBanIP is when an IP address is banned.
+	ModerationActionBanIP ModerationActionType = "BAN_IP"
+	// ModerationActionUnbanIP is when an IP address ban is removed.
+	ModerationActionUnbanIP ModerationActionType = "UNBAN_IP"
+	// ModerationActionTimeoutUser is when a user is timed out of chat.
+	ModerationActionTimeoutUser ModerationActionType = "TIMEOUT_USER"
+	// ModerationActionRemoveTimeout is when a user's timeout is removed.
+	ModerationActionRemoveTimeout ModerationActionType = "REMOVE_TIMEOUT"
+	// ModerationActionApproveMessage is when a message held for review is approved.
+	ModerationActionApproveMessage ModerationActionType = "APPROVE_MESSAGE"
+	// ModerationActionRejectMessage is when a message held for review is rejected.
+	ModerationActionRejectMessage ModerationActionType = "REJECT_MESSAGE"
+)
+
+// ModerationActor is who took a moderation action.
+type ModerationActor struct {
+	Type ModerationActorType
+	ID   string
+	Name string
+}
+
+// ModerationAction is a single entry in the moderation log.
+type ModerationAction struct {
+	Timestamp time.Time            `json:"timestamp"`
+	ActorType ModerationActorType  `json:"actorType"`
+	ActorID   string               `json:"actorId"`
+	ActorName string               `json:"actorName"`
+	Action    ModerationActionType `json:"action"`
+	Target    string               `json:"target"`
+	Reason    string               `json:"reason"`
+	ID        int                  `json:"id"`
+}
+
+// ModerationActionFilter limits the moderation actions returned. Empty
+// values are not filtered on.
+type ModerationActionFilter struct {
+	Action  ModerationActionType
+	ActorID string
+	Target  string
+}
@@ -14,3 +14,84 @@ type BrowserNotificationConfiguration struct {
 	GoLiveMessage string `json:"goLiveMessage,omitempty"`
 	Enabled       bool   `json:"enabled"`
 }
+
+// MatrixConfiguration represents the configuration for the Matrix
+// notification service.
+type MatrixConfiguration struct {
+	Homeserver    string `json:"homeserver,omitempty"`
+	AccessToken   string `json:"accessToken,omitempty"`
+	RoomID        string `json:"roomId,omitempty"`
+	GoLiveMessage string `json:"goLiveMessage,omitempty"`
+	Enabled       bool   `json:"enabled"`
+}
+
+// TelegramConfiguration represents the configuration for the Telegram bot
+// notification service.
+type TelegramConfiguration struct {
+	BotToken      string `json:"botToken,omitempty"`
+	ChatID        string `json:"chatId,omitempty"`
+	GoLiveMessage string `json:"goLiveMessage,omitempty"`
+	Enabled       bool   `json:"enabled"`
+}
+
+// NtfyConfiguration represents the configuration for the ntfy
+// notification service.
+type NtfyConfiguration struct {
+	ServerURL     string `json:"serverUrl,omitempty"`
+	Topic         string `json:"topic,omitempty"`
+	AccessToken   string `json:"accessToken,omitempty"`
+	GoLiveMessage string `json:"goLiveMessage,omitempty"`
+	Enabled       bool   `json:"enabled"`
+}
+
+// GotifyConfiguration represents the configuration for the Gotify
+// notification service.
+type GotifyConfiguration struct {
+	ServerURL     string `json:"serverUrl,omitempty"`
+	AppToken      string `json:"appToken,omitempty"`
+	GoLiveMessage string `json:"goLiveMessage,omitempty"`
+	Enabled       bool   `json:"enabled"`
+}
+
+// SlackConfiguration represents the configuration for the Slack incoming
+// webhook notification service.
+type SlackConfiguration struct {
+	Webhook       string `json:"webhook,omitempty"`
+	GoLiveMessage string `json:"goLiveMessage,omitempty"`
+	Enabled       bool   `json:"enabled"`
+}
+
+// EmailConfiguration represents the configuration for sending email
+// notifications over SMTP.
+type EmailConfiguration struct {
+	SMTPHost      string   `json:"smtpHost,omitempty"`
+	Username      string   `json:"username,omitempty"`
+	Password      string   `json:"password,omitempty"`
+	FromAddress   string   `json:"fromAddress,omitempty"`
+	GoLiveMessage string   `json:"goLiveMessage,omitempty"`
+	Recipients    []string `json:"recipients,omitempty"`
+	SMTPPort      int      `json:"smtpPort,omitempty"`
+	Enabled       bool     `json:"enabled"`
+	// AllowSubscriptions lets viewers subscribe their own address.
+	AllowSubscriptions bool `json:"allowSubscriptions"`
+}
+
+// NotificationRule controls when and how a channel announces that the
+// stream has gone live.
+type NotificationRule struct {
+	// QuietHoursStart and QuietHoursEnd are "HH:MM" times between which no
+	// go-live notifications are sent. The range may cross midnight.
+	QuietHoursStart string `json:"quietHoursStart,omitempty"`
+	QuietHoursEnd   string `json:"quietHoursEnd,omitempty"`
+	// TimeZone is the IANA time zone quiet hours are in. Empty means the
+	// server's local time.
+	TimeZone string `json:"timeZone,omitempty"`
+	// MessageTemplate replaces the channel's go-live message and may use
+	// {{.StreamTitle}}, {{.ServerName}}, {{.ServerURL}} and {{.Summary}}.
+	MessageTemplate string `json:"messageTemplate,omitempty"`
+	// MinIntervalMinutes is the least time between two go-live notices.
+	MinIntervalMinutes int `json:"minIntervalMinutes"`
+	// MinUptimeMinutes is how long the stream must stay up before the
+	// notice is sent.
+	MinUptimeMinutes int `json:"minUptimeMinutes"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// Poll is a question put to chat with a set of options to vote for.
+type Poll struct {
+	StartedAt     time.Time           `json:"startedAt"`
+	EndsAt        time.Time           `json:"endsAt"`
+	EndedAt       *time.Time          `json:"endedAt,omitempty"`
+	ID            string              `json:"id"`
+	Question      string              `json:"question"`
+	CreatedByType ModerationActorType `json:"createdByType"`
+	CreatedByID   string              `json:"createdById"`
+	CreatedByName string              `json:"createdByName"`
+	Options       []PollOption        `json:"options"`
+	TotalVotes    int                 `json:"totalVotes"`
+}
+
+// PollOption is a single choice in a poll and the number of votes for it.
+type PollOption struct {
+	Text  string `json:"text"`
+	Votes int    `json:"votes"`
+}
+
+// IsActive will return if the poll is still accepting votes.
+func (p Poll) IsActive() bool {
+	return p.EndedAt == nil
+}
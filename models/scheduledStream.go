@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// ScheduledStream is an upcoming broadcast announced ahead of time.
+type ScheduledStream struct {
+	StartTime      time.Time  `json:"startTime"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	ReminderSentAt *time.Time `json:"reminderSentAt,omitempty"`
+	ID             string     `json:"id"`
+	Title          string     `json:"title"`
+	Description    string     `json:"description,omitempty"`
+	Thumbnail      string     `json:"thumbnail,omitempty"` // An optional image URL.
+}
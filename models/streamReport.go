@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// StreamReport is the summary of a single broadcast, generated when the
+// stream ends.
+type StreamReport struct {
+	StartedAt          time.Time           `json:"startedAt"`
+	EndedAt            time.Time           `json:"endedAt"`
+	CreatedAt          time.Time           `json:"createdAt"`
+	ID                 string              `json:"id"`
+	StreamTitle        string              `json:"streamTitle,omitempty"`
+	Encoder            StreamReportEncoder `json:"encoder"`
+	AverageViewerCount float64             `json:"averageViewerCount"`
+	DurationSeconds    int                 `json:"durationSeconds"`
+	PeakViewerCount    int                 `json:"peakViewerCount"`
+	ChatMessageCount   int                 `json:"chatMessageCount"`
+	NewFollowerCount   int                 `json:"newFollowerCount"`
+}
+
+// StreamReportEncoder is how the broadcaster's encoder and the server held
+// up during a broadcast.
+type StreamReportEncoder struct {
+	Name              string  `json:"name,omitempty"`
+	HealthMessage     string  `json:"healthMessage,omitempty"`
+	AverageCPUUsage   float64 `json:"averageCpuUsage"`
+	Framerate         float32 `json:"framerate"`
+	VideoBitrate      int     `json:"videoBitrate"`
+	Width             int     `json:"width"`
+	Height            int     `json:"height"`
+	HealthyPercentage int     `json:"healthyPercentage"`
+}
@@ -0,0 +1,9 @@
+package models
+
+import "time"
+
+// TimestampedValue is a value with a timestamp.
+type TimestampedValue struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
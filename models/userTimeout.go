@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// MaxUserTimeout is the longest a user can be timed out of chat for.
+const MaxUserTimeout = 7 * 24 * time.Hour
+
+// UserTimeout represents a user that is temporarily prevented from
+// taking part in chat.
+type UserTimeout struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	User      *User     `json:"user"`
+	Reason    string    `json:"reason"`
+}
+
+// IsActive will return if the timeout has not yet expired.
+func (t *UserTimeout) IsActive() bool {
+	return time.Now().Before(t.ExpiresAt)
+}
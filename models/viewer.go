@@ -16,6 +16,7 @@ type Viewer struct {
 	UserAgent string            `json:"userAgent"`
 	IPAddress string            `json:"ipAddress"`
 	ClientID  string            `json:"clientID"`
+	Referrer  string            `json:"-"`
 }
 
 // GenerateViewerFromRequest will return a chat client from a http request.
@@ -26,5 +27,6 @@ func GenerateViewerFromRequest(req *http.Request) Viewer {
 		UserAgent: req.UserAgent(),
 		IPAddress: utils.GetIPAddressFromRequest(req),
 		ClientID:  utils.GenerateClientIDFromRequest(req),
+		Referrer:  utils.GetReferrerHost(req.URL.Query().Get("referrer"), req.Host),
 	}
 }
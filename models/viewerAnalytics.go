@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// ViewerSession is the anonymous record of a single viewer watching a
+// broadcast. It holds nothing that can identify the viewer.
+type ViewerSession struct {
+	FirstSeen    time.Time `json:"firstSeen"`
+	ID           string    `json:"id"`
+	BroadcastID  string    `json:"broadcastId"`
+	CountryCode  string    `json:"countryCode,omitempty"`
+	RegionName   string    `json:"regionName,omitempty"`
+	Browser      string    `json:"browser,omitempty"`
+	OS           string    `json:"os,omitempty"`
+	DeviceClass  string    `json:"deviceClass,omitempty"`
+	Referrer     string    `json:"referrer,omitempty"`
+	WatchSeconds int       `json:"watchSeconds"`
+}
+
+// ViewerAnalyticsFilter limits viewer analytics to a broadcast and/or a
+// range of dates. Empty values are not filtered on.
+type ViewerAnalyticsFilter struct {
+	Since       *time.Time
+	Until       *time.Time
+	BroadcastID string
+}
+
+// AnalyticsCount is the number of viewers that share a value.
+type AnalyticsCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// ViewerAnalytics is the breakdown of the viewers of one or more
+// broadcasts.
+type ViewerAnalytics struct {
+	WatchTime           []AnalyticsCount `json:"watchTime"`
+	Countries           []AnalyticsCount `json:"countries"`
+	Regions             []AnalyticsCount `json:"regions"`
+	Browsers            []AnalyticsCount `json:"browsers"`
+	OperatingSystems    []AnalyticsCount `json:"operatingSystems"`
+	DeviceClasses       []AnalyticsCount `json:"deviceClasses"`
+	Referrers           []AnalyticsCount `json:"referrers"`
+	UniqueViewers       int              `json:"uniqueViewers"`
+	TotalWatchSeconds   int              `json:"totalWatchSeconds"`
+	AverageWatchSeconds float64          `json:"averageWatchSeconds"`
+}
@@ -6,13 +6,30 @@ import (
 	"github.com/owncast/owncast/utils"
 )
 
+// WebhookType is how a webhook destination is used.
+type WebhookType = string
+
+const (
+	// WebhookTypeStandard is only notified about events.
+	WebhookTypeStandard WebhookType = "STANDARD"
+	// WebhookTypeChatBot can respond to events with chat actions that are
+	// performed as the integration it is linked to.
+	WebhookTypeChatBot WebhookType = "CHAT_BOT"
+)
+
 // Webhook is an event that is sent to 3rd party, external services with details about something that took place within an Owncast server.
 type Webhook struct {
-	Timestamp time.Time   `json:"timestamp"`
-	LastUsed  *time.Time  `json:"lastUsed"`
-	URL       string      `json:"url"`
-	Events    []EventType `json:"events"`
-	ID        int         `json:"id"`
+	Timestamp   time.Time   `json:"timestamp"`
+	LastUsed    *time.Time  `json:"lastUsed"`
+	URL         string      `json:"url"`
+	Type        WebhookType `json:"type"`
+	AccessToken string      `json:"-"`                     // The integration a chat bot acts as.
+	Secret      string      `json:"secret,omitempty"`      // Used to sign payloads.
+	Template    string      `json:"template,omitempty"`    // Replaces the event JSON when set.
+	ContentType string      `json:"contentType,omitempty"` // Sent with templated payloads.
+	Filter      string      `json:"filter,omitempty"`      // Events are only sent when this renders "true".
+	Events      []EventType `json:"events"`
+	ID          int         `json:"id"`
 }
 
 // For an event to be seen as "valid" it must live in this slice.
@@ -22,9 +39,16 @@ var validEvents = []EventType{
 	UserParted,
 	UserNameChanged,
 	VisibiltyToggled,
+	MessageReactionAdded,
+	MessageReactionRemoved,
+	MessageEdited,
+	PollStarted,
+	PollEnded,
 	StreamStarted,
 	StreamStopped,
 	StreamTitleUpdated,
+	AlertFired,
+	AlertResolved,
 }
 
 // HasValidEvents will verify that all the events provided are valid.
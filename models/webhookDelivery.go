@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// WebhookDelivery is the outcome of sending a single event to a webhook,
+// across all of its attempts.
+type WebhookDelivery struct {
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	EventType  EventType `json:"eventType"`
+	Error      string    `json:"error,omitempty"`
+	Payload    []byte    `json:"-"`
+	ID         int       `json:"id"`
+	WebhookID  int       `json:"webhookId"`
+	StatusCode int       `json:"statusCode"`
+	LatencyMs  int64     `json:"latencyMs"`
+	Attempts   int       `json:"attempts"`
+	Success    bool      `json:"success"`
+}
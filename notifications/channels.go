@@ -1,6 +1,175 @@
 package notifications
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/pkg/errors"
+)
+
 const (
 	// BrowserPushNotification represents a push notification for a browser.
 	BrowserPushNotification = "BROWSER_PUSH_NOTIFICATION"
+	// DiscordNotification represents a message sent to a Discord webhook.
+	DiscordNotification = "DISCORD"
+	// MatrixNotification represents a message sent to a Matrix room.
+	MatrixNotification = "MATRIX"
+	// TelegramNotification represents a message sent by a Telegram bot.
+	TelegramNotification = "TELEGRAM"
+	// NtfyNotification represents a message published to an ntfy topic.
+	NtfyNotification = "NTFY"
+	// GotifyNotification represents a message pushed through Gotify.
+	GotifyNotification = "GOTIFY"
+	// SlackNotification represents a message sent to a Slack incoming webhook.
+	SlackNotification = "SLACK"
+	// EmailNotification represents an email sent over SMTP.
+	EmailNotification = "EMAIL"
 )
+
+// Notification is a message sent to notification channels.
+type Notification struct {
+	Title       string // The server name.
+	Message     string // The message configured for the channel.
+	StreamTitle string
+	URL         string
+	Test        bool // Sent from the admin, so it shouldn't reach subscribers.
+	Private     bool // Meant for the admins, so it shouldn't reach subscribers.
+}
+
+// Text will return the notification as a single block of text, for
+// channels that only send text.
+func (n Notification) Text() string {
+	text := n.Message
+	if n.StreamTitle != "" {
+		text += "\n" + n.StreamTitle
+	}
+	if n.URL != "" {
+		text += "\n\n" + n.URL
+	}
+	return strings.TrimSpace(text)
+}
+
+// Channel is somewhere notifications can be sent.
+type Channel interface {
+	Send(notification Notification) error
+}
+
+// ChannelFunc allows a function to be used as a Channel.
+type ChannelFunc func(notification Notification) error
+
+// Send will send a notification by calling the function.
+func (f ChannelFunc) Send(notification Notification) error {
+	return f(notification)
+}
+
+// ChannelRegistration describes how a notification channel is set up from
+// its stored configuration.
+type ChannelRegistration struct {
+	// Enabled will return if the channel is turned on.
+	Enabled func(configRepository configrepository.ConfigRepository) bool
+	// Setup will create the channel along with the message it sends when
+	// the stream goes live.
+	Setup func(configRepository configrepository.ConfigRepository) (Channel, string, error)
+}
+
+var (
+	channelRegistry     = map[string]ChannelRegistration{}
+	channelRegistryLock sync.RWMutex
+)
+
+// RegisterChannel will make a notification channel available to the
+// notifier under a name.
+func RegisterChannel(name string, registration ChannelRegistration) {
+	channelRegistryLock.Lock()
+	defer channelRegistryLock.Unlock()
+
+	channelRegistry[name] = registration
+}
+
+// GetChannelNames will return the names of every registered channel.
+func GetChannelNames() []string {
+	channelRegistryLock.RLock()
+	defer channelRegistryLock.RUnlock()
+
+	names := make([]string, 0, len(channelRegistry))
+	for name := range channelRegistry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func getChannelRegistration(name string) (ChannelRegistration, bool) {
+	channelRegistryLock.RLock()
+	defer channelRegistryLock.RUnlock()
+
+	registration, ok := channelRegistry[name]
+	return registration, ok
+}
+
+// SendTestNotification will send a test notification through a channel
+// using its current configuration, even if it isn't enabled.
+func SendTestNotification(name string) error {
+	// A test would be sent to every subscriber, so it is only for the
+	// channels that can reach just the admins.
+	if err := ValidateAdminChannel(name); err != nil {
+		return err
+	}
+
+	configRepository := configrepository.Get()
+
+	return sendToChannel(name, Notification{
+		Title:   configRepository.GetServerName(),
+		Message: "This is a test notification from Owncast.",
+		URL:     configRepository.GetServerURL(),
+		Test:    true,
+	})
+}
+
+// SendAdminNotification will send a message meant for the admins through a
+// single notification channel, skipping any subscribers.
+func SendAdminNotification(name string, message string) error {
+	if err := ValidateAdminChannel(name); err != nil {
+		return err
+	}
+
+	configRepository := configrepository.Get()
+
+	return sendToChannel(name, Notification{
+		Title:   configRepository.GetServerName(),
+		Message: message,
+		URL:     configRepository.GetServerURL(),
+		Private: true,
+	})
+}
+
+// ValidateAdminChannel will return an error if a channel can't be used to
+// send messages only to the admins.
+func ValidateAdminChannel(name string) error {
+	// Browser pushes would be sent to every subscriber.
+	if name == BrowserPushNotification {
+		return errors.New("browser push notifications can only be sent to subscribers")
+	}
+
+	if _, ok := getChannelRegistration(name); !ok {
+		return fmt.Errorf("unknown notification channel %s", name)
+	}
+
+	return nil
+}
+
+// sendToChannel will send through a channel that has already been
+// validated.
+func sendToChannel(name string, notification Notification) error {
+	registration, _ := getChannelRegistration(name)
+	channel, _, err := registration.Setup(configrepository.Get())
+	if err != nil {
+		return err
+	}
+
+	return channel.Send(notification)
+}
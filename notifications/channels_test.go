@@ -0,0 +1,55 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/owncast/owncast/utils"
+)
+
+func TestBuiltInChannelsRegistered(t *testing.T) {
+	names := GetChannelNames()
+	for _, expected := range []string{BrowserPushNotification, DiscordNotification, MatrixNotification, TelegramNotification, NtfyNotification, GotifyNotification, SlackNotification, EmailNotification} {
+		if _, found := utils.FindInSlice(names, expected); !found {
+			t.Errorf("Expected %s to be a registered channel", expected)
+		}
+	}
+}
+
+func TestNotificationText(t *testing.T) {
+	notification := Notification{
+		Title:       "My Server",
+		Message:     "I've gone live!",
+		StreamTitle: "Building things",
+		URL:         "https://example.com",
+	}
+
+	expected := "I've gone live!\nBuilding things\n\nhttps://example.com"
+	if text := notification.Text(); text != expected {
+		t.Errorf("Expected %q but got %q", expected, text)
+	}
+
+	if text := (Notification{Message: "Test"}).Text(); text != "Test" {
+		t.Errorf("Expected only the message but got %q", text)
+	}
+}
+
+func TestUntestableChannels(t *testing.T) {
+	if err := SendTestNotification(BrowserPushNotification); err == nil {
+		t.Error("Expected browser push to not send test notifications")
+	}
+	if err := SendTestNotification("UNKNOWN"); err == nil {
+		t.Error("Expected unknown channels to error")
+	}
+}
+
+func TestValidateAdminChannel(t *testing.T) {
+	if err := ValidateAdminChannel(EmailNotification); err != nil {
+		t.Errorf("Expected email to be usable for admin messages: %s", err)
+	}
+	if err := ValidateAdminChannel(BrowserPushNotification); err == nil {
+		t.Error("Expected browser push to not be usable for admin messages")
+	}
+	if err := ValidateAdminChannel("UNKNOWN"); err == nil {
+		t.Error("Expected unknown channels to error")
+	}
+}
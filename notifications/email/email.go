@@ -0,0 +1,202 @@
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/teris-io/shortid"
+	"golang.org/x/time/rate"
+)
+
+var (
+	// batchSize is how many messages are sent over a single connection
+	// before reconnecting, as most providers limit messages per session.
+	batchSize = 50
+
+	// sendInterval is the minimum time between two messages so large
+	// subscriber lists don't trip provider rate limits.
+	sendInterval = 100 * time.Millisecond
+)
+
+// Email is an instance of an SMTP email service.
+type Email struct {
+	host     string
+	username string
+	password string
+	from     string
+	port     int
+}
+
+// New will create a new instance of the SMTP email service. Port 465 uses
+// implicit TLS, any other port upgrades with STARTTLS when the server
+// supports it.
+func New(host string, port int, username, password, from string) (*Email, error) {
+	if host == "" || from == "" {
+		return nil, errors.New("email requires an smtp host and from address")
+	}
+	if port == 0 {
+		port = 587
+	}
+
+	return &Email{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+	}, nil
+}
+
+// Message is a plain text email to a single recipient.
+type Message struct {
+	// Headers are any additional headers, such as List-Unsubscribe.
+	Headers map[string]string
+	To      string
+	Subject string
+	Body    string
+}
+
+// Send will email a plain text message to each recipient separately, so
+// recipients can't see each other.
+func (e *Email) Send(to []string, subject, body string) error {
+	messages := make([]Message, 0, len(to))
+	for _, recipient := range to {
+		messages = append(messages, Message{To: recipient, Subject: subject, Body: body})
+	}
+
+	return e.SendMessages(messages)
+}
+
+// SendMessages will send each message in batches over a connection per
+// batch, rate limited between messages. A message that can't be delivered
+// doesn't stop the rest from being sent.
+func (e *Email) SendMessages(messages []Message) error {
+	limiter := rate.NewLimiter(rate.Every(sendInterval), 1)
+
+	failed := 0
+	var lastErr error
+	for start := 0; start < len(messages); start += batchSize {
+		batch := messages[start:min(start+batchSize, len(messages))]
+
+		client, err := e.connect()
+		if err != nil {
+			return err
+		}
+
+		for _, message := range batch {
+			_ = limiter.Wait(context.Background())
+			if err := e.sendOne(client, message); err != nil {
+				failed++
+				lastErr = errors.Wrap(err, "error sending email to "+message.To)
+				// Clear the failed transaction so the connection can be reused.
+				_ = client.Reset()
+			}
+		}
+
+		if err := client.Quit(); err != nil {
+			client.Close()
+		}
+	}
+
+	if failed > 0 {
+		return errors.Wrapf(lastErr, "%d of %d emails failed", failed, len(messages))
+	}
+
+	return nil
+}
+
+func (e *Email) connect() (*smtp.Client, error) {
+	address := net.JoinHostPort(e.host, strconv.Itoa(e.port))
+	tlsConfig := &tls.Config{ServerName: e.host, MinVersion: tls.VersionTLS12}
+
+	var client *smtp.Client
+	if e.port == 465 {
+		conn, err := tls.Dial("tcp", address, tlsConfig)
+		if err != nil {
+			return nil, errors.Wrap(err, "error connecting to smtp server")
+		}
+		if client, err = smtp.NewClient(conn, e.host); err != nil {
+			return nil, errors.Wrap(err, "error connecting to smtp server")
+		}
+	} else {
+		var err error
+		if client, err = smtp.Dial(address); err != nil {
+			return nil, errors.Wrap(err, "error connecting to smtp server")
+		}
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(tlsConfig); err != nil {
+				client.Close()
+				return nil, errors.Wrap(err, "error starting smtp tls")
+			}
+		}
+	}
+
+	if e.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", e.username, e.password, e.host)); err != nil {
+			client.Close()
+			return nil, errors.Wrap(err, "error authenticating with smtp server")
+		}
+	}
+
+	return client, nil
+}
+
+func (e *Email) sendOne(client *smtp.Client, message Message) error {
+	if err := client.Mail(e.from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(message.To); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(buildMessage(e.from, message)); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+func buildMessage(from string, m Message) []byte {
+	domain := from[strings.LastIndex(from, "@")+1:]
+
+	var message strings.Builder
+	fmt.Fprintf(&message, "From: %s\r\n", from)
+	fmt.Fprintf(&message, "To: %s\r\n", m.To)
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&message, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&message, "Message-ID: <%s@%s>\r\n", shortid.MustGenerate(), domain)
+	message.WriteString("MIME-Version: 1.0\r\n")
+	message.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+
+	names := make([]string, 0, len(m.Headers))
+	for name := range m.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		// Don't allow a header value to inject more headers.
+		value := strings.NewReplacer("\r", "", "\n", "").Replace(m.Headers[name])
+		fmt.Fprintf(&message, "%s: %s\r\n", name, value)
+	}
+	message.WriteString("\r\n")
+
+	// SMTP requires CRLF line endings.
+	body := strings.ReplaceAll(m.Body, "\r\n", "\n")
+	message.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	message.WriteString("\r\n")
+
+	return []byte(message.String())
+}
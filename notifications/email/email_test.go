@@ -0,0 +1,146 @@
+package email
+
+import (
+	"bufio"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// smtpServer is a minimal local SMTP stand-in that records each delivered
+// message and rejects recipients at the "rejected.invalid" domain.
+type smtpServer struct {
+	listener    net.Listener
+	messages    []string
+	connections int
+	mu          sync.Mutex
+}
+
+func newSMTPServer(t *testing.T) *smtpServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := &smtpServer{listener: listener}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.handle(conn)
+		}
+	}()
+	t.Cleanup(func() { listener.Close() })
+
+	return server
+}
+
+func (s *smtpServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	s.mu.Lock()
+	s.connections++
+	s.mu.Unlock()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) {
+		_, _ = conn.Write([]byte(line + "\r\n"))
+	}
+
+	reply("220 localhost ESMTP")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.TrimSpace(line))
+
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "RCPT TO:") && strings.Contains(command, "REJECTED.INVALID"):
+			reply("550 no such user")
+		case strings.HasPrefix(command, "DATA"):
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, data.String())
+			s.mu.Unlock()
+			reply("250 queued")
+		case strings.HasPrefix(command, "QUIT"):
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func TestSendMessages(t *testing.T) {
+	server := newSMTPServer(t)
+
+	sendInterval = 0
+	batchSize = 2
+
+	e, err := New("127.0.0.1", server.port(), "", "", "owncast@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messages := []Message{}
+	for i := 0; i < 5; i++ {
+		messages = append(messages, Message{
+			To:      "viewer" + strconv.Itoa(i) + "@example.com",
+			Subject: "Live now",
+			Body:    "Come watch\nthe stream",
+			Headers: map[string]string{"List-Unsubscribe": "<https://example.com/unsubscribe?token=" + strconv.Itoa(i) + ">\r\nBcc: injected@example.com"},
+		})
+	}
+	messages = append(messages, Message{To: "someone@rejected.invalid", Subject: "Live now", Body: "Come watch"})
+
+	err = e.SendMessages(messages)
+	if err == nil || !strings.Contains(err.Error(), "1 of 6 emails failed") {
+		t.Errorf("Expected a single failed email but got %v", err)
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	if len(server.messages) != 5 {
+		t.Fatalf("Expected 5 delivered messages but got %d", len(server.messages))
+	}
+	if server.connections != 3 {
+		t.Errorf("Expected 3 batched connections but got %d", server.connections)
+	}
+
+	first := server.messages[0]
+	if !strings.Contains(first, "To: viewer0@example.com\r\n") {
+		t.Errorf("Expected the first message to be addressed to viewer0: %s", first)
+	}
+	if !strings.Contains(first, "List-Unsubscribe: <https://example.com/unsubscribe?token=0>Bcc: injected@example.com\r\n") {
+		t.Errorf("Expected the header value to be kept on a single line: %s", first)
+	}
+	if !strings.Contains(first, "Come watch\r\nthe stream") {
+		t.Errorf("Expected CRLF line endings in the body: %s", first)
+	}
+}
@@ -0,0 +1,65 @@
+package gotify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Gotify is an instance of the Gotify service.
+type Gotify struct {
+	serverURL string
+	appToken  string
+}
+
+// New will create a new instance of the Gotify service.
+func New(serverURL, appToken string) (*Gotify, error) {
+	if serverURL == "" || appToken == "" {
+		return nil, errors.New("gotify requires a server url and application token")
+	}
+
+	return &Gotify{
+		serverURL: strings.TrimSuffix(serverURL, "/"),
+		appToken:  appToken,
+	}, nil
+}
+
+// Send will push a message through a Gotify application.
+func (g *Gotify) Send(title, message string) error {
+	type gotifyMessage struct {
+		Title    string `json:"title"`
+		Message  string `json:"message"`
+		Priority int    `json:"priority"`
+	}
+
+	jsonText, err := json.Marshal(gotifyMessage{Title: title, Message: message, Priority: 5})
+	if err != nil {
+		return errors.Wrap(err, "error marshalling gotify message to json")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, g.serverURL+"/message", bytes.NewReader(jsonText))
+	if err != nil {
+		return errors.Wrap(err, "error creating gotify request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gotify-Key", g.appToken)
+
+	client := &http.Client{}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "error sending gotify message")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("gotify responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/teris-io/shortid"
+)
+
+// Matrix is an instance of the Matrix service.
+type Matrix struct {
+	homeserver  string
+	accessToken string
+	roomID      string
+}
+
+// New will create a new instance of the Matrix service.
+func New(homeserver, accessToken, roomID string) (*Matrix, error) {
+	if homeserver == "" || accessToken == "" || roomID == "" {
+		return nil, errors.New("matrix requires a homeserver, access token and room id")
+	}
+
+	return &Matrix{
+		homeserver:  strings.TrimSuffix(homeserver, "/"),
+		accessToken: accessToken,
+		roomID:      roomID,
+	}, nil
+}
+
+// Send will send a message to a Matrix room.
+func (m *Matrix) Send(title, message string) error {
+	type roomMessage struct {
+		MsgType string `json:"msgtype"`
+		Body    string `json:"body"`
+	}
+
+	jsonText, err := json.Marshal(roomMessage{MsgType: "m.text", Body: message})
+	if err != nil {
+		return errors.Wrap(err, "error marshalling matrix message to json")
+	}
+
+	// Matrix uses the transaction ID to ignore duplicate sends.
+	endpoint := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s", m.homeserver, url.PathEscape(m.roomID), shortid.MustGenerate())
+
+	req, err := http.NewRequest(http.MethodPut, endpoint, bytes.NewReader(jsonText))
+	if err != nil {
+		return errors.Wrap(err, "error creating matrix request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+m.accessToken)
+
+	client := &http.Client{}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "error sending matrix message")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("matrix responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
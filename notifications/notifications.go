@@ -2,7 +2,6 @@ package notifications
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/owncast/owncast/config"
 	"github.com/owncast/owncast/core/data"
@@ -12,7 +11,6 @@ import (
 	"github.com/owncast/owncast/persistence/tables"
 
 	"github.com/owncast/owncast/notifications/browser"
-	"github.com/owncast/owncast/notifications/discord"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 )
@@ -20,15 +18,22 @@ import (
 // Notifier is an instance of the live stream notifier.
 type Notifier struct {
 	datastore        *data.Datastore
-	browser          *browser.Browser
-	discord          *discord.Discord
 	configRepository configrepository.ConfigRepository
+	channels         map[string]notifierChannel
+}
+
+// notifierChannel is an enabled channel and the message it sends when the
+// stream goes live.
+type notifierChannel struct {
+	channel       Channel
+	goLiveMessage string
 }
 
 // Setup will perform any pre-use setup for the notifier.
 func Setup(datastore *data.Datastore) {
 	tables.CreateNotificationsTable(datastore.DB)
 	initializeBrowserPushIfNeeded()
+	initializeEmailSubscriptionKeyIfNeeded()
 }
 
 func initializeBrowserPushIfNeeded() {
@@ -60,103 +65,52 @@ func initializeBrowserPushIfNeeded() {
 	}
 }
 
-// New creates a new instance of the Notifier.
+// New creates a new instance of the Notifier with every enabled channel.
 func New(datastore *data.Datastore) (*Notifier, error) {
 	notifier := Notifier{
 		datastore:        datastore,
 		configRepository: configrepository.Get(),
+		channels:         map[string]notifierChannel{},
 	}
 
-	if err := notifier.setupBrowserPush(); err != nil {
-		log.Error(err)
-	}
-	if err := notifier.setupDiscord(); err != nil {
-		log.Error(err)
-	}
-
-	return &notifier, nil
-}
-
-func (n *Notifier) setupBrowserPush() error {
-	if n.configRepository.GetBrowserPushConfig().Enabled {
-		publicKey, err := n.configRepository.GetBrowserPushPublicKey()
-		if err != nil || publicKey == "" {
-			return errors.Wrap(err, "browser notifier disabled, failed to get browser push public key")
-		}
-
-		privateKey, err := n.configRepository.GetBrowserPushPrivateKey()
-		if err != nil || privateKey == "" {
-			return errors.Wrap(err, "browser notifier disabled, failed to get browser push private key")
+	for _, name := range GetChannelNames() {
+		registration, _ := getChannelRegistration(name)
+		if !registration.Enabled(notifier.configRepository) {
+			continue
 		}
 
-		browserNotifier, err := browser.New(n.datastore, publicKey, privateKey)
+		channel, goLiveMessage, err := registration.Setup(notifier.configRepository)
 		if err != nil {
-			return errors.Wrap(err, "error creating browser notifier")
+			log.Errorln("unable to set up", name, "notifications", err)
+			continue
 		}
-		n.browser = browserNotifier
-	}
-	return nil
-}
 
-func (n *Notifier) notifyBrowserPush() {
-	destinations, err := GetNotificationDestinationsForChannel(BrowserPushNotification)
-	if err != nil {
-		log.Errorln("error getting browser push notification destinations", err)
-	}
-	for _, destination := range destinations {
-		unsubscribed, err := n.browser.Send(destination, n.configRepository.GetServerName(), n.configRepository.GetBrowserPushConfig().GoLiveMessage)
-		if unsubscribed {
-			// If the error is "unsubscribed", then remove the destination from the database.
-			if err := RemoveNotificationForChannel(BrowserPushNotification, destination); err != nil {
-				log.Errorln(err)
-			}
-		} else if err != nil {
-			log.Errorln(err)
-		}
+		notifier.channels[name] = notifierChannel{channel: channel, goLiveMessage: goLiveMessage}
 	}
-}
 
-func (n *Notifier) setupDiscord() error {
-	discordConfig := n.configRepository.GetDiscordConfig()
-	if discordConfig.Enabled && discordConfig.Webhook != "" {
-		var image string
-		if serverURL := n.configRepository.GetServerURL(); serverURL != "" {
-			image = serverURL + "/logo"
-		}
-		discordNotifier, err := discord.New(
-			n.configRepository.GetServerName(),
-			image,
-			discordConfig.Webhook,
-		)
-		if err != nil {
-			return errors.Wrap(err, "error creating discord notifier")
-		}
-		n.discord = discordNotifier
-	}
-	return nil
+	return &notifier, nil
 }
 
-func (n *Notifier) notifyDiscord() {
-	goLiveMessage := n.configRepository.GetDiscordConfig().GoLiveMessage
-	streamTitle := n.configRepository.GetStreamTitle()
-	if streamTitle != "" {
-		goLiveMessage += "\n" + streamTitle
-	}
-	message := fmt.Sprintf("%s\n\n%s", goLiveMessage, n.configRepository.GetServerURL())
-
-	if err := n.discord.Send(message); err != nil {
-		log.Errorln("error sending discord message", err)
+// NotifyMessage will send the same message through every channel, for
+// notifications other than going live.
+func (n *Notifier) NotifyMessage(message string, streamTitle string) {
+	for name, c := range n.channels {
+		n.send(name, c.channel, message, streamTitle)
 	}
 }
 
-// Notify will fire the different notification channels.
-func (n *Notifier) Notify() {
-	if n.browser != nil {
-		n.notifyBrowserPush()
+func (n *Notifier) send(name string, channel Channel, message string, streamTitle string) {
+	if err := channel.Send(n.notification(message, streamTitle)); err != nil {
+		log.Errorln("error sending", name, "notification", err)
 	}
+}
 
-	if n.discord != nil {
-		n.notifyDiscord()
+func (n *Notifier) notification(message string, streamTitle string) Notification {
+	return Notification{
+		Title:       n.configRepository.GetServerName(),
+		Message:     message,
+		StreamTitle: streamTitle,
+		URL:         n.configRepository.GetServerURL(),
 	}
 }
 
@@ -0,0 +1,62 @@
+package ntfy
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Ntfy is an instance of the ntfy service.
+type Ntfy struct {
+	serverURL   string
+	topic       string
+	accessToken string
+	clickURL    string
+}
+
+// New will create a new instance of the ntfy service. The access token is
+// only needed for protected topics.
+func New(serverURL, topic, accessToken, clickURL string) (*Ntfy, error) {
+	if serverURL == "" || topic == "" {
+		return nil, errors.New("ntfy requires a server url and topic")
+	}
+
+	return &Ntfy{
+		serverURL:   strings.TrimSuffix(serverURL, "/"),
+		topic:       topic,
+		accessToken: accessToken,
+		clickURL:    clickURL,
+	}, nil
+}
+
+// Send will publish a message to an ntfy topic.
+func (n *Ntfy) Send(title, message string) error {
+	req, err := http.NewRequest(http.MethodPost, n.serverURL+"/"+n.topic, strings.NewReader(message))
+	if err != nil {
+		return errors.Wrap(err, "error creating ntfy request")
+	}
+
+	req.Header.Set("Title", title)
+	if n.clickURL != "" {
+		req.Header.Set("Click", n.clickURL)
+	}
+	if n.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+n.accessToken)
+	}
+
+	client := &http.Client{}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "error sending ntfy message")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("ntfy responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
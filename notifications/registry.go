@@ -0,0 +1,203 @@
+package notifications
+
+import (
+	"strings"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/notifications/browser"
+	"github.com/owncast/owncast/notifications/discord"
+	"github.com/owncast/owncast/notifications/email"
+	"github.com/owncast/owncast/notifications/gotify"
+	"github.com/owncast/owncast/notifications/matrix"
+	"github.com/owncast/owncast/notifications/ntfy"
+	"github.com/owncast/owncast/notifications/slack"
+	"github.com/owncast/owncast/notifications/telegram"
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// The channels built in to Owncast.
+func init() {
+	RegisterChannel(BrowserPushNotification, ChannelRegistration{
+		Enabled: func(cr configrepository.ConfigRepository) bool {
+			return cr.GetBrowserPushConfig().Enabled
+		},
+		Setup: setupBrowserPush,
+	})
+
+	RegisterChannel(DiscordNotification, ChannelRegistration{
+		Enabled: func(cr configrepository.ConfigRepository) bool {
+			c := cr.GetDiscordConfig()
+			return c.Enabled && c.Webhook != ""
+		},
+		Setup: func(cr configrepository.ConfigRepository) (Channel, string, error) {
+			c := cr.GetDiscordConfig()
+			var image string
+			if serverURL := cr.GetServerURL(); serverURL != "" {
+				image = serverURL + "/logo"
+			}
+			discordNotifier, err := discord.New(cr.GetServerName(), image, c.Webhook)
+			if err != nil {
+				return nil, "", errors.Wrap(err, "error creating discord notifier")
+			}
+			return ChannelFunc(func(n Notification) error {
+				return discordNotifier.Send(n.Text())
+			}), c.GoLiveMessage, nil
+		},
+	})
+
+	RegisterChannel(MatrixNotification, ChannelRegistration{
+		Enabled: func(cr configrepository.ConfigRepository) bool {
+			return cr.GetMatrixConfig().Enabled
+		},
+		Setup: func(cr configrepository.ConfigRepository) (Channel, string, error) {
+			c := cr.GetMatrixConfig()
+			matrixNotifier, err := matrix.New(c.Homeserver, c.AccessToken, c.RoomID)
+			if err != nil {
+				return nil, "", err
+			}
+			return textChannel(matrixNotifier.Send), c.GoLiveMessage, nil
+		},
+	})
+
+	RegisterChannel(TelegramNotification, ChannelRegistration{
+		Enabled: func(cr configrepository.ConfigRepository) bool {
+			return cr.GetTelegramConfig().Enabled
+		},
+		Setup: func(cr configrepository.ConfigRepository) (Channel, string, error) {
+			c := cr.GetTelegramConfig()
+			telegramNotifier, err := telegram.New(c.BotToken, c.ChatID)
+			if err != nil {
+				return nil, "", err
+			}
+			return textChannel(telegramNotifier.Send), c.GoLiveMessage, nil
+		},
+	})
+
+	RegisterChannel(NtfyNotification, ChannelRegistration{
+		Enabled: func(cr configrepository.ConfigRepository) bool {
+			return cr.GetNtfyConfig().Enabled
+		},
+		Setup: func(cr configrepository.ConfigRepository) (Channel, string, error) {
+			c := cr.GetNtfyConfig()
+			ntfyNotifier, err := ntfy.New(c.ServerURL, c.Topic, c.AccessToken, cr.GetServerURL())
+			if err != nil {
+				return nil, "", err
+			}
+			return textChannel(ntfyNotifier.Send), c.GoLiveMessage, nil
+		},
+	})
+
+	RegisterChannel(GotifyNotification, ChannelRegistration{
+		Enabled: func(cr configrepository.ConfigRepository) bool {
+			return cr.GetGotifyConfig().Enabled
+		},
+		Setup: func(cr configrepository.ConfigRepository) (Channel, string, error) {
+			c := cr.GetGotifyConfig()
+			gotifyNotifier, err := gotify.New(c.ServerURL, c.AppToken)
+			if err != nil {
+				return nil, "", err
+			}
+			return textChannel(gotifyNotifier.Send), c.GoLiveMessage, nil
+		},
+	})
+
+	RegisterChannel(SlackNotification, ChannelRegistration{
+		Enabled: func(cr configrepository.ConfigRepository) bool {
+			return cr.GetSlackConfig().Enabled
+		},
+		Setup: func(cr configrepository.ConfigRepository) (Channel, string, error) {
+			c := cr.GetSlackConfig()
+			slackNotifier, err := slack.New(c.Webhook)
+			if err != nil {
+				return nil, "", err
+			}
+			return textChannel(slackNotifier.Send), c.GoLiveMessage, nil
+		},
+	})
+
+	RegisterChannel(EmailNotification, ChannelRegistration{
+		Enabled: func(cr configrepository.ConfigRepository) bool {
+			c := cr.GetEmailConfig()
+			return c.Enabled && (len(c.Recipients) > 0 || c.AllowSubscriptions)
+		},
+		Setup: func(cr configrepository.ConfigRepository) (Channel, string, error) {
+			c := cr.GetEmailConfig()
+			emailNotifier, err := email.New(c.SMTPHost, c.SMTPPort, c.Username, c.Password, c.FromAddress)
+			if err != nil {
+				return nil, "", err
+			}
+			return ChannelFunc(func(n Notification) error {
+				subject := n.Title
+				if n.Message != "" {
+					// Longer messages only use their first line.
+					subject += " - " + strings.SplitN(n.Message, "\n", 2)[0]
+				}
+				messages := []email.Message{}
+				for _, recipient := range c.Recipients {
+					messages = append(messages, email.Message{To: recipient, Subject: subject, Body: n.Text()})
+				}
+
+				if c.AllowSubscriptions && !n.Test && !n.Private {
+					subscriberMessages, err := emailSubscriberMessages(cr, subject, n.Text())
+					if err != nil {
+						return err
+					}
+					messages = append(messages, subscriberMessages...)
+				}
+
+				if len(messages) == 0 && n.Test {
+					return errors.New("there are no email recipients to test")
+				}
+
+				return emailNotifier.SendMessages(messages)
+			}), c.GoLiveMessage, nil
+		},
+	})
+}
+
+// textChannel will adapt a service that sends a title and text body.
+func textChannel(send func(title, message string) error) Channel {
+	return ChannelFunc(func(n Notification) error {
+		return send(n.Title, n.Text())
+	})
+}
+
+func setupBrowserPush(cr configrepository.ConfigRepository) (Channel, string, error) {
+	publicKey, err := cr.GetBrowserPushPublicKey()
+	if err != nil || publicKey == "" {
+		return nil, "", errors.Wrap(err, "browser notifier disabled, failed to get browser push public key")
+	}
+
+	privateKey, err := cr.GetBrowserPushPrivateKey()
+	if err != nil || privateKey == "" {
+		return nil, "", errors.Wrap(err, "browser notifier disabled, failed to get browser push private key")
+	}
+
+	browserNotifier, err := browser.New(data.GetDatastore(), publicKey, privateKey)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error creating browser notifier")
+	}
+
+	return ChannelFunc(func(n Notification) error {
+		destinations, err := GetNotificationDestinationsForChannel(BrowserPushNotification)
+		if err != nil {
+			return errors.Wrap(err, "error getting browser push notification destinations")
+		}
+
+		for _, destination := range destinations {
+			unsubscribed, err := browserNotifier.Send(destination, n.Title, n.Message)
+			if unsubscribed {
+				// If the error is "unsubscribed", then remove the destination from the database.
+				if err := RemoveNotificationForChannel(BrowserPushNotification, destination); err != nil {
+					log.Errorln(err)
+				}
+			} else if err != nil {
+				log.Errorln(err)
+			}
+		}
+
+		return nil
+	}), cr.GetBrowserPushConfig().GoLiveMessage, nil
+}
@@ -0,0 +1,288 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/owncast/owncast/config"
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// FediverseNotification is the go-live post sent to followers. It isn't a
+// registered channel but has a rule like one.
+const FediverseNotification = "FEDIVERSE"
+
+const maxMessageTemplateLength = 1000
+
+var (
+	// The last time each channel announced the stream going live.
+	lastGoLiveNotices     = map[string]time.Time{}
+	lastGoLiveNoticesLock sync.Mutex
+)
+
+// goLiveMessageData is what a rule's message template can use.
+type goLiveMessageData struct {
+	StreamTitle string
+	ServerName  string
+	ServerURL   string
+	Summary     string
+}
+
+// goLiveNotice is a go-live notification waiting for its channel's rule to
+// allow it.
+type goLiveNotice struct {
+	due            time.Time
+	send           func(message string) error
+	channel        string
+	defaultMessage string
+	rule           models.NotificationRule
+}
+
+// GetRuleChannelNames will return the names of every channel that can have
+// a notification rule.
+func GetRuleChannelNames() []string {
+	return append(GetChannelNames(), FediverseNotification)
+}
+
+// GetNotificationRule will return the go-live rule for a channel, or the
+// default rule if one hasn't been set.
+func GetNotificationRule(configRepository configrepository.ConfigRepository, channel string) models.NotificationRule {
+	if rule, ok := configRepository.GetNotificationRules()[channel]; ok {
+		return rule
+	}
+
+	defaults := config.GetDefaults()
+	return models.NotificationRule{
+		MinIntervalMinutes: defaults.NotificationMinIntervalMinutes,
+		MinUptimeMinutes:   defaults.NotificationMinUptimeMinutes,
+	}
+}
+
+// ValidateNotificationRules will return an error if any rule is for an
+// unknown channel or can't be used.
+func ValidateNotificationRules(rules map[string]models.NotificationRule) error {
+	channelNames := GetRuleChannelNames()
+
+	for channel, rule := range rules {
+		known := false
+		for _, name := range channelNames {
+			if name == channel {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("%s is not a notification channel", channel)
+		}
+
+		if err := validateNotificationRule(rule); err != nil {
+			return errors.Wrap(err, channel)
+		}
+	}
+
+	return nil
+}
+
+func validateNotificationRule(rule models.NotificationRule) error {
+	if rule.MinIntervalMinutes < 0 || rule.MinUptimeMinutes < 0 {
+		return errors.New("minutes can't be negative")
+	}
+
+	if (rule.QuietHoursStart == "") != (rule.QuietHoursEnd == "") {
+		return errors.New("quiet hours require both a start and an end")
+	}
+	if rule.QuietHoursStart != "" {
+		if _, err := parseClockTime(rule.QuietHoursStart); err != nil {
+			return err
+		}
+		if _, err := parseClockTime(rule.QuietHoursEnd); err != nil {
+			return err
+		}
+	}
+
+	if _, err := time.LoadLocation(rule.TimeZone); err != nil {
+		return fmt.Errorf("%s is not a valid time zone", rule.TimeZone)
+	}
+
+	if len(rule.MessageTemplate) > maxMessageTemplateLength {
+		return fmt.Errorf("the message template can't be longer than %d characters", maxMessageTemplateLength)
+	}
+	// Rendering with sample data catches references to unknown fields.
+	if _, err := renderGoLiveMessage(rule.MessageTemplate, goLiveMessageData{}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// parseClockTime will return the number of minutes after midnight of an
+// "HH:MM" time.
+func parseClockTime(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("%s is not a valid time, expected HH:MM", value)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// inQuietHours will return if the provided time falls within the rule's
+// quiet hours.
+func inQuietHours(rule models.NotificationRule, now time.Time) bool {
+	if rule.QuietHoursStart == "" || rule.QuietHoursEnd == "" {
+		return false
+	}
+
+	start, err := parseClockTime(rule.QuietHoursStart)
+	if err != nil {
+		return false
+	}
+	end, err := parseClockTime(rule.QuietHoursEnd)
+	if err != nil {
+		return false
+	}
+
+	if location, err := time.LoadLocation(rule.TimeZone); err == nil {
+		now = now.In(location)
+	}
+	minute := now.Hour()*60 + now.Minute()
+
+	if start <= end {
+		return minute >= start && minute < end
+	}
+
+	// The quiet hours cross midnight.
+	return minute >= start || minute < end
+}
+
+func renderGoLiveMessage(messageTemplate string, data goLiveMessageData) (string, error) {
+	tmpl, err := template.New("message").Parse(messageTemplate)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid message template")
+	}
+
+	var message bytes.Buffer
+	if err := tmpl.Execute(&message, data); err != nil {
+		return "", errors.Wrap(err, "invalid message template")
+	}
+
+	return strings.TrimSpace(message.String()), nil
+}
+
+// shouldSendGoLiveNotice will return if a channel's rule allows it to
+// announce the stream going live at the provided time.
+func shouldSendGoLiveNotice(channel string, rule models.NotificationRule, now time.Time) bool {
+	if inQuietHours(rule, now) {
+		return false
+	}
+
+	lastGoLiveNoticesLock.Lock()
+	defer lastGoLiveNoticesLock.Unlock()
+
+	last, ok := lastGoLiveNotices[channel]
+	return !ok || now.Sub(last) >= time.Duration(rule.MinIntervalMinutes)*time.Minute
+}
+
+func recordGoLiveNotice(channel string, sentAt time.Time) {
+	lastGoLiveNoticesLock.Lock()
+	defer lastGoLiveNoticesLock.Unlock()
+
+	lastGoLiveNotices[channel] = sentAt
+}
+
+// NotifyGoLive will announce that the stream went live at startedAt to
+// followers and every enabled channel, each once its rule allows it. It
+// returns once every notice has been handled or the context is cancelled
+// because the stream ended.
+func NotifyGoLive(ctx context.Context, startedAt time.Time, sendFediverse func(message string) error) {
+	n, err := New(data.GetDatastore())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+
+	notices := []goLiveNotice{}
+	for name, c := range n.channels {
+		defaultMessage := c.goLiveMessage
+		if defaultMessage == "" {
+			defaultMessage = config.GetDefaults().FederationGoLiveMessage
+		}
+
+		notices = append(notices, goLiveNotice{
+			channel:        name,
+			defaultMessage: defaultMessage,
+			send: func(message string) error {
+				return c.channel.Send(n.notification(message, n.configRepository.GetStreamTitle()))
+			},
+		})
+	}
+
+	if n.configRepository.GetFederationEnabled() && sendFediverse != nil {
+		notices = append(notices, goLiveNotice{
+			channel:        FediverseNotification,
+			defaultMessage: n.configRepository.GetFederationGoLiveMessage(),
+			send:           sendFediverse,
+		})
+	}
+
+	for i := range notices {
+		notices[i].rule = GetNotificationRule(n.configRepository, notices[i].channel)
+		notices[i].due = startedAt.Add(time.Duration(notices[i].rule.MinUptimeMinutes) * time.Minute)
+	}
+	sort.SliceStable(notices, func(i, j int) bool {
+		return notices[i].due.Before(notices[j].due)
+	})
+
+	for _, notice := range notices {
+		select {
+		case <-time.After(time.Until(notice.due)):
+		case <-ctx.Done():
+			return
+		}
+
+		n.sendGoLiveNotice(notice, time.Now())
+	}
+}
+
+func (n *Notifier) sendGoLiveNotice(notice goLiveNotice, now time.Time) {
+	if !shouldSendGoLiveNotice(notice.channel, notice.rule, now) {
+		log.Traceln("Skipping", notice.channel, "go live notification due to its rule.")
+		return
+	}
+
+	message := notice.defaultMessage
+	if notice.rule.MessageTemplate != "" {
+		rendered, err := renderGoLiveMessage(notice.rule.MessageTemplate, goLiveMessageData{
+			StreamTitle: n.configRepository.GetStreamTitle(),
+			ServerName:  n.configRepository.GetServerName(),
+			ServerURL:   n.configRepository.GetServerURL(),
+			Summary:     n.configRepository.GetServerSummary(),
+		})
+		if err != nil {
+			log.Errorln("unable to render", notice.channel, "go live message", err)
+		} else {
+			message = rendered
+		}
+	}
+
+	// Nothing to announce, e.g. an empty Fediverse go live message.
+	if message == "" {
+		return
+	}
+
+	if err := notice.send(message); err != nil {
+		log.Errorln("error sending", notice.channel, "go live notification", err)
+	}
+
+	recordGoLiveNotice(notice.channel, now)
+}
@@ -0,0 +1,111 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/models"
+)
+
+func TestInQuietHours(t *testing.T) {
+	overnight := models.NotificationRule{QuietHoursStart: "22:00", QuietHoursEnd: "07:30", TimeZone: "UTC"}
+	daytime := models.NotificationRule{QuietHoursStart: "09:00", QuietHoursEnd: "17:00", TimeZone: "UTC"}
+
+	tests := []struct {
+		rule     models.NotificationRule
+		clock    string
+		expected bool
+	}{
+		{overnight, "23:15", true},
+		{overnight, "03:00", true},
+		{overnight, "07:30", false},
+		{overnight, "12:00", false},
+		{daytime, "09:00", true},
+		{daytime, "16:59", true},
+		{daytime, "17:00", false},
+		{models.NotificationRule{}, "03:00", false},
+	}
+
+	for _, test := range tests {
+		now, _ := time.Parse("2006-01-02 15:04", "2026-05-01 "+test.clock)
+		if result := inQuietHours(test.rule, now); result != test.expected {
+			t.Errorf("Expected %s to be in quiet hours %s-%s: %v", test.clock, test.rule.QuietHoursStart, test.rule.QuietHoursEnd, test.expected)
+		}
+	}
+}
+
+func TestInQuietHoursTimeZone(t *testing.T) {
+	rule := models.NotificationRule{QuietHoursStart: "22:00", QuietHoursEnd: "06:00", TimeZone: "America/New_York"}
+
+	// 03:00 UTC is 23:00 the day before in New York.
+	now := time.Date(2026, 5, 1, 3, 0, 0, 0, time.UTC)
+	if !inQuietHours(rule, now) {
+		t.Error("Expected quiet hours to use the rule's time zone")
+	}
+}
+
+func TestValidateNotificationRules(t *testing.T) {
+	valid := map[string]models.NotificationRule{
+		DiscordNotification:   {QuietHoursStart: "23:00", QuietHoursEnd: "08:00", TimeZone: "Europe/Berlin", MinIntervalMinutes: 60},
+		FediverseNotification: {MessageTemplate: "Live now: {{.StreamTitle}} {{.ServerURL}}", MinUptimeMinutes: 5},
+	}
+	if err := ValidateNotificationRules(valid); err != nil {
+		t.Errorf("Expected rules to be valid: %s", err)
+	}
+
+	invalid := map[string]map[string]models.NotificationRule{
+		"unknown channel":   {"CARRIER_PIGEON": {}},
+		"negative minutes":  {SlackNotification: {MinIntervalMinutes: -1}},
+		"half quiet hours":  {SlackNotification: {QuietHoursStart: "22:00"}},
+		"invalid time":      {SlackNotification: {QuietHoursStart: "25:00", QuietHoursEnd: "06:00"}},
+		"invalid time zone": {SlackNotification: {TimeZone: "Mars/Olympus_Mons"}},
+		"unknown field":     {SlackNotification: {MessageTemplate: "{{.StreamKey}}"}},
+		"invalid template":  {SlackNotification: {MessageTemplate: "{{.StreamTitle"}},
+	}
+	for name, rules := range invalid {
+		if err := ValidateNotificationRules(rules); err == nil {
+			t.Errorf("Expected %s to be invalid", name)
+		}
+	}
+}
+
+func TestRenderGoLiveMessage(t *testing.T) {
+	message, err := renderGoLiveMessage("{{.ServerName}} is live: {{.StreamTitle}}\n{{.ServerURL}}", goLiveMessageData{
+		StreamTitle: "Speedruns",
+		ServerName:  "My Stream",
+		ServerURL:   "https://live.example.com",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := "My Stream is live: Speedruns\nhttps://live.example.com"; message != expected {
+		t.Errorf("Expected %q but got %q", expected, message)
+	}
+}
+
+func TestShouldSendGoLiveNotice(t *testing.T) {
+	channel := "TEST_INTERVAL"
+	rule := models.NotificationRule{MinIntervalMinutes: 30}
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	if !shouldSendGoLiveNotice(channel, rule, now) {
+		t.Fatal("Expected the first notice to be sent")
+	}
+	recordGoLiveNotice(channel, now)
+
+	if shouldSendGoLiveNotice(channel, rule, now.Add(29*time.Minute)) {
+		t.Error("Expected a notice within the interval to be skipped")
+	}
+	if !shouldSendGoLiveNotice(channel, rule, now.Add(30*time.Minute)) {
+		t.Error("Expected a notice after the interval to be sent")
+	}
+	if !shouldSendGoLiveNotice("TEST_OTHER_CHANNEL", rule, now.Add(time.Minute)) {
+		t.Error("Expected intervals to be tracked per channel")
+	}
+
+	quiet := models.NotificationRule{QuietHoursStart: "11:00", QuietHoursEnd: "13:00", TimeZone: "UTC"}
+	if shouldSendGoLiveNotice("TEST_QUIET", quiet, now) {
+		t.Error("Expected a notice during quiet hours to be skipped")
+	}
+}
@@ -0,0 +1,59 @@
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Slack is an instance of the Slack service.
+type Slack struct {
+	webhookURL string
+}
+
+// New will create a new instance of the Slack service.
+func New(webhook string) (*Slack, error) {
+	if webhook == "" {
+		return nil, errors.New("slack requires an incoming webhook url")
+	}
+
+	return &Slack{
+		webhookURL: webhook,
+	}, nil
+}
+
+// Send will send a message to a Slack channel via an incoming webhook.
+func (s *Slack) Send(title, message string) error {
+	type slackMessage struct {
+		Text string `json:"text"`
+	}
+
+	jsonText, err := json.Marshal(slackMessage{Text: message})
+	if err != nil {
+		return errors.Wrap(err, "error marshalling slack message to json")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.webhookURL, bytes.NewReader(jsonText))
+	if err != nil {
+		return errors.Wrap(err, "error creating slack webhook request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "error executing slack webhook")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("slack responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
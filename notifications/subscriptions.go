@@ -0,0 +1,333 @@
+package notifications
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/owncast/owncast/notifications/email"
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/owncast/owncast/utils"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+const (
+	confirmSubscriptionPurpose = "confirm"
+	unsubscribePurpose         = "unsubscribe"
+
+	// How long a confirmation link can be used for.
+	confirmationLinkLifetime = 48 * time.Hour
+
+	// How often a confirmation email can be sent to the same address.
+	confirmationResendInterval = 10 * time.Minute
+
+	// How often a single IP address can ask for a confirmation email, and
+	// how many it can ask for at once.
+	ipConfirmationInterval = 10 * time.Minute
+	ipConfirmationBurst    = 3
+
+	// How often confirmation emails can be sent across every requester, and
+	// how many can be sent at once.
+	globalConfirmationInterval = 30 * time.Second
+	globalConfirmationBurst    = 10
+)
+
+var (
+	// ErrInvalidSubscriptionToken is returned when a confirmation or
+	// unsubscribe link has been tampered with or has expired.
+	ErrInvalidSubscriptionToken = errors.New("this link is invalid or has expired")
+	// ErrEmailSubscriptionsDisabled is returned when viewers can't subscribe
+	// to go-live emails.
+	ErrEmailSubscriptionsDisabled = errors.New("email subscriptions are not enabled")
+	// ErrInvalidEmailAddress is returned when an address can't be parsed.
+	ErrInvalidEmailAddress = errors.New("invalid email address")
+	// ErrTooManySubscriptionRequests is returned when confirmation emails
+	// are being asked for too often.
+	ErrTooManySubscriptionRequests = errors.New("too many subscription requests, please try again later")
+)
+
+var (
+	// Addresses and requesters are forgotten once they could no longer be
+	// limited, so neither map grows past what the limits allow.
+	recentConfirmations     = map[string]time.Time{}
+	recentRequesters        = map[string]*rate.Limiter{}
+	globalConfirmations     = rate.NewLimiter(rate.Every(globalConfirmationInterval), globalConfirmationBurst)
+	recentConfirmationsLock sync.Mutex
+)
+
+func initializeEmailSubscriptionKeyIfNeeded() {
+	configRepository := configrepository.Get()
+
+	if key, _ := configRepository.GetEmailSubscriptionSigningKey(); key != "" {
+		return
+	}
+
+	key, err := utils.GenerateRandomString(32)
+	if err != nil {
+		log.Errorln("unable to generate email subscription signing key", err)
+		return
+	}
+
+	if err := configRepository.SetEmailSubscriptionSigningKey(key); err != nil {
+		log.Errorln("unable to set email subscription signing key", err)
+	}
+}
+
+// SubscribeEmail will send a confirmation link to an email address. The
+// address is only subscribed once the link is followed. The email is sent
+// in the background so a slow mail server doesn't hold up the requester.
+func SubscribeEmail(address string, ipAddress string) error {
+	configRepository := configrepository.Get()
+	config := configRepository.GetEmailConfig()
+	if !config.Enabled || !config.AllowSubscriptions {
+		return ErrEmailSubscriptionsDisabled
+	}
+
+	serverURL := configRepository.GetServerURL()
+	if serverURL == "" {
+		return errors.New("the server url must be set for email subscriptions")
+	}
+
+	address, err := normalizeEmailAddress(address)
+	if err != nil {
+		return err
+	}
+
+	// Don't let the form be used to flood an inbox. Repeats for the same
+	// address are quietly dropped so the form doesn't reveal anything.
+	if allowed, err := allowConfirmation(address, ipAddress, time.Now()); !allowed {
+		return err
+	}
+
+	if subscribed, err := isEmailSubscribed(address); err != nil || subscribed {
+		return err
+	}
+
+	key, err := subscriptionSigningKey()
+	if err != nil {
+		return err
+	}
+
+	token := signSubscriptionToken(key, confirmSubscriptionPurpose, address, time.Now().Add(confirmationLinkLifetime))
+	link := subscriptionURL(serverURL, "confirm", token)
+
+	emailNotifier, err := email.New(config.SMTPHost, config.SMTPPort, config.Username, config.Password, config.FromAddress)
+	if err != nil {
+		return err
+	}
+
+	serverName := configRepository.GetServerName()
+	body := "Someone, hopefully you, asked to be emailed when " + serverName + " goes live.\n\n" +
+		"Confirm your subscription by visiting:\n" + link + "\n\n" +
+		"If this wasn't you, ignore this email and you won't hear from us again."
+
+	go func() {
+		if err := emailNotifier.Send([]string{address}, "Confirm your subscription to "+serverName, body); err != nil {
+			log.Errorln("unable to send email subscription confirmation", err)
+		}
+	}()
+
+	return nil
+}
+
+// allowConfirmation will return if a confirmation email can be sent to an
+// address for a requester. An error is returned when the requester, or
+// everyone, has asked for too many.
+func allowConfirmation(address string, ipAddress string, now time.Time) (bool, error) {
+	recentConfirmationsLock.Lock()
+	defer recentConfirmationsLock.Unlock()
+
+	for a, sent := range recentConfirmations {
+		if now.Sub(sent) >= confirmationResendInterval {
+			delete(recentConfirmations, a)
+		}
+	}
+	for ip, limiter := range recentRequesters {
+		if limiter.TokensAt(now) >= ipConfirmationBurst {
+			delete(recentRequesters, ip)
+		}
+	}
+
+	if _, ok := recentConfirmations[address]; ok {
+		return false, nil
+	}
+
+	requester, ok := recentRequesters[ipAddress]
+	if !ok {
+		requester = rate.NewLimiter(rate.Every(ipConfirmationInterval), ipConfirmationBurst)
+		recentRequesters[ipAddress] = requester
+	}
+	if !requester.AllowN(now, 1) {
+		return false, ErrTooManySubscriptionRequests
+	}
+	if !globalConfirmations.AllowN(now, 1) {
+		return false, ErrTooManySubscriptionRequests
+	}
+
+	recentConfirmations[address] = now
+
+	return true, nil
+}
+
+// ConfirmEmailSubscription will subscribe the address in a confirmation
+// link and return it.
+func ConfirmEmailSubscription(token string) (string, error) {
+	key, err := subscriptionSigningKey()
+	if err != nil {
+		return "", err
+	}
+
+	address, err := verifySubscriptionToken(key, confirmSubscriptionPurpose, token, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	subscribed, err := isEmailSubscribed(address)
+	if err != nil || subscribed {
+		return address, err
+	}
+
+	return address, AddNotification(EmailNotification, address)
+}
+
+// UnsubscribeEmail will remove the address in an unsubscribe link and
+// return it.
+func UnsubscribeEmail(token string) (string, error) {
+	key, err := subscriptionSigningKey()
+	if err != nil {
+		return "", err
+	}
+
+	address, err := verifySubscriptionToken(key, unsubscribePurpose, token, time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	return address, RemoveNotificationForChannel(EmailNotification, address)
+}
+
+// emailSubscriberMessages will return a go-live email for every subscribed
+// address, each with its own unsubscribe link.
+func emailSubscriberMessages(configRepository configrepository.ConfigRepository, subject, text string) ([]email.Message, error) {
+	subscribers, err := GetNotificationDestinationsForChannel(EmailNotification)
+	if err != nil || len(subscribers) == 0 {
+		return nil, err
+	}
+
+	key, err := subscriptionSigningKey()
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL := configRepository.GetServerURL()
+	serverName := configRepository.GetServerName()
+
+	messages := make([]email.Message, 0, len(subscribers))
+	for _, address := range subscribers {
+		link := subscriptionURL(serverURL, "unsubscribe", signSubscriptionToken(key, unsubscribePurpose, address, time.Time{}))
+		messages = append(messages, email.Message{
+			To:      address,
+			Subject: subject,
+			Body:    text + "\n\n--\nYou're receiving this because you subscribed to " + serverName + ".\nUnsubscribe: " + link,
+			Headers: map[string]string{
+				"List-Unsubscribe":      "<" + link + ">",
+				"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+			},
+		})
+	}
+
+	return messages, nil
+}
+
+func isEmailSubscribed(address string) (bool, error) {
+	subscribers, err := GetNotificationDestinationsForChannel(EmailNotification)
+	if err != nil {
+		return false, err
+	}
+
+	_, found := utils.FindInSlice(subscribers, address)
+	return found, nil
+}
+
+func normalizeEmailAddress(address string) (string, error) {
+	parsed, err := mail.ParseAddress(strings.TrimSpace(address))
+	if err != nil {
+		return "", ErrInvalidEmailAddress
+	}
+
+	return strings.ToLower(parsed.Address), nil
+}
+
+func subscriptionSigningKey() ([]byte, error) {
+	key, err := configrepository.Get().GetEmailSubscriptionSigningKey()
+	if err != nil || key == "" {
+		return nil, errors.New("email subscription signing key is not set")
+	}
+
+	return []byte(key), nil
+}
+
+func subscriptionURL(serverURL, action, token string) string {
+	return strings.TrimSuffix(serverURL, "/") + "/api/notifications/email/" + action + "?token=" + url.QueryEscape(token)
+}
+
+// signSubscriptionToken will sign an address for a single purpose. A zero
+// expiry means the token never expires.
+func signSubscriptionToken(key []byte, purpose, address string, expires time.Time) string {
+	var expiresAt int64
+	if !expires.IsZero() {
+		expiresAt = expires.Unix()
+	}
+
+	payload := purpose + "\n" + address + "\n" + strconv.FormatInt(expiresAt, 10)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
+		base64.RawURLEncoding.EncodeToString(subscriptionSignature(key, payload))
+}
+
+// verifySubscriptionToken will return the address a token was signed for.
+func verifySubscriptionToken(key []byte, purpose, token string, now time.Time) (string, error) {
+	encodedPayload, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return "", ErrInvalidSubscriptionToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return "", ErrInvalidSubscriptionToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return "", ErrInvalidSubscriptionToken
+	}
+
+	if !hmac.Equal(signature, subscriptionSignature(key, string(payload))) {
+		return "", ErrInvalidSubscriptionToken
+	}
+
+	fields := strings.Split(string(payload), "\n")
+	if len(fields) != 3 || fields[0] != purpose {
+		return "", ErrInvalidSubscriptionToken
+	}
+
+	expiresAt, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil || (expiresAt != 0 && now.Unix() > expiresAt) {
+		return "", ErrInvalidSubscriptionToken
+	}
+
+	return fields[1], nil
+}
+
+func subscriptionSignature(key []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,102 @@
+package notifications
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestSubscriptionTokens(t *testing.T) {
+	key := []byte("test key")
+	now := time.Now()
+
+	token := signSubscriptionToken(key, confirmSubscriptionPurpose, "viewer@example.com", now.Add(time.Hour))
+
+	address, err := verifySubscriptionToken(key, confirmSubscriptionPurpose, token, now)
+	if err != nil || address != "viewer@example.com" {
+		t.Errorf("Expected the token to verify for viewer@example.com but got %q, %v", address, err)
+	}
+
+	if _, err := verifySubscriptionToken(key, unsubscribePurpose, token, now); err == nil {
+		t.Error("Expected a confirmation token to not be usable to unsubscribe")
+	}
+
+	if _, err := verifySubscriptionToken([]byte("other key"), confirmSubscriptionPurpose, token, now); err == nil {
+		t.Error("Expected a token signed with another key to be rejected")
+	}
+
+	if _, err := verifySubscriptionToken(key, confirmSubscriptionPurpose, token, now.Add(2*time.Hour)); err == nil {
+		t.Error("Expected an expired token to be rejected")
+	}
+
+	tampered := signSubscriptionToken(key, confirmSubscriptionPurpose, "someone@example.com", now.Add(time.Hour))
+	forged := tampered[:len(tampered)-4] + token[len(token)-4:]
+	if _, err := verifySubscriptionToken(key, confirmSubscriptionPurpose, forged, now); err == nil {
+		t.Error("Expected a tampered token to be rejected")
+	}
+
+	unsubscribeToken := signSubscriptionToken(key, unsubscribePurpose, "viewer@example.com", time.Time{})
+	if _, err := verifySubscriptionToken(key, unsubscribePurpose, unsubscribeToken, now.AddDate(10, 0, 0)); err != nil {
+		t.Errorf("Expected unsubscribe tokens to never expire: %v", err)
+	}
+}
+
+func TestNormalizeEmailAddress(t *testing.T) {
+	address, err := normalizeEmailAddress(" Viewer <Viewer@Example.com> ")
+	if err != nil || address != "viewer@example.com" {
+		t.Errorf("Expected viewer@example.com but got %q, %v", address, err)
+	}
+
+	if _, err := normalizeEmailAddress("not an address"); err == nil {
+		t.Error("Expected an invalid address to be rejected")
+	}
+}
+
+func TestAllowConfirmation(t *testing.T) {
+	recentConfirmations = map[string]time.Time{}
+	recentRequesters = map[string]*rate.Limiter{}
+	globalConfirmations = rate.NewLimiter(rate.Every(globalConfirmationInterval), globalConfirmationBurst)
+	now := time.Now()
+
+	if allowed, err := allowConfirmation("a@example.com", "1.1.1.1", now); !allowed || err != nil {
+		t.Fatalf("Expected the first confirmation to be allowed but got %v, %v", allowed, err)
+	}
+
+	if allowed, err := allowConfirmation("a@example.com", "2.2.2.2", now); allowed || err != nil {
+		t.Errorf("Expected a repeat for the same address to be silently skipped but got %v, %v", allowed, err)
+	}
+
+	for i := 1; i < ipConfirmationBurst; i++ {
+		if allowed, _ := allowConfirmation(fmt.Sprintf("%d@example.com", i), "1.1.1.1", now); !allowed {
+			t.Fatalf("Expected confirmation %d from the same address to be allowed", i)
+		}
+	}
+	if _, err := allowConfirmation("over@example.com", "1.1.1.1", now); !errors.Is(err, ErrTooManySubscriptionRequests) {
+		t.Errorf("Expected the per-IP limit to be enforced but got %v", err)
+	}
+
+	later := now.Add(confirmationResendInterval + ipConfirmationInterval*ipConfirmationBurst)
+	globalConfirmations = rate.NewLimiter(rate.Every(globalConfirmationInterval), globalConfirmationBurst)
+	if allowed, err := allowConfirmation("a@example.com", "1.1.1.1", later); !allowed || err != nil {
+		t.Errorf("Expected the address to be allowed again once its entry expired but got %v, %v", allowed, err)
+	}
+	if len(recentConfirmations) != 1 {
+		t.Errorf("Expected expired confirmations to be pruned but %d remain", len(recentConfirmations))
+	}
+	if _, ok := recentRequesters["2.2.2.2"]; ok {
+		t.Error("Expected an idle requester to be pruned")
+	}
+
+	globalConfirmations = rate.NewLimiter(rate.Every(globalConfirmationInterval), globalConfirmationBurst)
+	for i := 0; i < globalConfirmationBurst; i++ {
+		if allowed, _ := allowConfirmation(fmt.Sprintf("global%d@example.com", i), fmt.Sprintf("10.0.0.%d", i), later); !allowed {
+			t.Fatalf("Expected confirmation %d to be allowed", i)
+		}
+	}
+	if _, err := allowConfirmation("global@example.com", "10.0.1.1", later); !errors.Is(err, ErrTooManySubscriptionRequests) {
+		t.Errorf("Expected the global limit to be enforced but got %v", err)
+	}
+}
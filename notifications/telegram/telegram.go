@@ -0,0 +1,66 @@
+package telegram
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// apiURL is the Telegram Bot API the messages are sent through.
+var apiURL = "https://api.telegram.org"
+
+// Telegram is an instance of the Telegram service.
+type Telegram struct {
+	botToken string
+	chatID   string
+}
+
+// New will create a new instance of the Telegram service.
+func New(botToken, chatID string) (*Telegram, error) {
+	if botToken == "" || chatID == "" {
+		return nil, errors.New("telegram requires a bot token and chat id")
+	}
+
+	return &Telegram{
+		botToken: botToken,
+		chatID:   chatID,
+	}, nil
+}
+
+// Send will send a message to a Telegram chat as the bot.
+func (t *Telegram) Send(title, message string) error {
+	type sendMessage struct {
+		ChatID string `json:"chat_id"`
+		Text   string `json:"text"`
+	}
+
+	jsonText, err := json.Marshal(sendMessage{ChatID: t.chatID, Text: message})
+	if err != nil {
+		return errors.Wrap(err, "error marshalling telegram message to json")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, apiURL+"/bot"+t.botToken+"/sendMessage", bytes.NewReader(jsonText))
+	if err != nil {
+		return errors.Wrap(err, "error creating telegram request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		// Don't include the request URL, as it contains the bot token.
+		return errors.New("error sending telegram message")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("telegram responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
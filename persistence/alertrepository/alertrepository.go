@@ -0,0 +1,122 @@
+package alertrepository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/pkg/errors"
+)
+
+type AlertRepository interface {
+	CreateAlert(alert models.Alert) error
+	ResolveAlert(id string, resolvedAt time.Time) error
+	ResolveOpenAlerts(resolvedAt time.Time) error
+	GetAlerts(offset int, limit int) ([]models.Alert, int, error)
+}
+
+type SqlAlertRepository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance AlertRepository
+
+// Get will return the alert repository.
+func Get() AlertRepository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the AlertRepository.
+func New(datastore *data.Datastore) AlertRepository {
+	r := SqlAlertRepository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+const alertColumns = `id, rule_id, metric, comparison, severity, value, threshold, fired_at, resolved_at`
+
+// CreateAlert will save an alert that has just fired.
+func (r *SqlAlertRepository) CreateAlert(alert models.Alert) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("INSERT INTO alerts(id, rule_id, metric, comparison, severity, value, threshold, fired_at) values(?, ?, ?, ?, ?, ?, ?, ?)",
+		alert.ID, alert.RuleID, alert.Metric, alert.Comparison, alert.Severity, alert.Value, alert.Threshold, alert.FiredAt)
+
+	return errors.Wrap(err, "error saving alert")
+}
+
+// ResolveAlert will record when an alert's condition cleared.
+func (r *SqlAlertRepository) ResolveAlert(id string, resolvedAt time.Time) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("UPDATE alerts SET resolved_at = ? WHERE id = ?", resolvedAt, id)
+
+	return errors.Wrap(err, "error resolving alert")
+}
+
+// ResolveOpenAlerts will resolve every alert that is still open. Alert
+// state isn't kept across restarts so these would otherwise never resolve.
+func (r *SqlAlertRepository) ResolveOpenAlerts(resolvedAt time.Time) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("UPDATE alerts SET resolved_at = ? WHERE resolved_at IS NULL", resolvedAt)
+
+	return errors.Wrap(err, "error resolving open alerts")
+}
+
+// GetAlerts will return a page of alerts, newest first, along with the
+// total number of alerts.
+func (r *SqlAlertRepository) GetAlerts(offset int, limit int) ([]models.Alert, int, error) {
+	alerts := []models.Alert{}
+
+	var total int
+	if err := r.datastore.DB.QueryRow("SELECT COUNT(*) FROM alerts").Scan(&total); err != nil {
+		return alerts, 0, errors.Wrap(err, "error counting alerts")
+	}
+
+	rows, err := r.datastore.DB.Query("SELECT "+alertColumns+" FROM alerts ORDER BY fired_at DESC LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return alerts, 0, errors.Wrap(err, "error fetching alerts")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		alert, err := scanAlert(rows)
+		if err != nil {
+			return alerts, 0, errors.Wrap(err, "error reading alerts")
+		}
+		alerts = append(alerts, *alert)
+	}
+
+	return alerts, total, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAlert(row scanner) (*models.Alert, error) {
+	var alert models.Alert
+	var resolvedAt sql.NullTime
+
+	if err := row.Scan(&alert.ID, &alert.RuleID, &alert.Metric, &alert.Comparison, &alert.Severity, &alert.Value, &alert.Threshold, &alert.FiredAt, &resolvedAt); err != nil {
+		return nil, err
+	}
+
+	if resolvedAt.Valid {
+		alert.ResolvedAt = &resolvedAt.Time
+	}
+
+	return &alert, nil
+}
@@ -0,0 +1,212 @@
+package broadcastrepository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/pkg/errors"
+)
+
+type BroadcastRepository interface {
+	StartBroadcast(broadcast models.Broadcast) error
+	EndBroadcast(id string, endedAt time.Time, broadcaster *models.Broadcaster, peakViewerCount int) error
+	SetBroadcastAnalytics(id string, peakViewerCount int, averageViewerCount float64, chatMessageCount int, chatterCount int, viewersOverTime []models.TimestampedValue) error
+	GetBroadcasts(offset int, limit int) ([]models.Broadcast, int, error)
+	GetBroadcast(id string) (*models.Broadcast, error)
+	GetBroadcastViewersOverTime(id string) ([]models.TimestampedValue, error)
+}
+
+type SqlBroadcastRepository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance BroadcastRepository
+
+// Get will return the broadcast repository.
+func Get() BroadcastRepository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the BroadcastRepository.
+func New(datastore *data.Datastore) BroadcastRepository {
+	r := SqlBroadcastRepository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+const broadcastColumns = `id, started_at, ended_at, title, tags, output_settings, latency_level, broadcaster,
+	peak_viewer_count, average_viewer_count, chat_message_count, chatter_count`
+
+// StartBroadcast will save a broadcast that has just started.
+func (r *SqlBroadcastRepository) StartBroadcast(broadcast models.Broadcast) error {
+	tags, err := json.Marshal(broadcast.Tags)
+	if err != nil {
+		return err
+	}
+	outputSettings, err := json.Marshal(broadcast.OutputSettings)
+	if err != nil {
+		return err
+	}
+
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err = r.datastore.DB.Exec("INSERT INTO broadcasts(id, started_at, title, tags, output_settings, latency_level) values(?, ?, ?, ?, ?, ?)",
+		broadcast.ID, broadcast.StartedAt, broadcast.Title, string(tags), string(outputSettings), broadcast.LatencyLevel)
+
+	return err
+}
+
+// EndBroadcast will record when a broadcast ended along with the details
+// of the broadcaster's connection.
+func (r *SqlBroadcastRepository) EndBroadcast(id string, endedAt time.Time, broadcaster *models.Broadcaster, peakViewerCount int) error {
+	var broadcasterJSON sql.NullString
+	if broadcaster != nil {
+		b, err := json.Marshal(broadcaster)
+		if err != nil {
+			return err
+		}
+		broadcasterJSON = sql.NullString{String: string(b), Valid: true}
+	}
+
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("UPDATE broadcasts SET ended_at = ?, broadcaster = ?, peak_viewer_count = ? WHERE id = ?",
+		endedAt, broadcasterJSON, peakViewerCount, id)
+
+	return err
+}
+
+// SetBroadcastAnalytics will save the viewer and chat activity of a
+// broadcast. The viewer counts over time are kept with the broadcast as
+// the metrics they come from are only kept for a couple of weeks.
+func (r *SqlBroadcastRepository) SetBroadcastAnalytics(id string, peakViewerCount int, averageViewerCount float64, chatMessageCount int, chatterCount int, viewersOverTime []models.TimestampedValue) error {
+	if viewersOverTime == nil {
+		viewersOverTime = []models.TimestampedValue{}
+	}
+	viewers, err := json.Marshal(viewersOverTime)
+	if err != nil {
+		return err
+	}
+
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err = r.datastore.DB.Exec("UPDATE broadcasts SET peak_viewer_count = ?, average_viewer_count = ?, chat_message_count = ?, chatter_count = ?, viewers_over_time = ? WHERE id = ?",
+		peakViewerCount, averageViewerCount, chatMessageCount, chatterCount, string(viewers), id)
+
+	return err
+}
+
+// GetBroadcasts will return a page of broadcasts, newest first, and the
+// total number of broadcasts.
+func (r *SqlBroadcastRepository) GetBroadcasts(offset int, limit int) ([]models.Broadcast, int, error) {
+	broadcasts := []models.Broadcast{}
+
+	var total int
+	if err := r.datastore.DB.QueryRow("SELECT COUNT(*) FROM broadcasts").Scan(&total); err != nil {
+		return broadcasts, 0, errors.Wrap(err, "error counting broadcasts")
+	}
+
+	rows, err := r.datastore.DB.Query("SELECT "+broadcastColumns+" FROM broadcasts ORDER BY started_at DESC LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return broadcasts, 0, errors.Wrap(err, "error fetching broadcasts")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		broadcast, err := scanBroadcast(rows)
+		if err != nil {
+			return broadcasts, 0, errors.Wrap(err, "error reading broadcasts")
+		}
+		broadcasts = append(broadcasts, *broadcast)
+	}
+
+	return broadcasts, total, rows.Err()
+}
+
+// GetBroadcast will return a single broadcast, or nil if it does not exist.
+func (r *SqlBroadcastRepository) GetBroadcast(id string) (*models.Broadcast, error) {
+	broadcast, err := scanBroadcast(r.datastore.DB.QueryRow("SELECT "+broadcastColumns+" FROM broadcasts WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return broadcast, err
+}
+
+// GetBroadcastViewersOverTime will return the viewer counts saved when a
+// broadcast ended, or nil if none were saved.
+func (r *SqlBroadcastRepository) GetBroadcastViewersOverTime(id string) ([]models.TimestampedValue, error) {
+	var viewers sql.NullString
+	if err := r.datastore.DB.QueryRow("SELECT viewers_over_time FROM broadcasts WHERE id = ?", id).Scan(&viewers); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "error fetching broadcast viewers")
+	}
+	if !viewers.Valid {
+		return nil, nil
+	}
+
+	viewersOverTime := []models.TimestampedValue{}
+	if err := json.Unmarshal([]byte(viewers.String), &viewersOverTime); err != nil {
+		return nil, errors.Wrap(err, "invalid broadcast viewers")
+	}
+
+	return viewersOverTime, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanBroadcast(row scanner) (*models.Broadcast, error) {
+	var broadcast models.Broadcast
+	var endedAt sql.NullTime
+	var title, tags, outputSettings, broadcaster sql.NullString
+
+	if err := row.Scan(&broadcast.ID, &broadcast.StartedAt, &endedAt, &title, &tags, &outputSettings, &broadcast.LatencyLevel, &broadcaster,
+		&broadcast.PeakViewerCount, &broadcast.AverageViewerCount, &broadcast.ChatMessageCount, &broadcast.ChatterCount); err != nil {
+		return nil, err
+	}
+
+	if endedAt.Valid {
+		broadcast.EndedAt = &endedAt.Time
+	}
+	broadcast.Title = title.String
+
+	broadcast.Tags = []string{}
+	if tags.Valid {
+		if err := json.Unmarshal([]byte(tags.String), &broadcast.Tags); err != nil {
+			return nil, errors.Wrap(err, "invalid broadcast tags")
+		}
+	}
+
+	broadcast.OutputSettings = []models.StreamOutputVariant{}
+	if outputSettings.Valid {
+		if err := json.Unmarshal([]byte(outputSettings.String), &broadcast.OutputSettings); err != nil {
+			return nil, errors.Wrap(err, "invalid broadcast output settings")
+		}
+	}
+
+	if broadcaster.Valid {
+		broadcast.Broadcaster = &models.Broadcaster{}
+		if err := json.Unmarshal([]byte(broadcaster.String), broadcast.Broadcaster); err != nil {
+			return nil, errors.Wrap(err, "invalid broadcaster details")
+		}
+	}
+
+	return &broadcast, nil
+}
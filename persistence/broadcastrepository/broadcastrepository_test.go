@@ -0,0 +1,223 @@
+package broadcastrepository
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/teris-io/shortid"
+)
+
+func TestMain(m *testing.M) {
+	dbFile, err := os.CreateTemp(os.TempDir(), "owncast-broadcasts-test-db.db")
+	if err != nil {
+		panic(err)
+	}
+	dbFile.Close()
+	defer os.Remove(dbFile.Name())
+
+	if err := data.SetupPersistence(dbFile.Name()); err != nil {
+		panic(err)
+	}
+
+	m.Run()
+}
+
+func startTestBroadcast(t *testing.T, startedAt time.Time) models.Broadcast {
+	t.Helper()
+
+	broadcast := models.Broadcast{
+		ID:           shortid.MustGenerate(),
+		StartedAt:    startedAt,
+		Title:        "Test broadcast",
+		Tags:         []string{"music", "live"},
+		LatencyLevel: 3,
+		OutputSettings: []models.StreamOutputVariant{
+			{Name: "high", VideoBitrate: 2500, Framerate: 30},
+		},
+	}
+
+	if err := New(data.GetDatastore()).StartBroadcast(broadcast); err != nil {
+		t.Fatal(err)
+	}
+
+	return broadcast
+}
+
+func TestBroadcastLifecycle(t *testing.T) {
+	repository := New(data.GetDatastore())
+	startedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	started := startTestBroadcast(t, startedAt)
+
+	broadcast, err := repository.GetBroadcast(started.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if broadcast == nil {
+		t.Fatal("started broadcast was not saved")
+	}
+	if !broadcast.StartedAt.Equal(startedAt) {
+		t.Errorf("broadcast started at %s, expected %s", broadcast.StartedAt, startedAt)
+	}
+	if broadcast.EndedAt != nil {
+		t.Error("a broadcast that is still live should not have ended")
+	}
+	if broadcast.Title != started.Title || broadcast.LatencyLevel != started.LatencyLevel {
+		t.Errorf("unexpected broadcast details %+v", broadcast)
+	}
+	if len(broadcast.Tags) != 2 || broadcast.Tags[0] != "music" {
+		t.Errorf("unexpected broadcast tags %v", broadcast.Tags)
+	}
+	if len(broadcast.OutputSettings) != 1 || broadcast.OutputSettings[0].VideoBitrate != 2500 {
+		t.Errorf("unexpected broadcast output settings %+v", broadcast.OutputSettings)
+	}
+
+	endedAt := startedAt.Add(45 * time.Minute)
+	broadcaster := &models.Broadcaster{
+		RemoteAddr: "127.0.0.1",
+		StreamDetails: models.InboundStreamDetails{
+			VideoCodec: "H.264",
+		},
+	}
+	if err := repository.EndBroadcast(started.ID, endedAt, broadcaster, 12); err != nil {
+		t.Fatal(err)
+	}
+
+	broadcast, err = repository.GetBroadcast(started.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if broadcast.EndedAt == nil || !broadcast.EndedAt.Equal(endedAt) {
+		t.Errorf("broadcast ended at %v, expected %s", broadcast.EndedAt, endedAt)
+	}
+	if broadcast.Broadcaster == nil || broadcast.Broadcaster.StreamDetails.VideoCodec != "H.264" {
+		t.Errorf("unexpected broadcaster %+v", broadcast.Broadcaster)
+	}
+	if broadcast.PeakViewerCount != 12 {
+		t.Errorf("peak viewer count is %d, expected 12", broadcast.PeakViewerCount)
+	}
+}
+
+func TestSetBroadcastAnalytics(t *testing.T) {
+	repository := New(data.GetDatastore())
+	started := startTestBroadcast(t, time.Now().Add(-time.Hour))
+
+	if err := repository.EndBroadcast(started.ID, time.Now(), nil, 4); err != nil {
+		t.Fatal(err)
+	}
+	viewers := []models.TimestampedValue{
+		{Time: time.Now().Add(-time.Hour).Truncate(time.Second), Value: 3},
+		{Time: time.Now().Add(-30 * time.Minute).Truncate(time.Second), Value: 20},
+	}
+
+	saved, err := repository.GetBroadcastViewersOverTime(started.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved != nil {
+		t.Errorf("no viewers should be saved before the analytics are, got %v", saved)
+	}
+
+	if err := repository.SetBroadcastAnalytics(started.ID, 20, 7.5, 130, 9, viewers); err != nil {
+		t.Fatal(err)
+	}
+
+	broadcast, err := repository.GetBroadcast(started.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if broadcast.PeakViewerCount != 20 {
+		t.Errorf("peak viewer count is %d, expected 20", broadcast.PeakViewerCount)
+	}
+	if broadcast.AverageViewerCount != 7.5 {
+		t.Errorf("average viewer count is %f, expected 7.5", broadcast.AverageViewerCount)
+	}
+	if broadcast.ChatMessageCount != 130 {
+		t.Errorf("chat message count is %d, expected 130", broadcast.ChatMessageCount)
+	}
+	if broadcast.ChatterCount != 9 {
+		t.Errorf("chatter count is %d, expected 9", broadcast.ChatterCount)
+	}
+	if broadcast.Broadcaster != nil {
+		t.Errorf("broadcast without a broadcaster has %+v", broadcast.Broadcaster)
+	}
+
+	saved, err = repository.GetBroadcastViewersOverTime(started.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved) != len(viewers) {
+		t.Fatalf("expected %d saved viewer counts, got %d", len(viewers), len(saved))
+	}
+	for i := range viewers {
+		if !saved[i].Time.Equal(viewers[i].Time) || saved[i].Value != viewers[i].Value {
+			t.Errorf("saved viewer count %v, expected %v", saved[i], viewers[i])
+		}
+	}
+}
+
+func TestBroadcastWithoutViewers(t *testing.T) {
+	repository := New(data.GetDatastore())
+	started := startTestBroadcast(t, time.Now().Add(-time.Hour))
+
+	if err := repository.SetBroadcastAnalytics(started.ID, 0, 0, 0, 0, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// A broadcast nobody watched still has its viewers saved.
+	saved, err := repository.GetBroadcastViewersOverTime(started.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved == nil || len(saved) != 0 {
+		t.Errorf("expected an empty list of viewer counts, got %v", saved)
+	}
+}
+
+func TestGetBroadcastsNewestFirst(t *testing.T) {
+	repository := New(data.GetDatastore())
+	older := startTestBroadcast(t, time.Now().Add(-2*time.Hour))
+	newer := startTestBroadcast(t, time.Now().Add(-time.Minute))
+
+	broadcasts, total, err := repository.GetBroadcasts(0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total < 2 {
+		t.Errorf("expected at least 2 broadcasts in total, got %d", total)
+	}
+	if len(broadcasts) != 1 || broadcasts[0].ID != newer.ID {
+		t.Fatalf("expected the first page to be the newest broadcast, got %+v", broadcasts)
+	}
+
+	broadcasts, _, err = repository.GetBroadcasts(1, total)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(broadcasts) != total-1 {
+		t.Errorf("expected %d broadcasts after the first, got %d", total-1, len(broadcasts))
+	}
+
+	found := false
+	for _, broadcast := range broadcasts {
+		if broadcast.ID == newer.ID {
+			t.Error("the newest broadcast should only be on the first page")
+		}
+		found = found || broadcast.ID == older.ID
+	}
+	if !found {
+		t.Error("expected the older broadcast after the first page")
+	}
+}
+
+func TestGetMissingBroadcast(t *testing.T) {
+	broadcast, err := New(data.GetDatastore()).GetBroadcast("missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if broadcast != nil {
+		t.Errorf("expected no broadcast, got %+v", broadcast)
+	}
+}
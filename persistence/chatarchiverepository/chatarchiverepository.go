@@ -0,0 +1,97 @@
+package chatarchiverepository
+
+import (
+	"database/sql"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+)
+
+type ChatArchiveRepository interface {
+	SaveArchive(archive models.ChatArchive) error
+	GetArchives() ([]models.ChatArchive, error)
+	GetArchive(id string) (*models.ChatArchive, error)
+	DeleteArchive(id string) error
+}
+
+type SqlChatArchiveRepository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance ChatArchiveRepository
+
+// Get will return the chat archive repository.
+func Get() ChatArchiveRepository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the ChatArchiveRepository.
+func New(datastore *data.Datastore) ChatArchiveRepository {
+	r := SqlChatArchiveRepository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+// SaveArchive will save the chat archive of a broadcast.
+func (r *SqlChatArchiveRepository) SaveArchive(archive models.ChatArchive) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("INSERT INTO chat_archives(id, started_at, ended_at, created_at, message_count, messages) values(?, ?, ?, ?, ?, ?)",
+		archive.ID, archive.StartedAt, archive.EndedAt, archive.CreatedAt, archive.MessageCount, archive.Messages)
+
+	return err
+}
+
+// GetArchives will return all the chat archives, newest first, without
+// their messages.
+func (r *SqlChatArchiveRepository) GetArchives() ([]models.ChatArchive, error) {
+	rows, err := r.datastore.DB.Query("SELECT id, started_at, ended_at, created_at, message_count FROM chat_archives ORDER BY started_at DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	archives := []models.ChatArchive{}
+	for rows.Next() {
+		var archive models.ChatArchive
+		if err := rows.Scan(&archive.ID, &archive.StartedAt, &archive.EndedAt, &archive.CreatedAt, &archive.MessageCount); err != nil {
+			return nil, err
+		}
+		archives = append(archives, archive)
+	}
+
+	return archives, rows.Err()
+}
+
+// GetArchive will return a single chat archive with its messages, or nil
+// if it does not exist.
+func (r *SqlChatArchiveRepository) GetArchive(id string) (*models.ChatArchive, error) {
+	row := r.datastore.DB.QueryRow("SELECT id, started_at, ended_at, created_at, message_count, messages FROM chat_archives WHERE id = ?", id)
+
+	var archive models.ChatArchive
+	if err := row.Scan(&archive.ID, &archive.StartedAt, &archive.EndedAt, &archive.CreatedAt, &archive.MessageCount, &archive.Messages); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &archive, nil
+}
+
+// DeleteArchive will remove a single chat archive.
+func (r *SqlChatArchiveRepository) DeleteArchive(id string) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("DELETE FROM chat_archives WHERE id = ?", id)
+	return err
+}
@@ -0,0 +1,149 @@
+package chatfilterrepository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+type ChatFilterRepository interface {
+	InsertRule(rule models.ChatFilterRule) (int, error)
+	UpdateRule(rule models.ChatFilterRule) error
+	DeleteRule(id int) error
+	GetRules() ([]models.ChatFilterRule, error)
+	GetEnabledRules() ([]models.ChatFilterRule, error)
+}
+
+type SqlChatFilterRepository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance ChatFilterRepository
+
+// Get will return the chat filter repository.
+func Get() ChatFilterRepository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the ChatFilterRepository.
+func New(datastore *data.Datastore) ChatFilterRepository {
+	r := SqlChatFilterRepository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+// InsertRule will add a new chat filter rule to the database.
+func (r *SqlChatFilterRepository) InsertRule(rule models.ChatFilterRule) (int, error) {
+	log.Traceln("Adding new chat filter rule")
+
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	tx, err := r.datastore.DB.Begin()
+	if err != nil {
+		return 0, err
+	}
+
+	defer tx.Rollback() //nolint
+
+	stmt, err := tx.Prepare("INSERT INTO chat_filter_rules(type, value, threshold, action, timeout_seconds, enabled, created_at) values(?, ?, ?, ?, ?, ?, ?)")
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	insertResult, err := stmt.Exec(rule.Type, rule.Value, rule.Threshold, rule.Action, rule.TimeoutSeconds, rule.Enabled, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	newID, err := insertResult.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(newID), nil
+}
+
+// UpdateRule will replace an existing chat filter rule.
+func (r *SqlChatFilterRepository) UpdateRule(rule models.ChatFilterRule) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	result, err := r.datastore.DB.Exec("UPDATE chat_filter_rules SET type = ?, value = ?, threshold = ?, action = ?, timeout_seconds = ?, enabled = ? WHERE id = ?",
+		rule.Type, rule.Value, rule.Threshold, rule.Action, rule.TimeoutSeconds, rule.Enabled, rule.ID)
+	if err != nil {
+		return err
+	}
+
+	if rowsUpdated, _ := result.RowsAffected(); rowsUpdated == 0 {
+		return errors.New(fmt.Sprint(rule.ID) + " not found")
+	}
+
+	return nil
+}
+
+// DeleteRule will delete a chat filter rule from the database.
+func (r *SqlChatFilterRepository) DeleteRule(id int) error {
+	log.Traceln("Deleting chat filter rule")
+
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	result, err := r.datastore.DB.Exec("DELETE FROM chat_filter_rules WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+
+	if rowsDeleted, _ := result.RowsAffected(); rowsDeleted == 0 {
+		return errors.New(fmt.Sprint(id) + " not found")
+	}
+
+	return nil
+}
+
+// GetRules will return all the chat filter rules.
+func (r *SqlChatFilterRepository) GetRules() ([]models.ChatFilterRule, error) {
+	return r.getRules("SELECT id, type, value, threshold, action, timeout_seconds, enabled, created_at FROM chat_filter_rules ORDER BY id")
+}
+
+// GetEnabledRules will return the chat filter rules that should be applied.
+func (r *SqlChatFilterRepository) GetEnabledRules() ([]models.ChatFilterRule, error) {
+	return r.getRules("SELECT id, type, value, threshold, action, timeout_seconds, enabled, created_at FROM chat_filter_rules WHERE enabled = 1 ORDER BY id")
+}
+
+func (r *SqlChatFilterRepository) getRules(query string) ([]models.ChatFilterRule, error) {
+	rules := make([]models.ChatFilterRule, 0)
+
+	rows, err := r.datastore.DB.Query(query)
+	if err != nil {
+		return rules, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rule models.ChatFilterRule
+		if err := rows.Scan(&rule.ID, &rule.Type, &rule.Value, &rule.Threshold, &rule.Action, &rule.TimeoutSeconds, &rule.Enabled, &rule.CreatedAt); err != nil {
+			return rules, errors.Wrap(err, "error reading chat filter rules")
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
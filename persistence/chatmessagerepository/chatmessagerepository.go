@@ -4,17 +4,23 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"html"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/owncast/owncast/core/chat/events"
 	"github.com/owncast/owncast/core/data"
 	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/utils"
 
 	log "github.com/sirupsen/logrus"
 )
 
-const maxBacklogNumber = 50 // Return max number of messages in history request
+const (
+	maxBacklogNumber       = 50  // Return max number of messages in history request
+	maxMessageIDQueryBatch = 500 // Max number of message IDs to use in a single query
+)
 
 type ChatMessageRepository interface {
 	SaveUserMessage(event events.UserMessageEvent)
@@ -24,12 +30,30 @@ type ChatMessageRepository interface {
 	GetChatHistory() []interface{}
 	GetMessagesFromUser(userID string) ([]events.UserMessageEvent, error)
 	GetMessageIdsForUserID(userID string) ([]string, error)
+	GetVisibleMessageIDs() ([]string, error)
 	SetMessageVisibilityForMessageIDs(messageIDs []string, visible bool) error
 	GetMessagesCount() int64
+	MessageExists(messageID string) bool
+	AddReaction(messageID string, userID string, emoji string) error
+	RemoveReaction(messageID string, userID string, emoji string) error
+	GetReactionCountFromUser(messageID string, userID string) (int, error)
+	GetReactionsForMessages(messageIDs []string) (map[string][]events.MessageReactions, error)
+	GetMessageByID(messageID string) (*events.UserMessageEvent, error)
+	EditMessage(messageID string, body string) error
+	DeleteMessage(messageID string) error
+	GetMessageEdits(messageIDs []string) (map[string][]events.MessageEdit, error)
+	EnableSearchIndex() error
+	PruneSearchIndex() error
+	SearchMessages(search models.ChatMessageSearch, offset int, limit int) ([]events.UserMessageEvent, int, error)
+	GetMessagesBetween(since time.Time, until time.Time) ([]events.UserMessageEvent, error)
+	GetMessageCountBetween(since time.Time, until time.Time) (int, error)
+	GetChatterCountBetween(since time.Time, until time.Time) (int, error)
 }
 
 type SqlChatMessageRepository struct {
 	datastore *data.Datastore
+	// Set when the full-text search index is available and kept in sync.
+	searchIndexEnabled atomic.Bool
 }
 
 // NOTE: This is temporary during the transition period.
@@ -46,16 +70,21 @@ func Get() ChatMessageRepository {
 
 // New will create a new instance of the UserRepository.
 func New(datastore *data.Datastore) ChatMessageRepository {
-	r := SqlChatMessageRepository{
+	r := &SqlChatMessageRepository{
 		datastore: datastore,
 	}
 
-	return &r
+	return r
 }
 
 // SaveUserMessage will save a single chat event to the messages database.
 func (r *SqlChatMessageRepository) SaveUserMessage(event events.UserMessageEvent) {
-	r.SaveEvent(event.ID, &event.User.ID, event.Body, event.Type, event.HiddenAt, event.Timestamp, nil, nil, nil, nil)
+	var replyTo *string
+	if event.ReplyTo != "" {
+		replyTo = &event.ReplyTo
+	}
+
+	r.saveEvent(event.ID, &event.User.ID, event.Body, event.Type, event.HiddenAt, event.Timestamp, nil, nil, nil, nil, replyTo)
 }
 
 func (r *SqlChatMessageRepository) SaveFederatedAction(event events.FediverseEngagementEvent) {
@@ -64,6 +93,10 @@ func (r *SqlChatMessageRepository) SaveFederatedAction(event events.FediverseEng
 
 // nolint: unparam
 func (r *SqlChatMessageRepository) SaveEvent(id string, userID *string, body string, eventType string, hidden *time.Time, timestamp time.Time, image *string, link *string, title *string, subtitle *string) {
+	r.saveEvent(id, userID, body, eventType, hidden, timestamp, image, link, title, subtitle, nil)
+}
+
+func (r *SqlChatMessageRepository) saveEvent(id string, userID *string, body string, eventType string, hidden *time.Time, timestamp time.Time, image *string, link *string, title *string, subtitle *string, replyTo *string) {
 	defer func() {
 		_historyCache = nil
 	}()
@@ -76,7 +109,7 @@ func (r *SqlChatMessageRepository) SaveEvent(id string, userID *string, body str
 
 	defer tx.Rollback() // nolint
 
-	stmt, err := tx.Prepare("INSERT INTO messages(id, user_id, body, eventType, hidden_at, timestamp, image, link, title, subtitle) values(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+	stmt, err := tx.Prepare("INSERT INTO messages(id, user_id, body, eventType, hidden_at, timestamp, image, link, title, subtitle, reply_to) values(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
 	if err != nil {
 		log.Errorln("error saving", eventType, err)
 		return
@@ -84,10 +117,17 @@ func (r *SqlChatMessageRepository) SaveEvent(id string, userID *string, body str
 
 	defer stmt.Close()
 
-	if _, err = stmt.Exec(id, userID, body, eventType, hidden, timestamp, image, link, title, subtitle); err != nil {
+	if _, err = stmt.Exec(id, userID, body, eventType, hidden, timestamp, image, link, title, subtitle, replyTo); err != nil {
 		log.Errorln("error saving", eventType, err)
 		return
 	}
+
+	if eventType == events.MessageSent && r.searchIndexEnabled.Load() {
+		if _, err = tx.Exec("INSERT INTO messages_fts(message_id, body) values(?, ?)", id, searchableText(body)); err != nil {
+			log.Errorln("error indexing", eventType, err)
+			return
+		}
+	}
 	if err = tx.Commit(); err != nil {
 		log.Errorln("error saving", eventType, err)
 		return
@@ -120,6 +160,11 @@ func makeUserMessageEventFromRowData(row rowData) events.UserMessageEvent {
 		createdAt = *row.userCreatedAt
 	}
 
+	replyTo := ""
+	if row.replyTo != nil {
+		replyTo = *row.replyTo
+	}
+
 	isBot := (row.userType != nil && *row.userType == "API")
 	scopeSlice := strings.Split(scopes, ",")
 
@@ -151,6 +196,9 @@ func makeUserMessageEventFromRowData(row rowData) events.UserMessageEvent {
 			Body:    row.body,
 			RawBody: row.body,
 		},
+		ReplyTo:   replyTo,
+		EditedAt:  row.editedAt,
+		DeletedAt: row.deletedAt,
 	}
 
 	return message
@@ -215,6 +263,9 @@ type rowData struct {
 	title            *string
 	subtitle         *string
 	link             *string
+	replyTo          *string
+	editedAt         *time.Time
+	deletedAt        *time.Time
 
 	userType            *string
 	userScopes          *string
@@ -243,6 +294,9 @@ func getChat(rows *sql.Rows) ([]interface{}, error) {
 			&row.subtitle,
 			&row.image,
 			&row.link,
+			&row.replyTo,
+			&row.editedAt,
+			&row.deletedAt,
 			&row.eventType,
 			&row.hiddenAt,
 			&row.timestamp,
@@ -299,7 +353,7 @@ func (r *SqlChatMessageRepository) GetChatModerationHistory() []interface{} {
 	defer tx.Rollback() // nolint
 
 	// Get all messages regardless of visibility
-	query := "SELECT messages.id, user_id, body, title, subtitle, image, link, eventType, hidden_at, timestamp, display_name, display_color, created_at, disabled_at, previous_names, namechanged_at, authenticated_at, scopes, type FROM messages INNER JOIN users ON messages.user_id = users.id ORDER BY timestamp DESC"
+	query := "SELECT messages.id, user_id, body, title, subtitle, image, link, reply_to, edited_at, deleted_at, eventType, hidden_at, timestamp, display_name, display_color, created_at, disabled_at, previous_names, namechanged_at, authenticated_at, scopes, type FROM messages INNER JOIN users ON messages.user_id = users.id ORDER BY timestamp DESC"
 	stmt, err := tx.Prepare(query)
 	if err != nil {
 		log.Errorln("error fetching chat moderation history", err)
@@ -322,6 +376,8 @@ func (r *SqlChatMessageRepository) GetChatModerationHistory() []interface{} {
 		return nil
 	}
 
+	r.attachReactions(result)
+	r.attachEditHistory(result)
 	_historyCache = &result
 
 	if err = tx.Commit(); err != nil {
@@ -343,7 +399,7 @@ func (r *SqlChatMessageRepository) GetChatHistory() []interface{} {
 	defer tx.Rollback() // nolint
 
 	// Get all visible messages
-	query := "SELECT messages.id, messages.user_id, messages.body, messages.title, messages.subtitle, messages.image, messages.link, messages.eventType, messages.hidden_at, messages.timestamp, users.display_name, users.display_color, users.created_at, users.disabled_at, users.previous_names, users.namechanged_at, users.authenticated_at, users.scopes, users.type FROM users JOIN messages ON users.id = messages.user_id WHERE hidden_at IS NULL AND disabled_at IS NULL ORDER BY timestamp DESC LIMIT ?"
+	query := "SELECT messages.id, messages.user_id, messages.body, messages.title, messages.subtitle, messages.image, messages.link, messages.reply_to, messages.edited_at, messages.deleted_at, messages.eventType, messages.hidden_at, messages.timestamp, users.display_name, users.display_color, users.created_at, users.disabled_at, users.previous_names, users.namechanged_at, users.authenticated_at, users.scopes, users.type FROM users JOIN messages ON users.id = messages.user_id WHERE hidden_at IS NULL AND disabled_at IS NULL ORDER BY timestamp DESC LIMIT ?"
 
 	stmt, err := tx.Prepare(query)
 	if err != nil {
@@ -372,6 +428,8 @@ func (r *SqlChatMessageRepository) GetChatHistory() []interface{} {
 		return nil
 	}
 
+	r.attachReactions(m)
+
 	// Invert order of messages
 	for i, j := 0, len(m)-1; i < j; i, j = i+1, j-1 {
 		m[i], m[j] = m[j], m[i]
@@ -403,6 +461,27 @@ func (r *SqlChatMessageRepository) GetMessagesFromUser(userID string) ([]events.
 	return results, nil
 }
 
+// GetVisibleMessageIDs will return the IDs of all the chat messages that
+// have not been hidden.
+func (r *SqlChatMessageRepository) GetVisibleMessageIDs() ([]string, error) {
+	rows, err := r.datastore.DB.Query("SELECT id FROM messages WHERE hidden_at IS NULL")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
 // GetMessageIdsForUserID will return the chat message IDs for a specific user.
 func (r *SqlChatMessageRepository) GetMessageIdsForUserID(userID string) ([]string, error) {
 	defer func() {
@@ -415,7 +494,7 @@ func (r *SqlChatMessageRepository) GetMessageIdsForUserID(userID string) ([]stri
 	}
 
 	defer tx.Rollback() // nolint
-	query := "SELECT messages.id, user_id, body, title, subtitle, image, link, eventType, hidden_at, timestamp, display_name, display_color, created_at, disabled_at,  previous_names, namechanged_at, authenticated_at, scopes, type FROM messages INNER JOIN users ON messages.user_id = users.id WHERE user_id IS ?"
+	query := "SELECT messages.id, user_id, body, title, subtitle, image, link, reply_to, edited_at, deleted_at, eventType, hidden_at, timestamp, display_name, display_color, created_at, disabled_at,  previous_names, namechanged_at, authenticated_at, scopes, type FROM messages INNER JOIN users ON messages.user_id = users.id WHERE user_id IS ?"
 
 	stmt, err := tx.Prepare(query)
 	if err != nil {
@@ -521,3 +600,491 @@ func (r *SqlChatMessageRepository) GetMessagesCount() int64 {
 	}
 	return count
 }
+
+// MessageExists will return if a message with the provided ID exists.
+func (r *SqlChatMessageRepository) MessageExists(messageID string) bool {
+	var count int
+	if err := r.datastore.DB.QueryRow(`SELECT COUNT(*) FROM messages WHERE id = ?`, messageID).Scan(&count); err != nil {
+		log.Errorln("error checking if message exists", err)
+		return false
+	}
+
+	return count > 0
+}
+
+// AddReaction will save a user's emoji reaction to a message.
+func (r *SqlChatMessageRepository) AddReaction(messageID string, userID string, emoji string) error {
+	defer func() {
+		_historyCache = nil
+	}()
+
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec(`INSERT OR IGNORE INTO message_reactions(message_id, user_id, emoji, timestamp) VALUES(?, ?, ?, ?)`, messageID, userID, emoji, time.Now())
+	return err
+}
+
+// RemoveReaction will remove a user's emoji reaction from a message.
+func (r *SqlChatMessageRepository) RemoveReaction(messageID string, userID string, emoji string) error {
+	defer func() {
+		_historyCache = nil
+	}()
+
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec(`DELETE FROM message_reactions WHERE message_id = ? AND user_id = ? AND emoji = ?`, messageID, userID, emoji)
+	return err
+}
+
+// GetReactionCountFromUser will return how many different reactions a user
+// has added to a message.
+func (r *SqlChatMessageRepository) GetReactionCountFromUser(messageID string, userID string) (int, error) {
+	var count int
+	if err := r.datastore.DB.QueryRow(`SELECT COUNT(*) FROM message_reactions WHERE message_id = ? AND user_id = ?`, messageID, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetReactionsForMessages will return the reactions for each of the
+// provided messages, keyed by message ID.
+func (r *SqlChatMessageRepository) GetReactionsForMessages(messageIDs []string) (map[string][]events.MessageReactions, error) {
+	reactions := map[string][]events.MessageReactions{}
+	if len(messageIDs) == 0 {
+		return reactions, nil
+	}
+
+	args := make([]interface{}, len(messageIDs))
+	for i, id := range messageIDs {
+		args[i] = id
+	}
+
+	// nolint:gosec
+	query := "SELECT message_id, user_id, emoji FROM message_reactions WHERE message_id IN (?" + strings.Repeat(",?", len(messageIDs)-1) + ") ORDER BY timestamp ASC"
+	rows, err := r.datastore.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var messageID, userID, emoji string
+		if err := rows.Scan(&messageID, &userID, &emoji); err != nil {
+			return nil, err
+		}
+
+		found := false
+		for i := range reactions[messageID] {
+			reaction := &reactions[messageID][i]
+			if reaction.Emoji == emoji {
+				reaction.UserIDs = append(reaction.UserIDs, userID)
+				reaction.Count++
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			reactions[messageID] = append(reactions[messageID], events.MessageReactions{
+				Emoji:   emoji,
+				UserIDs: []string{userID},
+				Count:   1,
+			})
+		}
+	}
+
+	return reactions, rows.Err()
+}
+
+// attachReactions will add the saved reactions to the user messages in a
+// chat history.
+func (r *SqlChatMessageRepository) attachReactions(history []interface{}) {
+	messageIDs := []string{}
+	for _, item := range history {
+		if message, ok := item.(events.UserMessageEvent); ok {
+			messageIDs = append(messageIDs, message.ID)
+		}
+	}
+
+	// Query in batches to stay within the SQLite variable limit.
+	reactions := map[string][]events.MessageReactions{}
+	for start := 0; start < len(messageIDs); start += maxMessageIDQueryBatch {
+		end := start + maxMessageIDQueryBatch
+		if end > len(messageIDs) {
+			end = len(messageIDs)
+		}
+
+		batch, err := r.GetReactionsForMessages(messageIDs[start:end])
+		if err != nil {
+			log.Errorln("error fetching chat message reactions", err)
+			return
+		}
+		for id, messageReactions := range batch {
+			reactions[id] = messageReactions
+		}
+	}
+
+	for i, item := range history {
+		if message, ok := item.(events.UserMessageEvent); ok {
+			message.Reactions = reactions[message.ID]
+			history[i] = message
+		}
+	}
+}
+
+// GetMessageByID will return a single user message.
+func (r *SqlChatMessageRepository) GetMessageByID(messageID string) (*events.UserMessageEvent, error) {
+	query := "SELECT messages.id, user_id, body, title, subtitle, image, link, reply_to, edited_at, deleted_at, eventType, hidden_at, timestamp, display_name, display_color, created_at, disabled_at, previous_names, namechanged_at, authenticated_at, scopes, type FROM messages INNER JOIN users ON messages.user_id = users.id WHERE messages.id = ?"
+	rows, err := r.datastore.DB.Query(query, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages, err := getChat(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range messages {
+		if message, ok := item.(events.UserMessageEvent); ok {
+			return &message, nil
+		}
+	}
+
+	return nil, errors.New("message not found")
+}
+
+// EditMessage will replace the body of a message, keeping the previous
+// body in the message's edit history.
+func (r *SqlChatMessageRepository) EditMessage(messageID string, body string) error {
+	defer func() {
+		_historyCache = nil
+	}()
+
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	tx, err := r.datastore.DB.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback() // nolint
+
+	now := time.Now()
+
+	if _, err := tx.Exec(`INSERT INTO message_edits(message_id, body, edited_at) SELECT id, body, ? FROM messages WHERE id = ?`, now, messageID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`UPDATE messages SET body = ?, edited_at = ? WHERE id = ?`, body, now, messageID); err != nil {
+		return err
+	}
+
+	if r.searchIndexEnabled.Load() {
+		if _, err := tx.Exec(`UPDATE messages_fts SET body = ? WHERE message_id = ?`, searchableText(body), messageID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// DeleteMessage will hide a message that was deleted by its sender.
+func (r *SqlChatMessageRepository) DeleteMessage(messageID string) error {
+	defer func() {
+		_historyCache = nil
+	}()
+
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	now := time.Now()
+	_, err := r.datastore.DB.Exec(`UPDATE messages SET hidden_at = ?, deleted_at = ? WHERE id = ?`, now, now, messageID)
+	return err
+}
+
+// GetMessageEdits will return the previous versions of each of the
+// provided messages, keyed by message ID.
+func (r *SqlChatMessageRepository) GetMessageEdits(messageIDs []string) (map[string][]events.MessageEdit, error) {
+	edits := map[string][]events.MessageEdit{}
+	if len(messageIDs) == 0 {
+		return edits, nil
+	}
+
+	args := make([]interface{}, len(messageIDs))
+	for i, id := range messageIDs {
+		args[i] = id
+	}
+
+	// nolint:gosec
+	query := "SELECT message_id, body, edited_at FROM message_edits WHERE message_id IN (?" + strings.Repeat(",?", len(messageIDs)-1) + ") ORDER BY edited_at ASC"
+	rows, err := r.datastore.DB.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var messageID string
+		var body *string
+		var editedAt time.Time
+		if err := rows.Scan(&messageID, &body, &editedAt); err != nil {
+			return nil, err
+		}
+
+		edit := events.MessageEdit{EditedAt: editedAt}
+		if body != nil {
+			edit.Body = *body
+		}
+		edits[messageID] = append(edits[messageID], edit)
+	}
+
+	return edits, rows.Err()
+}
+
+// attachEditHistory will add the previous versions of edited messages in a
+// chat history.
+func (r *SqlChatMessageRepository) attachEditHistory(history []interface{}) {
+	messageIDs := []string{}
+	for _, item := range history {
+		if message, ok := item.(events.UserMessageEvent); ok && message.EditedAt != nil {
+			messageIDs = append(messageIDs, message.ID)
+		}
+	}
+
+	edits := map[string][]events.MessageEdit{}
+	for start := 0; start < len(messageIDs); start += maxMessageIDQueryBatch {
+		end := start + maxMessageIDQueryBatch
+		if end > len(messageIDs) {
+			end = len(messageIDs)
+		}
+
+		batch, err := r.GetMessageEdits(messageIDs[start:end])
+		if err != nil {
+			log.Errorln("error fetching chat message edits", err)
+			return
+		}
+		for id, messageEdits := range batch {
+			edits[id] = messageEdits
+		}
+	}
+
+	for i, item := range history {
+		if message, ok := item.(events.UserMessageEvent); ok && message.EditedAt != nil {
+			message.EditHistory = edits[message.ID]
+			history[i] = message
+		}
+	}
+}
+
+// EnableSearchIndex will start keeping the full-text search index in sync
+// with the chat messages, and index any messages that are missing from it.
+func (r *SqlChatMessageRepository) EnableSearchIndex() error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	tx, err := r.datastore.DB.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback() // nolint
+
+	rows, err := tx.Query("SELECT id, body FROM messages WHERE eventType = ? AND id NOT IN (SELECT message_id FROM messages_fts)", events.MessageSent)
+	if err != nil {
+		return err
+	}
+
+	unindexed := map[string]string{}
+	for rows.Next() {
+		var id string
+		var body *string
+		if err := rows.Scan(&id, &body); err != nil {
+			rows.Close()
+			return err
+		}
+		if body != nil {
+			unindexed[id] = *body
+		}
+	}
+	rows.Close()
+
+	for id, body := range unindexed {
+		if _, err := tx.Exec("INSERT INTO messages_fts(message_id, body) values(?, ?)", id, searchableText(body)); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	r.searchIndexEnabled.Store(true)
+
+	return nil
+}
+
+// PruneSearchIndex will remove messages from the full-text search index
+// that no longer exist.
+func (r *SqlChatMessageRepository) PruneSearchIndex() error {
+	if !r.searchIndexEnabled.Load() {
+		return nil
+	}
+
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("DELETE FROM messages_fts WHERE message_id NOT IN (SELECT id FROM messages)")
+	return err
+}
+
+// SearchMessages will return a page of the user chat messages matching the
+// search, newest first, along with the total number of matching messages.
+func (r *SqlChatMessageRepository) SearchMessages(search models.ChatMessageSearch, offset int, limit int) ([]events.UserMessageEvent, int, error) {
+	conditions := []string{"messages.eventType = ?"}
+	args := []interface{}{events.MessageSent}
+
+	if text := strings.TrimSpace(search.Text); text != "" {
+		if r.searchIndexEnabled.Load() {
+			conditions = append(conditions, "messages.id IN (SELECT message_id FROM messages_fts WHERE messages_fts MATCH ?)")
+			args = append(args, ftsQuery(text))
+		} else {
+			// Message bodies are stored as sanitized HTML.
+			conditions = append(conditions, `messages.body LIKE ? ESCAPE '\'`)
+			args = append(args, "%"+escapeLike(html.EscapeString(text))+"%")
+		}
+	}
+
+	if search.UserID != "" {
+		conditions = append(conditions, "messages.user_id = ?")
+		args = append(args, search.UserID)
+	}
+
+	if name := strings.TrimSpace(search.DisplayName); name != "" {
+		conditions = append(conditions, `(users.display_name LIKE ? ESCAPE '\' OR users.previous_names LIKE ? ESCAPE '\')`)
+		args = append(args, "%"+escapeLike(name)+"%", "%"+escapeLike(name)+"%")
+	}
+
+	if search.Since != nil {
+		conditions = append(conditions, "messages.timestamp >= ?")
+		args = append(args, utils.StoredTimestamp(*search.Since))
+	}
+
+	if search.Until != nil {
+		conditions = append(conditions, "messages.timestamp <= ?")
+		args = append(args, utils.StoredTimestamp(*search.Until))
+	}
+
+	switch search.Visibility {
+	case models.ChatMessageVisibilityVisible:
+		conditions = append(conditions, "messages.hidden_at IS NULL")
+	case models.ChatMessageVisibilityHidden:
+		conditions = append(conditions, "messages.hidden_at IS NOT NULL")
+	}
+
+	from := " FROM messages INNER JOIN users ON messages.user_id = users.id WHERE " + strings.Join(conditions, " AND ")
+
+	var total int
+	if err := r.datastore.DB.QueryRow("SELECT COUNT(*)"+from, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// nolint:gosec
+	query := "SELECT messages.id, messages.user_id, messages.body, messages.title, messages.subtitle, messages.image, messages.link, messages.reply_to, messages.edited_at, messages.deleted_at, messages.eventType, messages.hidden_at, messages.timestamp, users.display_name, users.display_color, users.created_at, users.disabled_at, users.previous_names, users.namechanged_at, users.authenticated_at, users.scopes, users.type" +
+		from + " ORDER BY messages.timestamp DESC LIMIT ? OFFSET ?"
+
+	rows, err := r.datastore.DB.Query(query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	history, err := getChat(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	r.attachReactions(history)
+	r.attachEditHistory(history)
+
+	messages := make([]events.UserMessageEvent, 0, len(history))
+	for _, item := range history {
+		if message, ok := item.(events.UserMessageEvent); ok {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages, total, nil
+}
+
+// GetMessagesBetween will return the visible user chat messages sent
+// between two times, oldest first.
+func (r *SqlChatMessageRepository) GetMessagesBetween(since time.Time, until time.Time) ([]events.UserMessageEvent, error) {
+	query := "SELECT messages.id, messages.user_id, messages.body, messages.title, messages.subtitle, messages.image, messages.link, messages.reply_to, messages.edited_at, messages.deleted_at, messages.eventType, messages.hidden_at, messages.timestamp, users.display_name, users.display_color, users.created_at, users.disabled_at, users.previous_names, users.namechanged_at, users.authenticated_at, users.scopes, users.type FROM messages INNER JOIN users ON messages.user_id = users.id WHERE messages.eventType = ? AND messages.hidden_at IS NULL AND messages.timestamp >= ? AND messages.timestamp <= ? ORDER BY messages.timestamp ASC"
+
+	rows, err := r.datastore.DB.Query(query, events.MessageSent, since, until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history, err := getChat(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	r.attachReactions(history)
+
+	messages := make([]events.UserMessageEvent, 0, len(history))
+	for _, item := range history {
+		if message, ok := item.(events.UserMessageEvent); ok {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages, nil
+}
+
+// searchableText will return the plain text of a rendered message body.
+func searchableText(body string) string {
+	return strings.TrimSpace(html.UnescapeString(utils.StripHTML(body)))
+}
+
+// ftsQuery will turn user provided text into an FTS5 query matching
+// messages containing words starting with each of the provided words.
+func ftsQuery(text string) string {
+	terms := []string{}
+	for _, word := range strings.Fields(text) {
+		terms = append(terms, `"`+strings.ReplaceAll(word, `"`, `""`)+`"*`)
+	}
+
+	return strings.Join(terms, " ")
+}
+
+// escapeLike will escape the wildcard characters in a LIKE pattern.
+func escapeLike(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(text)
+}
+
+// GetMessageCountBetween will return the number of user chat messages sent
+// between two times.
+func (r *SqlChatMessageRepository) GetMessageCountBetween(since time.Time, until time.Time) (int, error) {
+	var count int
+	err := r.datastore.DB.QueryRow("SELECT COUNT(*) FROM messages WHERE eventType = ? AND timestamp >= ? AND timestamp <= ?", events.MessageSent, since, until).Scan(&count)
+
+	return count, err
+}
+
+// GetChatterCountBetween will return the number of different users who
+// sent chat messages between two times.
+func (r *SqlChatMessageRepository) GetChatterCountBetween(since time.Time, until time.Time) (int, error) {
+	var count int
+	err := r.datastore.DB.QueryRow("SELECT COUNT(DISTINCT user_id) FROM messages WHERE eventType = ? AND timestamp >= ? AND timestamp <= ?", events.MessageSent, since, until).Scan(&count)
+
+	return count, err
+}
@@ -0,0 +1,55 @@
+//go:build sqlite_fts5
+
+package chatmessagerepository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/tables"
+)
+
+func TestSearchMessagesWithIndex(t *testing.T) {
+	if err := tables.CreateMessagesSearchIndex(data.GetDatastore().DB); err != nil {
+		t.Fatal(err)
+	}
+
+	r := New(data.GetDatastore())
+	user := newTestUser(t, "fts-search-user")
+	now := time.Now()
+
+	// Saved before the index is enabled, so it has to be backfilled.
+	backfilled := saveTestMessage(r, user, "<p>Café playlist please</p>", now)
+
+	if err := r.EnableSearchIndex(); err != nil {
+		t.Fatal(err)
+	}
+
+	indexed := saveTestMessage(r, user, "<p>That <strong>playlist</strong> rocks</p>", now.Add(time.Second))
+	saveTestMessage(r, user, "<p>Unrelated</p>", now)
+
+	results, total, err := r.SearchMessages(models.ChatMessageSearch{Text: "playl", UserID: user.ID}, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 2 || len(results) != 2 || results[0].ID != indexed || results[1].ID != backfilled {
+		t.Errorf("Expected %s and %s to match by prefix but got %v (total %d)", indexed, backfilled, messageIDs(results), total)
+	}
+
+	// Diacritics are ignored and markup isn't indexed.
+	if _, total, _ := r.SearchMessages(models.ChatMessageSearch{Text: "cafe", UserID: user.ID}, 0, 10); total != 1 {
+		t.Errorf("Expected diacritics to be ignored but %d messages matched", total)
+	}
+	if _, total, _ := r.SearchMessages(models.ChatMessageSearch{Text: "strong", UserID: user.ID}, 0, 10); total != 0 {
+		t.Errorf("Expected markup to not be searchable but %d messages matched", total)
+	}
+
+	if err := r.EditMessage(indexed, "<p>Edited away</p>"); err != nil {
+		t.Fatal(err)
+	}
+	if _, total, _ := r.SearchMessages(models.ChatMessageSearch{Text: "playlist", UserID: user.ID}, 0, 10); total != 1 {
+		t.Errorf("Expected an edit to update the index but %d messages matched", total)
+	}
+}
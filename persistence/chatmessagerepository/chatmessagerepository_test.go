@@ -0,0 +1,122 @@
+package chatmessagerepository
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/tables"
+	"github.com/owncast/owncast/persistence/userrepository"
+	"github.com/teris-io/shortid"
+)
+
+func TestMain(m *testing.M) {
+	// Timestamps are stored in the server's zone, so make sure it isn't UTC.
+	time.Local = time.FixedZone("UTC-5", -5*60*60)
+
+	dbFile, err := os.CreateTemp(os.TempDir(), "owncast-chatmessages-test-db.db")
+	if err != nil {
+		panic(err)
+	}
+	dbFile.Close()
+	defer os.Remove(dbFile.Name())
+
+	if err := data.SetupPersistence(dbFile.Name()); err != nil {
+		panic(err)
+	}
+
+	db := data.GetDatastore().DB
+	tables.CreateMessagesTable(db)
+	tables.CreateMessageReactionsTable(db)
+	tables.CreateMessageEditsTable(db)
+
+	m.Run()
+}
+
+// newTestUser will create a user to send test messages as.
+func newTestUser(t *testing.T, name string) *models.User {
+	t.Helper()
+
+	user, _, err := userrepository.Get().CreateAnonymousUser(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return user
+}
+
+// saveTestMessage will save a chat message and return its ID.
+func saveTestMessage(r ChatMessageRepository, user *models.User, body string, timestamp time.Time) string {
+	id := shortid.MustGenerate()
+	r.SaveUserMessage(events.UserMessageEvent{
+		Event:        events.Event{ID: id, Type: events.MessageSent, Timestamp: timestamp},
+		UserEvent:    events.UserEvent{User: user},
+		MessageEvent: events.MessageEvent{Body: body},
+	})
+
+	return id
+}
+
+func messageIDs(messages []events.UserMessageEvent) []string {
+	ids := []string{}
+	for _, message := range messages {
+		ids = append(ids, message.ID)
+	}
+
+	return ids
+}
+
+func TestSearchMessagesWithoutIndex(t *testing.T) {
+	r := New(data.GetDatastore())
+	user := newTestUser(t, "like-search-user")
+	now := time.Now()
+
+	match := saveTestMessage(r, user, "<p>Does anyone know the 100% setlist?</p>", now)
+	saveTestMessage(r, user, "<p>Nothing to see here</p>", now)
+
+	results, total, err := r.SearchMessages(models.ChatMessageSearch{Text: "100% setlist", UserID: user.ID}, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 || len(results) != 1 || results[0].ID != match {
+		t.Errorf("Expected only %s to match but got %v (total %d)", match, messageIDs(results), total)
+	}
+
+	// Wildcards in the search text are matched literally.
+	if _, total, _ := r.SearchMessages(models.ChatMessageSearch{Text: "100_", UserID: user.ID}, 0, 10); total != 0 {
+		t.Errorf("Expected a LIKE wildcard to be escaped but %d messages matched", total)
+	}
+}
+
+func TestSearchMessagesTimeRange(t *testing.T) {
+	r := New(data.GetDatastore())
+	user := newTestUser(t, "time-range-user")
+	now := time.Now().Truncate(time.Second)
+
+	old := saveTestMessage(r, user, "<p>old</p>", now.Add(-2*time.Hour))
+	recent := saveTestMessage(r, user, "<p>recent</p>", now.Add(-30*time.Minute))
+	saveTestMessage(r, user, "<p>newest</p>", now)
+
+	// Clients send RFC3339 times that are usually in UTC.
+	since := now.Add(-time.Hour).UTC()
+	until := now.Add(-time.Minute).UTC()
+
+	results, total, err := r.SearchMessages(models.ChatMessageSearch{UserID: user.ID, Since: &since, Until: &until}, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 || len(results) != 1 || results[0].ID != recent {
+		t.Errorf("Expected only %s to be in range but got %v (total %d)", recent, messageIDs(results), total)
+	}
+
+	results, _, err = r.SearchMessages(models.ChatMessageSearch{UserID: user.ID, Until: &since}, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].ID != old {
+		t.Errorf("Expected only %s to be before the range but got %v", old, messageIDs(results))
+	}
+}
@@ -46,12 +46,28 @@ const (
 	chatEstablishedUsersOnlyModeKey = "chat_established_users_only_mode"
 	chatSpamProtectionEnabledKey    = "chat_spam_protection_enabled"
 	chatSlurFilterEnabledKey        = "chat_slur_filter_enabled"
+	chatModesKey                    = "chat_modes"
+	chatReviewQueueKey              = "chat_review_queue"
+	chatUserDirectMessagesKey       = "chat_user_direct_messages_enabled"
+	chatRetentionKey                = "chat_retention"
+	chatCustomCommandsKey           = "chat_custom_commands"
+	scheduleReminderMinutesKey      = "schedule_reminder_minutes"
 	notificationsEnabledKey         = "notifications_enabled"
 	discordConfigurationKey         = "discord_configuration"
+	matrixConfigurationKey          = "matrix_configuration"
+	telegramConfigurationKey        = "telegram_configuration"
+	ntfyConfigurationKey            = "ntfy_configuration"
+	gotifyConfigurationKey          = "gotify_configuration"
+	slackConfigurationKey           = "slack_configuration"
+	emailConfigurationKey           = "email_configuration"
+	notificationRulesKey            = "notification_rules"
+	streamReportChannelKey          = "stream_report_channel"
+	alertRulesKey                   = "alert_rules"
 	browserPushConfigurationKey     = "browser_push_configuration"
 	browserPushPublicKeyKey         = "browser_push_public_key"
 	// nolint:gosec
 	browserPushPrivateKeyKey             = "browser_push_private_key"
+	emailSubscriptionSigningKeyKey       = "email_subscription_signing_key"
 	hasConfiguredInitialNotificationsKey = "has_configured_initial_notifications"
 	hideViewerCountKey                   = "hide_viewer_count"
 	customOfflineMessageKey              = "custom_offline_message"
@@ -67,6 +67,16 @@ type ConfigRepository interface {
 	GetChatSpamProtectionEnabled() bool
 	SetChatSlurFilterEnabled(enabled bool) error
 	GetChatSlurFilterEnabled() bool
+	SetChatUserDirectMessagesEnabled(enabled bool) error
+	GetChatUserDirectMessagesEnabled() bool
+	GetChatModes() models.ChatModes
+	SetChatModes(modes models.ChatModes) error
+	GetChatReviewQueue() models.ChatReviewQueue
+	SetChatReviewQueue(queue models.ChatReviewQueue) error
+	GetChatRetention() models.ChatRetention
+	SetChatRetention(retention models.ChatRetention) error
+	GetChatCustomCommands() []models.ChatCommand
+	SetChatCustomCommands(commands []models.ChatCommand) error
 	GetExternalActions() []models.ExternalAction
 	SetExternalActions(actions []models.ExternalAction) error
 	SetCustomStyles(styles string) error
@@ -101,12 +111,34 @@ type ConfigRepository interface {
 	GetNotificationsEnabled() bool
 	GetDiscordConfig() models.DiscordConfiguration
 	SetDiscordConfig(config models.DiscordConfiguration) error
+	GetMatrixConfig() models.MatrixConfiguration
+	SetMatrixConfig(config models.MatrixConfiguration) error
+	GetTelegramConfig() models.TelegramConfiguration
+	SetTelegramConfig(config models.TelegramConfiguration) error
+	GetNtfyConfig() models.NtfyConfiguration
+	SetNtfyConfig(config models.NtfyConfiguration) error
+	GetGotifyConfig() models.GotifyConfiguration
+	SetGotifyConfig(config models.GotifyConfiguration) error
+	GetSlackConfig() models.SlackConfiguration
+	SetSlackConfig(config models.SlackConfiguration) error
+	GetScheduleReminderMinutes() int
+	SetScheduleReminderMinutes(minutes int) error
+	GetEmailConfig() models.EmailConfiguration
+	SetEmailConfig(config models.EmailConfiguration) error
+	GetNotificationRules() map[string]models.NotificationRule
+	SetNotificationRules(rules map[string]models.NotificationRule) error
+	GetStreamReportChannel() string
+	SetStreamReportChannel(channel string) error
+	GetAlertRules() []models.AlertRule
+	SetAlertRules(rules []models.AlertRule) error
 	GetBrowserPushConfig() models.BrowserNotificationConfiguration
 	SetBrowserPushConfig(config models.BrowserNotificationConfiguration) error
 	SetBrowserPushPublicKey(key string) error
 	GetBrowserPushPublicKey() (string, error)
 	SetBrowserPushPrivateKey(key string) error
 	GetBrowserPushPrivateKey() (string, error)
+	SetEmailSubscriptionSigningKey(key string) error
+	GetEmailSubscriptionSigningKey() (string, error)
 	SetHasPerformedInitialNotificationsConfig(hasConfigured bool) error
 	GetHasPerformedInitialNotificationsConfig() bool
 	GetHideViewerCount() bool
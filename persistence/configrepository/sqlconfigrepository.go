@@ -526,6 +526,23 @@ func (r *SqlConfigRepository) GetChatSpamProtectionEnabled() bool {
 	return true
 }
 
+// SetChatUserDirectMessagesEnabled will allow chat users to send direct
+// messages to moderators if set to true.
+func (r *SqlConfigRepository) SetChatUserDirectMessagesEnabled(enabled bool) error {
+	return r.datastore.SetBool(chatUserDirectMessagesKey, enabled)
+}
+
+// GetChatUserDirectMessagesEnabled will return if chat users can send
+// direct messages to moderators.
+func (r *SqlConfigRepository) GetChatUserDirectMessagesEnabled() bool {
+	enabled, err := r.datastore.GetBool(chatUserDirectMessagesKey)
+	if err == nil {
+		return enabled
+	}
+
+	return false
+}
+
 // SetChatSlurFilterEnabled will enable the chat slur filter.
 func (r *SqlConfigRepository) SetChatSlurFilterEnabled(enabled bool) error {
 	return r.datastore.SetBool(chatSlurFilterEnabledKey, enabled)
@@ -541,6 +558,99 @@ func (r *SqlConfigRepository) GetChatSlurFilterEnabled() bool {
 	return false
 }
 
+// GetChatModes will return the chat moderation modes currently applied.
+func (r *SqlConfigRepository) GetChatModes() models.ChatModes {
+	configEntry, err := r.datastore.Get(chatModesKey)
+	if err != nil {
+		return models.ChatModes{}
+	}
+
+	var modes models.ChatModes
+	if err := configEntry.GetObject(&modes); err != nil {
+		return models.ChatModes{}
+	}
+
+	return modes
+}
+
+// SetChatModes will set the chat moderation modes.
+func (r *SqlConfigRepository) SetChatModes(modes models.ChatModes) error {
+	configEntry := models.ConfigEntry{Key: chatModesKey, Value: modes}
+	return r.datastore.Save(configEntry)
+}
+
+// GetChatReviewQueue will return which chat messages are held for review.
+func (r *SqlConfigRepository) GetChatReviewQueue() models.ChatReviewQueue {
+	configEntry, err := r.datastore.Get(chatReviewQueueKey)
+	if err != nil {
+		return models.ChatReviewQueue{}
+	}
+
+	var queue models.ChatReviewQueue
+	if err := configEntry.GetObject(&queue); err != nil {
+		return models.ChatReviewQueue{}
+	}
+
+	return queue
+}
+
+// SetChatReviewQueue will set which chat messages are held for review.
+func (r *SqlConfigRepository) SetChatReviewQueue(queue models.ChatReviewQueue) error {
+	configEntry := models.ConfigEntry{Key: chatReviewQueueKey, Value: queue}
+	return r.datastore.Save(configEntry)
+}
+
+// GetChatRetention will return how long chat messages are kept.
+func (r *SqlConfigRepository) GetChatRetention() models.ChatRetention {
+	defaultRetention := models.ChatRetention{
+		Mode:  models.ChatRetentionHours,
+		Hours: config.GetDefaults().ChatRetentionHours,
+	}
+
+	configEntry, err := r.datastore.Get(chatRetentionKey)
+	if err != nil {
+		return defaultRetention
+	}
+
+	var retention models.ChatRetention
+	if err := configEntry.GetObject(&retention); err != nil || !retention.IsValid() {
+		return defaultRetention
+	}
+
+	return retention
+}
+
+// SetChatRetention will set how long chat messages are kept.
+func (r *SqlConfigRepository) SetChatRetention(retention models.ChatRetention) error {
+	if !retention.IsValid() {
+		return errors.New("invalid chat retention settings")
+	}
+
+	configEntry := models.ConfigEntry{Key: chatRetentionKey, Value: retention}
+	return r.datastore.Save(configEntry)
+}
+
+// GetChatCustomCommands will return the admin defined chat commands.
+func (r *SqlConfigRepository) GetChatCustomCommands() []models.ChatCommand {
+	configEntry, err := r.datastore.Get(chatCustomCommandsKey)
+	if err != nil {
+		return []models.ChatCommand{}
+	}
+
+	var commands []models.ChatCommand
+	if err := configEntry.GetObject(&commands); err != nil {
+		return []models.ChatCommand{}
+	}
+
+	return commands
+}
+
+// SetChatCustomCommands will set the admin defined chat commands.
+func (r *SqlConfigRepository) SetChatCustomCommands(commands []models.ChatCommand) error {
+	configEntry := models.ConfigEntry{Key: chatCustomCommandsKey, Value: commands}
+	return r.datastore.Save(configEntry)
+}
+
 // GetExternalActions will return the registered external actions.
 func (r *SqlConfigRepository) GetExternalActions() []models.ExternalAction {
 	configEntry, err := r.datastore.Get(externalActionsKey)
@@ -872,6 +982,189 @@ func (r *SqlConfigRepository) SetDiscordConfig(config models.DiscordConfiguratio
 	return r.datastore.Save(configEntry)
 }
 
+// GetMatrixConfig will return the Matrix configuration.
+func (r *SqlConfigRepository) GetMatrixConfig() models.MatrixConfiguration {
+	configEntry, err := r.datastore.Get(matrixConfigurationKey)
+	if err != nil {
+		return models.MatrixConfiguration{Enabled: false}
+	}
+
+	var config models.MatrixConfiguration
+	if err := configEntry.GetObject(&config); err != nil {
+		return models.MatrixConfiguration{Enabled: false}
+	}
+
+	return config
+}
+
+// SetMatrixConfig will set the Matrix configuration.
+func (r *SqlConfigRepository) SetMatrixConfig(config models.MatrixConfiguration) error {
+	configEntry := models.ConfigEntry{Key: matrixConfigurationKey, Value: config}
+	return r.datastore.Save(configEntry)
+}
+
+// GetTelegramConfig will return the Telegram configuration.
+func (r *SqlConfigRepository) GetTelegramConfig() models.TelegramConfiguration {
+	configEntry, err := r.datastore.Get(telegramConfigurationKey)
+	if err != nil {
+		return models.TelegramConfiguration{Enabled: false}
+	}
+
+	var config models.TelegramConfiguration
+	if err := configEntry.GetObject(&config); err != nil {
+		return models.TelegramConfiguration{Enabled: false}
+	}
+
+	return config
+}
+
+// SetTelegramConfig will set the Telegram configuration.
+func (r *SqlConfigRepository) SetTelegramConfig(config models.TelegramConfiguration) error {
+	configEntry := models.ConfigEntry{Key: telegramConfigurationKey, Value: config}
+	return r.datastore.Save(configEntry)
+}
+
+// GetNtfyConfig will return the ntfy configuration.
+func (r *SqlConfigRepository) GetNtfyConfig() models.NtfyConfiguration {
+	configEntry, err := r.datastore.Get(ntfyConfigurationKey)
+	if err != nil {
+		return models.NtfyConfiguration{Enabled: false}
+	}
+
+	var config models.NtfyConfiguration
+	if err := configEntry.GetObject(&config); err != nil {
+		return models.NtfyConfiguration{Enabled: false}
+	}
+
+	return config
+}
+
+// SetNtfyConfig will set the ntfy configuration.
+func (r *SqlConfigRepository) SetNtfyConfig(config models.NtfyConfiguration) error {
+	configEntry := models.ConfigEntry{Key: ntfyConfigurationKey, Value: config}
+	return r.datastore.Save(configEntry)
+}
+
+// GetGotifyConfig will return the Gotify configuration.
+func (r *SqlConfigRepository) GetGotifyConfig() models.GotifyConfiguration {
+	configEntry, err := r.datastore.Get(gotifyConfigurationKey)
+	if err != nil {
+		return models.GotifyConfiguration{Enabled: false}
+	}
+
+	var config models.GotifyConfiguration
+	if err := configEntry.GetObject(&config); err != nil {
+		return models.GotifyConfiguration{Enabled: false}
+	}
+
+	return config
+}
+
+// SetGotifyConfig will set the Gotify configuration.
+func (r *SqlConfigRepository) SetGotifyConfig(config models.GotifyConfiguration) error {
+	configEntry := models.ConfigEntry{Key: gotifyConfigurationKey, Value: config}
+	return r.datastore.Save(configEntry)
+}
+
+// GetSlackConfig will return the Slack configuration.
+func (r *SqlConfigRepository) GetSlackConfig() models.SlackConfiguration {
+	configEntry, err := r.datastore.Get(slackConfigurationKey)
+	if err != nil {
+		return models.SlackConfiguration{Enabled: false}
+	}
+
+	var config models.SlackConfiguration
+	if err := configEntry.GetObject(&config); err != nil {
+		return models.SlackConfiguration{Enabled: false}
+	}
+
+	return config
+}
+
+// SetSlackConfig will set the Slack configuration.
+func (r *SqlConfigRepository) SetSlackConfig(config models.SlackConfiguration) error {
+	configEntry := models.ConfigEntry{Key: slackConfigurationKey, Value: config}
+	return r.datastore.Save(configEntry)
+}
+
+// GetEmailConfig will return the email configuration.
+func (r *SqlConfigRepository) GetEmailConfig() models.EmailConfiguration {
+	configEntry, err := r.datastore.Get(emailConfigurationKey)
+	if err != nil {
+		return models.EmailConfiguration{Enabled: false}
+	}
+
+	var config models.EmailConfiguration
+	if err := configEntry.GetObject(&config); err != nil {
+		return models.EmailConfiguration{Enabled: false}
+	}
+
+	return config
+}
+
+// SetEmailConfig will set the email configuration.
+func (r *SqlConfigRepository) SetEmailConfig(config models.EmailConfiguration) error {
+	configEntry := models.ConfigEntry{Key: emailConfigurationKey, Value: config}
+	return r.datastore.Save(configEntry)
+}
+
+// GetNotificationRules will return the go-live notification rules, keyed
+// by channel name.
+func (r *SqlConfigRepository) GetNotificationRules() map[string]models.NotificationRule {
+	rules := map[string]models.NotificationRule{}
+
+	configEntry, err := r.datastore.Get(notificationRulesKey)
+	if err != nil {
+		return rules
+	}
+
+	if err := configEntry.GetObject(&rules); err != nil {
+		return map[string]models.NotificationRule{}
+	}
+
+	return rules
+}
+
+// SetNotificationRules will set the go-live notification rules.
+func (r *SqlConfigRepository) SetNotificationRules(rules map[string]models.NotificationRule) error {
+	configEntry := models.ConfigEntry{Key: notificationRulesKey, Value: rules}
+	return r.datastore.Save(configEntry)
+}
+
+// GetStreamReportChannel will return the notification channel end of
+// stream reports are sent to. Empty means reports are not sent.
+func (r *SqlConfigRepository) GetStreamReportChannel() string {
+	channel, _ := r.datastore.GetString(streamReportChannelKey)
+	return channel
+}
+
+// SetStreamReportChannel will set the notification channel end of stream
+// reports are sent to.
+func (r *SqlConfigRepository) SetStreamReportChannel(channel string) error {
+	return r.datastore.SetString(streamReportChannelKey, channel)
+}
+
+// GetAlertRules will return the rules alerts are fired from.
+func (r *SqlConfigRepository) GetAlertRules() []models.AlertRule {
+	configEntry, err := r.datastore.Get(alertRulesKey)
+	if err != nil {
+		return config.GetDefaults().AlertRules
+	}
+
+	var rules []models.AlertRule
+	if err := configEntry.GetObject(&rules); err != nil {
+		return config.GetDefaults().AlertRules
+	}
+
+	return rules
+}
+
+// SetAlertRules will set the rules alerts are fired from.
+func (r *SqlConfigRepository) SetAlertRules(rules []models.AlertRule) error {
+	configEntry := models.ConfigEntry{Key: alertRulesKey, Value: rules}
+	return r.datastore.Save(configEntry)
+}
+
 // GetBrowserPushConfig will return the browser push configuration.
 func (r *SqlConfigRepository) GetBrowserPushConfig() models.BrowserNotificationConfiguration {
 	configEntry, err := r.datastore.Get(browserPushConfigurationKey)
@@ -913,6 +1206,39 @@ func (r *SqlConfigRepository) GetBrowserPushPrivateKey() (string, error) {
 	return r.datastore.GetString(browserPushPrivateKeyKey)
 }
 
+// GetScheduleReminderMinutes will return how long before a scheduled stream
+// a reminder is sent. Zero means reminders are not sent.
+func (r *SqlConfigRepository) GetScheduleReminderMinutes() int {
+	minutes, err := r.datastore.GetNumber(scheduleReminderMinutesKey)
+	if err != nil {
+		return config.GetDefaults().ScheduleReminderMinutes
+	}
+
+	return int(minutes)
+}
+
+// SetScheduleReminderMinutes will set how long before a scheduled stream a
+// reminder is sent.
+func (r *SqlConfigRepository) SetScheduleReminderMinutes(minutes int) error {
+	if minutes < 0 {
+		return errors.New("reminder minutes can't be negative")
+	}
+
+	return r.datastore.SetNumber(scheduleReminderMinutesKey, float64(minutes))
+}
+
+// SetEmailSubscriptionSigningKey will set the key used to sign email
+// subscription confirmation and unsubscribe links.
+func (r *SqlConfigRepository) SetEmailSubscriptionSigningKey(key string) error {
+	return r.datastore.SetString(emailSubscriptionSigningKeyKey, key)
+}
+
+// GetEmailSubscriptionSigningKey will return the key used to sign email
+// subscription confirmation and unsubscribe links.
+func (r *SqlConfigRepository) GetEmailSubscriptionSigningKey() (string, error) {
+	return r.datastore.GetString(emailSubscriptionSigningKeyKey)
+}
+
 // SetHasPerformedInitialNotificationsConfig sets when performed initial setup.
 func (r *SqlConfigRepository) SetHasPerformedInitialNotificationsConfig(hasConfigured bool) error {
 	return r.datastore.SetBool(hasConfiguredInitialNotificationsKey, true)
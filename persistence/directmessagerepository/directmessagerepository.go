@@ -0,0 +1,105 @@
+package directmessagerepository
+
+import (
+	"strings"
+	"time"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+)
+
+type DirectMessageRepository interface {
+	SaveMessage(message models.DirectMessage) error
+	GetMessagesForUser(userID string, limit int) ([]models.DirectMessage, error)
+	RemoveMessagesBefore(before time.Time) error
+}
+
+type SqlDirectMessageRepository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance DirectMessageRepository
+
+// Get will return the direct message repository.
+func Get() DirectMessageRepository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the DirectMessageRepository.
+func New(datastore *data.Datastore) DirectMessageRepository {
+	r := SqlDirectMessageRepository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+// SaveMessage will save a single direct message.
+func (r *SqlDirectMessageRepository) SaveMessage(message models.DirectMessage) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("INSERT INTO direct_messages(id, sender_id, recipient_id, body, timestamp) values(?, ?, ?, ?, ?)",
+		message.ID, message.Sender.ID, message.RecipientID, message.Body, message.Timestamp)
+
+	return err
+}
+
+// GetMessagesForUser will return the most recent direct messages sent to or
+// from a user, oldest first.
+func (r *SqlDirectMessageRepository) GetMessagesForUser(userID string, limit int) ([]models.DirectMessage, error) {
+	query := `SELECT direct_messages.id, sender_id, recipient_id, body, timestamp, users.display_name, users.display_color, users.scopes
+		FROM direct_messages INNER JOIN users ON direct_messages.sender_id = users.id
+		WHERE sender_id = ? OR recipient_id = ? ORDER BY timestamp DESC LIMIT ?`
+
+	rows, err := r.datastore.DB.Query(query, userID, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := []models.DirectMessage{}
+	for rows.Next() {
+		var message models.DirectMessage
+		var senderID string
+		var body, scopes *string
+		sender := &models.User{}
+
+		if err := rows.Scan(&message.ID, &senderID, &message.RecipientID, &body, &message.Timestamp, &sender.DisplayName, &sender.DisplayColor, &scopes); err != nil {
+			return nil, err
+		}
+
+		sender.ID = senderID
+		if scopes != nil && *scopes != "" {
+			sender.Scopes = strings.Split(*scopes, ",")
+		}
+		if body != nil {
+			message.Body = *body
+		}
+		message.Sender = sender
+
+		messages = append(messages, message)
+	}
+
+	// Invert order of messages
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+
+	return messages, rows.Err()
+}
+
+// RemoveMessagesBefore will delete the direct messages sent before the
+// provided time.
+func (r *SqlDirectMessageRepository) RemoveMessagesBefore(before time.Time) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("DELETE FROM direct_messages WHERE timestamp <= ?", before)
+	return err
+}
@@ -0,0 +1,105 @@
+package moderationrepository
+
+import (
+	"strings"
+	"time"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/pkg/errors"
+)
+
+type ModerationRepository interface {
+	RecordAction(action models.ModerationAction) error
+	GetActions(filter models.ModerationActionFilter, offset int, limit int) ([]models.ModerationAction, int, error)
+}
+
+type SqlModerationRepository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance ModerationRepository
+
+// Get will return the moderation repository.
+func Get() ModerationRepository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the ModerationRepository.
+func New(datastore *data.Datastore) ModerationRepository {
+	r := SqlModerationRepository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+// RecordAction will add a single entry to the moderation log.
+func (r *SqlModerationRepository) RecordAction(action models.ModerationAction) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	if action.Timestamp.IsZero() {
+		action.Timestamp = time.Now()
+	}
+
+	_, err := r.datastore.DB.Exec("INSERT INTO moderation_actions(timestamp, actor_type, actor_id, actor_name, action, target, reason) values(?, ?, ?, ?, ?, ?, ?)",
+		action.Timestamp, action.ActorType, action.ActorID, action.ActorName, action.Action, action.Target, action.Reason)
+
+	return err
+}
+
+// GetActions will return a page of the moderation log, newest first,
+// along with the total number of matching entries.
+func (r *SqlModerationRepository) GetActions(filter models.ModerationActionFilter, offset int, limit int) ([]models.ModerationAction, int, error) {
+	actions := make([]models.ModerationAction, 0)
+
+	conditions := []string{}
+	args := []interface{}{}
+
+	if filter.Action != "" {
+		conditions = append(conditions, "action = ?")
+		args = append(args, filter.Action)
+	}
+	if filter.ActorID != "" {
+		conditions = append(conditions, "actor_id = ?")
+		args = append(args, filter.ActorID)
+	}
+	if filter.Target != "" {
+		conditions = append(conditions, "target = ?")
+		args = append(args, filter.Target)
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	if err := r.datastore.DB.QueryRow("SELECT COUNT(*) FROM moderation_actions"+where, args...).Scan(&total); err != nil {
+		return actions, 0, errors.Wrap(err, "error counting moderation actions")
+	}
+
+	query := "SELECT id, timestamp, actor_type, actor_id, actor_name, action, target, reason FROM moderation_actions" + where + " ORDER BY timestamp DESC, id DESC LIMIT ? OFFSET ?"
+	rows, err := r.datastore.DB.Query(query, append(args, limit, offset)...)
+	if err != nil {
+		return actions, 0, errors.Wrap(err, "error fetching moderation actions")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var action models.ModerationAction
+		if err := rows.Scan(&action.ID, &action.Timestamp, &action.ActorType, &action.ActorID, &action.ActorName, &action.Action, &action.Target, &action.Reason); err != nil {
+			return actions, 0, errors.Wrap(err, "error reading moderation actions")
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions, total, rows.Err()
+}
@@ -0,0 +1,214 @@
+package pollrepository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/pkg/errors"
+)
+
+type PollRepository interface {
+	CreatePoll(poll models.Poll) error
+	GetPoll(id string) (*models.Poll, error)
+	GetActivePoll() (*models.Poll, error)
+	GetPolls(offset int, limit int) ([]models.Poll, int, error)
+	EndPoll(id string, endedAt time.Time) error
+	AddVote(pollID string, userID string, option int) (bool, error)
+}
+
+type SqlPollRepository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance PollRepository
+
+// Get will return the poll repository.
+func Get() PollRepository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the PollRepository.
+func New(datastore *data.Datastore) PollRepository {
+	r := SqlPollRepository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+const pollColumns = "id, question, options, created_by_type, created_by_id, created_by_name, started_at, ends_at, ended_at"
+
+// CreatePoll will save a new poll.
+func (r *SqlPollRepository) CreatePoll(poll models.Poll) error {
+	options := make([]string, len(poll.Options))
+	for i, option := range poll.Options {
+		options[i] = option.Text
+	}
+
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err = r.datastore.DB.Exec("INSERT INTO polls("+pollColumns+") values(?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		poll.ID, poll.Question, string(optionsJSON), poll.CreatedByType, poll.CreatedByID, poll.CreatedByName, poll.StartedAt, poll.EndsAt, poll.EndedAt)
+
+	return err
+}
+
+// GetPoll will return a single poll with its current results, or nil if
+// it does not exist.
+func (r *SqlPollRepository) GetPoll(id string) (*models.Poll, error) {
+	return r.getPoll(r.datastore.DB.QueryRow("SELECT "+pollColumns+" FROM polls WHERE id = ?", id))
+}
+
+// GetActivePoll will return the poll currently accepting votes, or nil if
+// there isn't one.
+func (r *SqlPollRepository) GetActivePoll() (*models.Poll, error) {
+	return r.getPoll(r.datastore.DB.QueryRow("SELECT " + pollColumns + " FROM polls WHERE ended_at IS NULL ORDER BY started_at DESC LIMIT 1"))
+}
+
+func (r *SqlPollRepository) getPoll(row *sql.Row) (*models.Poll, error) {
+	poll, err := scanPoll(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := r.attachVotes(poll); err != nil {
+		return nil, err
+	}
+
+	return poll, nil
+}
+
+// GetPolls will return a page of polls with their results, newest first,
+// along with the total number of polls.
+func (r *SqlPollRepository) GetPolls(offset int, limit int) ([]models.Poll, int, error) {
+	polls := []models.Poll{}
+
+	var total int
+	if err := r.datastore.DB.QueryRow("SELECT COUNT(*) FROM polls").Scan(&total); err != nil {
+		return polls, 0, errors.Wrap(err, "error counting polls")
+	}
+
+	rows, err := r.datastore.DB.Query("SELECT "+pollColumns+" FROM polls ORDER BY started_at DESC LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return polls, 0, errors.Wrap(err, "error fetching polls")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		poll, err := scanPoll(rows)
+		if err != nil {
+			return polls, 0, errors.Wrap(err, "error reading polls")
+		}
+		polls = append(polls, *poll)
+	}
+	if err := rows.Err(); err != nil {
+		return polls, 0, err
+	}
+
+	for i := range polls {
+		if err := r.attachVotes(&polls[i]); err != nil {
+			return polls, 0, err
+		}
+	}
+
+	return polls, total, nil
+}
+
+// EndPoll will stop a poll from accepting votes.
+func (r *SqlPollRepository) EndPoll(id string, endedAt time.Time) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("UPDATE polls SET ended_at = ? WHERE id = ? AND ended_at IS NULL", endedAt, id)
+	return err
+}
+
+// AddVote will save a user's vote in a poll. Users can only vote once in
+// each poll, so false is returned if they have already voted.
+func (r *SqlPollRepository) AddVote(pollID string, userID string, option int) (bool, error) {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	result, err := r.datastore.DB.Exec("INSERT OR IGNORE INTO poll_votes(poll_id, user_id, option, timestamp) values(?, ?, ?, ?)", pollID, userID, option, time.Now())
+	if err != nil {
+		return false, err
+	}
+
+	added, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return added > 0, nil
+}
+
+// attachVotes will set the number of votes for each of the poll's options.
+func (r *SqlPollRepository) attachVotes(poll *models.Poll) error {
+	rows, err := r.datastore.DB.Query("SELECT option, COUNT(*) FROM poll_votes WHERE poll_id = ? GROUP BY option", poll.ID)
+	if err != nil {
+		return errors.Wrap(err, "error fetching poll votes")
+	}
+	defer rows.Close()
+
+	poll.TotalVotes = 0
+	for rows.Next() {
+		var option, votes int
+		if err := rows.Scan(&option, &votes); err != nil {
+			return errors.Wrap(err, "error reading poll votes")
+		}
+
+		if option >= 0 && option < len(poll.Options) {
+			poll.Options[option].Votes = votes
+			poll.TotalVotes += votes
+		}
+	}
+
+	return rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanPoll(row scanner) (*models.Poll, error) {
+	var poll models.Poll
+	var options string
+	var endedAt sql.NullTime
+
+	if err := row.Scan(&poll.ID, &poll.Question, &options, &poll.CreatedByType, &poll.CreatedByID, &poll.CreatedByName, &poll.StartedAt, &poll.EndsAt, &endedAt); err != nil {
+		return nil, err
+	}
+
+	var optionTexts []string
+	if err := json.Unmarshal([]byte(options), &optionTexts); err != nil {
+		return nil, errors.Wrap(err, "error reading poll options")
+	}
+
+	poll.Options = make([]models.PollOption, len(optionTexts))
+	for i, text := range optionTexts {
+		poll.Options[i] = models.PollOption{Text: text}
+	}
+
+	if endedAt.Valid {
+		poll.EndedAt = &endedAt.Time
+	}
+
+	return &poll, nil
+}
@@ -0,0 +1,170 @@
+package schedulerepository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/pkg/errors"
+)
+
+type ScheduleRepository interface {
+	CreateScheduledStream(stream models.ScheduledStream) error
+	UpdateScheduledStream(stream models.ScheduledStream) error
+	DeleteScheduledStream(id string) error
+	GetScheduledStream(id string) (*models.ScheduledStream, error)
+	GetScheduledStreams() ([]models.ScheduledStream, error)
+	GetUpcomingScheduledStreams(since time.Time) ([]models.ScheduledStream, error)
+	GetScheduledStreamsNeedingReminder(now time.Time, before time.Time) ([]models.ScheduledStream, error)
+	SetReminderSent(id string, sentAt time.Time) error
+}
+
+type SqlScheduleRepository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance ScheduleRepository
+
+// Get will return the schedule repository.
+func Get() ScheduleRepository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the ScheduleRepository.
+func New(datastore *data.Datastore) ScheduleRepository {
+	r := SqlScheduleRepository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+// Times are always stored in UTC so they can be compared in queries.
+const scheduledStreamColumns = "id, title, description, thumbnail, start_time, reminder_sent_at, created_at"
+
+// CreateScheduledStream will save a new scheduled stream.
+func (r *SqlScheduleRepository) CreateScheduledStream(stream models.ScheduledStream) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("INSERT INTO scheduled_streams("+scheduledStreamColumns+") values(?, ?, ?, ?, ?, ?, ?)",
+		stream.ID, stream.Title, stream.Description, stream.Thumbnail, stream.StartTime.UTC(), nil, stream.CreatedAt.UTC())
+
+	return err
+}
+
+// UpdateScheduledStream will update the details of a scheduled stream. If
+// the start time changes a new reminder will be sent.
+func (r *SqlScheduleRepository) UpdateScheduledStream(stream models.ScheduledStream) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	result, err := r.datastore.DB.Exec(`UPDATE scheduled_streams SET title = ?, description = ?, thumbnail = ?,
+		reminder_sent_at = CASE WHEN start_time = ? THEN reminder_sent_at ELSE NULL END, start_time = ? WHERE id = ?`,
+		stream.Title, stream.Description, stream.Thumbnail, stream.StartTime.UTC(), stream.StartTime.UTC(), stream.ID)
+	if err != nil {
+		return err
+	}
+
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return errors.New("scheduled stream not found")
+	}
+
+	return nil
+}
+
+// DeleteScheduledStream will remove a scheduled stream.
+func (r *SqlScheduleRepository) DeleteScheduledStream(id string) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("DELETE FROM scheduled_streams WHERE id = ?", id)
+	return err
+}
+
+// GetScheduledStream will return a single scheduled stream, or nil if it
+// does not exist.
+func (r *SqlScheduleRepository) GetScheduledStream(id string) (*models.ScheduledStream, error) {
+	stream, err := scanScheduledStream(r.datastore.DB.QueryRow("SELECT "+scheduledStreamColumns+" FROM scheduled_streams WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return stream, err
+}
+
+// GetScheduledStreams will return every scheduled stream, soonest first.
+func (r *SqlScheduleRepository) GetScheduledStreams() ([]models.ScheduledStream, error) {
+	return r.queryScheduledStreams("SELECT " + scheduledStreamColumns + " FROM scheduled_streams ORDER BY start_time ASC")
+}
+
+// GetUpcomingScheduledStreams will return the streams starting after the
+// provided time, soonest first.
+func (r *SqlScheduleRepository) GetUpcomingScheduledStreams(since time.Time) ([]models.ScheduledStream, error) {
+	return r.queryScheduledStreams("SELECT "+scheduledStreamColumns+" FROM scheduled_streams WHERE start_time >= ? ORDER BY start_time ASC", since.UTC())
+}
+
+// GetScheduledStreamsNeedingReminder will return the streams that haven't
+// started yet, start before the provided time and haven't had a reminder
+// sent.
+func (r *SqlScheduleRepository) GetScheduledStreamsNeedingReminder(now time.Time, before time.Time) ([]models.ScheduledStream, error) {
+	return r.queryScheduledStreams("SELECT "+scheduledStreamColumns+" FROM scheduled_streams WHERE reminder_sent_at IS NULL AND start_time > ? AND start_time <= ? ORDER BY start_time ASC", now.UTC(), before.UTC())
+}
+
+// SetReminderSent will record that the reminder for a scheduled stream was
+// sent.
+func (r *SqlScheduleRepository) SetReminderSent(id string, sentAt time.Time) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("UPDATE scheduled_streams SET reminder_sent_at = ? WHERE id = ?", sentAt.UTC(), id)
+	return err
+}
+
+func (r *SqlScheduleRepository) queryScheduledStreams(query string, args ...interface{}) ([]models.ScheduledStream, error) {
+	streams := []models.ScheduledStream{}
+
+	rows, err := r.datastore.DB.Query(query, args...)
+	if err != nil {
+		return streams, errors.Wrap(err, "error fetching scheduled streams")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		stream, err := scanScheduledStream(rows)
+		if err != nil {
+			return streams, errors.Wrap(err, "error reading scheduled streams")
+		}
+		streams = append(streams, *stream)
+	}
+
+	return streams, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanScheduledStream(row scanner) (*models.ScheduledStream, error) {
+	var stream models.ScheduledStream
+	var description, thumbnail sql.NullString
+	var reminderSentAt sql.NullTime
+
+	if err := row.Scan(&stream.ID, &stream.Title, &description, &thumbnail, &stream.StartTime, &reminderSentAt, &stream.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	stream.Description = description.String
+	stream.Thumbnail = thumbnail.String
+	if reminderSentAt.Valid {
+		stream.ReminderSentAt = &reminderSentAt.Time
+	}
+
+	return &stream, nil
+}
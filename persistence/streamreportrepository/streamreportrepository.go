@@ -0,0 +1,122 @@
+package streamreportrepository
+
+import (
+	"database/sql"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/pkg/errors"
+)
+
+type StreamReportRepository interface {
+	SaveReport(report models.StreamReport) error
+	GetReports() ([]models.StreamReport, error)
+	GetReport(id string) (*models.StreamReport, error)
+	DeleteReport(id string) error
+}
+
+type SqlStreamReportRepository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance StreamReportRepository
+
+// Get will return the stream report repository.
+func Get() StreamReportRepository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the StreamReportRepository.
+func New(datastore *data.Datastore) StreamReportRepository {
+	r := SqlStreamReportRepository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+const streamReportColumns = `id, started_at, ended_at, created_at, stream_title, peak_viewer_count, average_viewer_count,
+	chat_message_count, new_follower_count, encoder, video_bitrate, framerate, width, height, average_cpu_usage,
+	healthy_percentage, health_message`
+
+// SaveReport will save the report of a broadcast.
+func (r *SqlStreamReportRepository) SaveReport(report models.StreamReport) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	encoder := report.Encoder
+	_, err := r.datastore.DB.Exec("INSERT INTO stream_reports("+streamReportColumns+") values(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		report.ID, report.StartedAt, report.EndedAt, report.CreatedAt, report.StreamTitle, report.PeakViewerCount, report.AverageViewerCount,
+		report.ChatMessageCount, report.NewFollowerCount, encoder.Name, encoder.VideoBitrate, encoder.Framerate, encoder.Width, encoder.Height, encoder.AverageCPUUsage,
+		encoder.HealthyPercentage, encoder.HealthMessage)
+
+	return err
+}
+
+// GetReports will return every stream report, newest first.
+func (r *SqlStreamReportRepository) GetReports() ([]models.StreamReport, error) {
+	reports := []models.StreamReport{}
+
+	rows, err := r.datastore.DB.Query("SELECT " + streamReportColumns + " FROM stream_reports ORDER BY started_at DESC")
+	if err != nil {
+		return reports, errors.Wrap(err, "error fetching stream reports")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		report, err := scanStreamReport(rows)
+		if err != nil {
+			return reports, errors.Wrap(err, "error reading stream reports")
+		}
+		reports = append(reports, *report)
+	}
+
+	return reports, rows.Err()
+}
+
+// GetReport will return a single stream report, or nil if it does not
+// exist.
+func (r *SqlStreamReportRepository) GetReport(id string) (*models.StreamReport, error) {
+	report, err := scanStreamReport(r.datastore.DB.QueryRow("SELECT "+streamReportColumns+" FROM stream_reports WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return report, err
+}
+
+// DeleteReport will remove a single stream report.
+func (r *SqlStreamReportRepository) DeleteReport(id string) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("DELETE FROM stream_reports WHERE id = ?", id)
+	return err
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanStreamReport(row scanner) (*models.StreamReport, error) {
+	var report models.StreamReport
+	var streamTitle, encoderName, healthMessage sql.NullString
+
+	if err := row.Scan(&report.ID, &report.StartedAt, &report.EndedAt, &report.CreatedAt, &streamTitle, &report.PeakViewerCount, &report.AverageViewerCount,
+		&report.ChatMessageCount, &report.NewFollowerCount, &encoderName, &report.Encoder.VideoBitrate, &report.Encoder.Framerate, &report.Encoder.Width, &report.Encoder.Height, &report.Encoder.AverageCPUUsage,
+		&report.Encoder.HealthyPercentage, &healthMessage); err != nil {
+		return nil, err
+	}
+
+	report.StreamTitle = streamTitle.String
+	report.Encoder.Name = encoderName.String
+	report.Encoder.HealthMessage = healthMessage.String
+	report.DurationSeconds = int(report.EndedAt.Sub(report.StartedAt).Seconds())
+
+	return &report, nil
+}
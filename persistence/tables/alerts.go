@@ -0,0 +1,29 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/owncast/owncast/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateAlertsTable will create the alert history table if needed.
+func CreateAlertsTable(db *sql.DB) {
+	log.Traceln("Creating alerts table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS alerts (
+		"id" TEXT NOT NULL,
+		"rule_id" TEXT NOT NULL,
+		"metric" TEXT NOT NULL,
+		"comparison" TEXT NOT NULL,
+		"severity" TEXT NOT NULL,
+		"value" REAL NOT NULL DEFAULT 0,
+		"threshold" REAL NOT NULL DEFAULT 0,
+		"fired_at" DATETIME NOT NULL,
+		"resolved_at" DATETIME,
+		PRIMARY KEY (id)
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_alerts_fired_at ON alerts (fired_at);`, db)
+}
@@ -0,0 +1,33 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/owncast/owncast/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateBroadcastsTable will create the broadcast history table if needed.
+func CreateBroadcastsTable(db *sql.DB) {
+	log.Traceln("Creating broadcasts table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS broadcasts (
+		"id" TEXT NOT NULL,
+		"started_at" DATETIME NOT NULL,
+		"ended_at" DATETIME,
+		"title" TEXT,
+		"tags" TEXT,
+		"output_settings" TEXT,
+		"latency_level" INTEGER NOT NULL DEFAULT 0,
+		"broadcaster" TEXT,
+		"peak_viewer_count" INTEGER NOT NULL DEFAULT 0,
+		"average_viewer_count" REAL NOT NULL DEFAULT 0,
+		"chat_message_count" INTEGER NOT NULL DEFAULT 0,
+		"chatter_count" INTEGER NOT NULL DEFAULT 0,
+		"viewers_over_time" TEXT,
+		PRIMARY KEY (id)
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_broadcasts_started_at ON broadcasts (started_at);`, db)
+}
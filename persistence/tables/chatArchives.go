@@ -0,0 +1,26 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/owncast/owncast/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateChatArchivesTable will create the broadcast chat archives table if needed.
+func CreateChatArchivesTable(db *sql.DB) {
+	log.Traceln("Creating chat archives table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS chat_archives (
+		"id" TEXT NOT NULL,
+		"started_at" DATETIME NOT NULL,
+		"ended_at" DATETIME NOT NULL,
+		"created_at" DATETIME NOT NULL,
+		"message_count" INTEGER NOT NULL DEFAULT 0,
+		"messages" BLOB,
+		PRIMARY KEY (id)
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_chat_archives_started_at ON chat_archives (started_at);`, db)
+}
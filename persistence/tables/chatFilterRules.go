@@ -0,0 +1,25 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/owncast/owncast/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+func CreateChatFilterRulesTable(db *sql.DB) {
+	log.Traceln("Creating chat filter rules table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS chat_filter_rules (
+		"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+		"type" TEXT NOT NULL,
+		"value" TEXT DEFAULT '',
+		"threshold" REAL DEFAULT 0,
+		"action" TEXT NOT NULL,
+		"timeout_seconds" INTEGER DEFAULT 0,
+		"enabled" INTEGER DEFAULT 1,
+		"created_at" DATETIME DEFAULT CURRENT_TIMESTAMP
+	);`
+
+	utils.MustExec(createTableSQL, db)
+}
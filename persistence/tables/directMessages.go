@@ -0,0 +1,27 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/owncast/owncast/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateDirectMessagesTable will create the private chat messages table if needed.
+func CreateDirectMessagesTable(db *sql.DB) {
+	log.Traceln("Creating direct messages table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS direct_messages (
+		"id" TEXT NOT NULL,
+		"sender_id" TEXT NOT NULL,
+		"recipient_id" TEXT NOT NULL,
+		"body" TEXT,
+		"timestamp" DATETIME NOT NULL,
+		PRIMARY KEY (id)
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_direct_messages_sender_id ON direct_messages (sender_id);`, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_direct_messages_recipient_id ON direct_messages (recipient_id);`, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_direct_messages_timestamp ON direct_messages (timestamp);`, db)
+}
@@ -19,6 +19,9 @@ func CreateMessagesTable(db *sql.DB) {
 		"subtitle" TEXT,
 		"image" TEXT,
 		"link" TEXT,
+		"reply_to" TEXT,
+		"edited_at" DATETIME,
+		"deleted_at" DATETIME,
 		PRIMARY KEY (id)
 	);`
 	utils.MustExec(createTableSQL, db)
@@ -31,3 +34,43 @@ func CreateMessagesTable(db *sql.DB) {
 	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_timestamp ON messages (timestamp);`, db)
 	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_messages_hidden_at_timestamp on messages(hidden_at, timestamp);`, db)
 }
+
+// CreateMessageReactionsTable will create the chat message reactions table if needed.
+func CreateMessageReactionsTable(db *sql.DB) {
+	createTableSQL := `CREATE TABLE IF NOT EXISTS message_reactions (
+		"message_id" TEXT NOT NULL,
+		"user_id" TEXT NOT NULL,
+		"emoji" TEXT NOT NULL,
+		"timestamp" DATETIME DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (message_id, user_id, emoji)
+	);`
+	utils.MustExec(createTableSQL, db)
+
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_message_reactions_message_id ON message_reactions (message_id);`, db)
+}
+
+// CreateMessageEditsTable will create the table keeping the previous
+// versions of edited chat messages if needed.
+func CreateMessageEditsTable(db *sql.DB) {
+	createTableSQL := `CREATE TABLE IF NOT EXISTS message_edits (
+		"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+		"message_id" TEXT NOT NULL,
+		"body" TEXT,
+		"edited_at" DATETIME NOT NULL
+	);`
+	utils.MustExec(createTableSQL, db)
+
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_message_edits_message_id ON message_edits (message_id);`, db)
+}
+
+// CreateMessagesSearchIndex will create the full-text search index of chat
+// messages if needed. It returns an error if SQLite was built without FTS5.
+func CreateMessagesSearchIndex(db *sql.DB) error {
+	_, err := db.Exec(`CREATE VIRTUAL TABLE IF NOT EXISTS messages_fts USING fts5(
+		message_id UNINDEXED,
+		body,
+		tokenize = 'unicode61 remove_diacritics 2'
+	);`)
+
+	return err
+}
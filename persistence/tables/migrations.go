@@ -33,6 +33,16 @@ func MigrateDatabaseSchema(db *sql.DB, from, to int) error {
 			migrateToSchema6(db)
 		case 6:
 			migrateToSchema7(db)
+		case 7:
+			migrateToSchema8(db)
+		case 8:
+			migrateToSchema9(db)
+		case 9:
+			migrateToSchema10(db)
+		case 10:
+			migrateToSchema11(db)
+		case 11:
+			migrateToSchema12(db)
 		default:
 			log.Fatalln("missing database migration step")
 		}
@@ -46,6 +56,114 @@ func MigrateDatabaseSchema(db *sql.DB, from, to int) error {
 	return nil
 }
 
+func migrateToSchema12(db *sql.DB) {
+	// Webhooks can now have payload templates and filters.
+	for _, column := range []string{"template", "content_type", "filter"} {
+		stmt, err := db.Prepare("ALTER TABLE webhooks ADD COLUMN " + column + " TEXT")
+		if err != nil {
+			log.Errorln("Error running migration. This may be because you have already been running a dev version.", err)
+			return
+		}
+
+		_, err = stmt.Exec()
+		if err != nil {
+			log.Warnln(err)
+		}
+		stmt.Close()
+	}
+}
+
+func migrateToSchema11(db *sql.DB) {
+	// Webhooks now have a secret their payloads are signed with.
+	stmt, err := db.Prepare("ALTER TABLE webhooks ADD COLUMN secret TEXT")
+	if err != nil {
+		log.Errorln("Error running migration. This may be because you have already been running a dev version.", err)
+		return
+	}
+	defer stmt.Close()
+
+	if _, err := stmt.Exec(); err != nil {
+		log.Warnln(err)
+	}
+
+	rows, err := db.Query("SELECT id FROM webhooks WHERE secret IS NULL")
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	defer rows.Close()
+
+	ids := []int{}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			log.Errorln(err)
+			return
+		}
+		ids = append(ids, id)
+	}
+
+	for _, id := range ids {
+		secret, err := utils.GenerateRandomString(32)
+		if err != nil {
+			log.Errorln(err)
+			return
+		}
+		if _, err := db.Exec("UPDATE webhooks SET secret = ? WHERE id = ?", secret, id); err != nil {
+			log.Errorln(err)
+		}
+	}
+}
+
+func migrateToSchema10(db *sql.DB) {
+	// Webhooks can now be chat bots that act as an integration.
+	for _, column := range []string{"type TEXT NOT NULL DEFAULT 'STANDARD'", "access_token TEXT"} {
+		stmt, err := db.Prepare("ALTER TABLE webhooks ADD COLUMN " + column)
+		if err != nil {
+			log.Errorln("Error running migration. This may be because you have already been running a dev version.", err)
+			return
+		}
+
+		_, err = stmt.Exec()
+		if err != nil {
+			log.Warnln(err)
+		}
+		stmt.Close()
+	}
+}
+
+func migrateToSchema9(db *sql.DB) {
+	// Chat messages can now be edited and deleted by their sender.
+	for _, column := range []string{"edited_at", "deleted_at"} {
+		stmt, err := db.Prepare("ALTER TABLE messages ADD COLUMN " + column + " DATETIME")
+		if err != nil {
+			log.Errorln("Error running migration. This may be because you have already been running a dev version.", err)
+			return
+		}
+
+		_, err = stmt.Exec()
+		if err != nil {
+			log.Warnln(err)
+		}
+		stmt.Close()
+	}
+}
+
+func migrateToSchema8(db *sql.DB) {
+	// Chat messages can now be a reply to another message.
+	stmt, err := db.Prepare("ALTER TABLE messages ADD COLUMN reply_to TEXT")
+	if err != nil {
+		log.Errorln("Error running migration. This may be because you have already been running a dev version.", err)
+		return
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec()
+	if err != nil {
+		log.Warnln(err)
+	}
+}
+
 func migrateToSchema7(db *sql.DB) {
 	log.Println("Migrating users. This may take time if you have lots of users...")
 
@@ -0,0 +1,28 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/owncast/owncast/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+func CreateModerationActionsTable(db *sql.DB) {
+	log.Traceln("Creating moderation actions table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS moderation_actions (
+		"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+		"timestamp" DATETIME DEFAULT CURRENT_TIMESTAMP,
+		"actor_type" TEXT NOT NULL,
+		"actor_id" TEXT NOT NULL,
+		"actor_name" TEXT DEFAULT '',
+		"action" TEXT NOT NULL,
+		"target" TEXT NOT NULL,
+		"reason" TEXT DEFAULT ''
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_moderation_actions_timestamp ON moderation_actions (timestamp);`, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_moderation_actions_actor_id ON moderation_actions (actor_id);`, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_moderation_actions_target ON moderation_actions (target);`, db)
+}
@@ -0,0 +1,39 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/owncast/owncast/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreatePollsTables will create the chat polls and poll votes tables if needed.
+func CreatePollsTables(db *sql.DB) {
+	log.Traceln("Creating polls tables...")
+
+	createPollsTableSQL := `CREATE TABLE IF NOT EXISTS polls (
+		"id" TEXT NOT NULL,
+		"question" TEXT NOT NULL,
+		"options" TEXT NOT NULL,
+		"created_by_type" TEXT NOT NULL,
+		"created_by_id" TEXT NOT NULL,
+		"created_by_name" TEXT NOT NULL,
+		"started_at" DATETIME NOT NULL,
+		"ends_at" DATETIME NOT NULL,
+		"ended_at" DATETIME,
+		PRIMARY KEY (id)
+	);`
+
+	utils.MustExec(createPollsTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_polls_started_at ON polls (started_at);`, db)
+
+	createPollVotesTableSQL := `CREATE TABLE IF NOT EXISTS poll_votes (
+		"poll_id" TEXT NOT NULL,
+		"user_id" TEXT NOT NULL,
+		"option" INTEGER NOT NULL,
+		"timestamp" DATETIME NOT NULL,
+		PRIMARY KEY (poll_id, user_id)
+	);`
+
+	utils.MustExec(createPollVotesTableSQL, db)
+}
@@ -0,0 +1,28 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/owncast/owncast/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateScheduledStreamsTable will create the scheduled streams table if
+// needed.
+func CreateScheduledStreamsTable(db *sql.DB) {
+	log.Traceln("Creating scheduled streams table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS scheduled_streams (
+		"id" TEXT NOT NULL,
+		"title" TEXT NOT NULL,
+		"description" TEXT,
+		"thumbnail" TEXT,
+		"start_time" DATETIME NOT NULL,
+		"reminder_sent_at" DATETIME,
+		"created_at" DATETIME NOT NULL,
+		PRIMARY KEY (id)
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_scheduled_streams_start_time ON scheduled_streams (start_time);`, db)
+}
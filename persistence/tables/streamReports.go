@@ -0,0 +1,38 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/owncast/owncast/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateStreamReportsTable will create the end of stream reports table if
+// needed.
+func CreateStreamReportsTable(db *sql.DB) {
+	log.Traceln("Creating stream reports table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS stream_reports (
+		"id" TEXT NOT NULL,
+		"started_at" DATETIME NOT NULL,
+		"ended_at" DATETIME NOT NULL,
+		"created_at" DATETIME NOT NULL,
+		"stream_title" TEXT,
+		"peak_viewer_count" INTEGER NOT NULL DEFAULT 0,
+		"average_viewer_count" REAL NOT NULL DEFAULT 0,
+		"chat_message_count" INTEGER NOT NULL DEFAULT 0,
+		"new_follower_count" INTEGER NOT NULL DEFAULT 0,
+		"encoder" TEXT,
+		"video_bitrate" INTEGER NOT NULL DEFAULT 0,
+		"framerate" REAL NOT NULL DEFAULT 0,
+		"width" INTEGER NOT NULL DEFAULT 0,
+		"height" INTEGER NOT NULL DEFAULT 0,
+		"average_cpu_usage" REAL NOT NULL DEFAULT 0,
+		"healthy_percentage" INTEGER NOT NULL DEFAULT 0,
+		"health_message" TEXT,
+		PRIMARY KEY (id)
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_stream_reports_started_at ON stream_reports (started_at);`, db)
+}
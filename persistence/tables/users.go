@@ -10,6 +10,7 @@ import (
 func SetupUsers(db *sql.DB) {
 	CreateUsersTable(db)
 	CreateAccessTokenTable(db)
+	CreateUserTimeoutsTable(db)
 }
 
 func CreateAccessTokenTable(db *sql.DB) {
@@ -54,3 +55,18 @@ func CreateUsersTable(db *sql.DB) {
 	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_user_id_disabled ON users (id, disabled_at);`, db)
 	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_user_disabled_at ON users (disabled_at);`, db)
 }
+
+func CreateUserTimeoutsTable(db *sql.DB) {
+	log.Traceln("Creating user timeouts table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS user_timeouts (
+		"user_id" TEXT NOT NULL PRIMARY KEY,
+		"reason" TEXT DEFAULT '',
+		"created_at" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		"expires_at" TIMESTAMP NOT NULL,
+		FOREIGN KEY(user_id) REFERENCES users(id)
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_user_timeouts_expires_at ON user_timeouts (expires_at);`, db)
+}
@@ -0,0 +1,32 @@
+package tables
+
+import (
+	"database/sql"
+
+	"github.com/owncast/owncast/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// CreateViewerSessionsTable will create the anonymous viewer analytics
+// table if needed.
+func CreateViewerSessionsTable(db *sql.DB) {
+	log.Traceln("Creating viewer sessions table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS viewer_sessions (
+		"id" TEXT NOT NULL,
+		"broadcast_id" TEXT NOT NULL,
+		"first_seen" DATETIME NOT NULL,
+		"watch_seconds" INTEGER NOT NULL DEFAULT 0,
+		"country_code" TEXT,
+		"region_name" TEXT,
+		"browser" TEXT,
+		"os" TEXT,
+		"device_class" TEXT,
+		"referrer" TEXT,
+		PRIMARY KEY (id)
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_viewer_sessions_broadcast_id ON viewer_sessions (broadcast_id);`, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_viewer_sessions_first_seen ON viewer_sessions (first_seen);`, db)
+}
@@ -3,6 +3,7 @@ package tables
 import (
 	"database/sql"
 
+	"github.com/owncast/owncast/utils"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -14,7 +15,13 @@ func CreateWebhooksTable(db *sql.DB) {
 		"url" string NOT NULL,
 		"events" TEXT NOT NULL,
 		"timestamp" DATETIME DEFAULT CURRENT_TIMESTAMP,
-		"last_used" DATETIME
+		"last_used" DATETIME,
+		"type" TEXT NOT NULL DEFAULT 'STANDARD',
+		"access_token" TEXT,
+		"secret" TEXT,
+		"template" TEXT,
+		"content_type" TEXT,
+		"filter" TEXT
 	);`
 
 	stmt, err := db.Prepare(createTableSQL)
@@ -26,3 +33,24 @@ func CreateWebhooksTable(db *sql.DB) {
 		log.Warnln(err)
 	}
 }
+
+func CreateWebhookDeliveriesTable(db *sql.DB) {
+	log.Traceln("Creating webhook deliveries table...")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS webhook_deliveries (
+		"id" INTEGER PRIMARY KEY AUTOINCREMENT,
+		"webhook_id" INTEGER NOT NULL,
+		"event_type" TEXT NOT NULL,
+		"payload" BLOB NOT NULL,
+		"status_code" INTEGER NOT NULL DEFAULT 0,
+		"latency_ms" INTEGER NOT NULL DEFAULT 0,
+		"attempts" INTEGER NOT NULL DEFAULT 0,
+		"success" BOOLEAN NOT NULL DEFAULT FALSE,
+		"error" TEXT,
+		"created_at" DATETIME NOT NULL,
+		"updated_at" DATETIME NOT NULL
+	);`
+
+	utils.MustExec(createTableSQL, db)
+	utils.MustExec(`CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_id ON webhook_deliveries (webhook_id);`, db)
+}
@@ -28,6 +28,7 @@ type UserRepository interface {
 	GetDisabledUsers() []*models.User
 	GetExternalAPIUser() ([]models.ExternalAPIUser, error)
 	GetExternalAPIUserForAccessTokenAndScope(token string, scope string) (*models.ExternalAPIUser, error)
+	GetIntegrationNameForAccessToken(token string) *string
 	GetModeratorUsers() []*models.User
 	GetUserByID(id string) *models.User
 	GetUserByToken(token string) *models.User
@@ -42,6 +43,11 @@ type UserRepository interface {
 	AddAuth(userID, authToken string, authType models.AuthType) error
 	SetExternalAPIUserAccessTokenAsUsed(token string) error
 	GetUsersCount() int
+	SetTimeout(userID string, reason string, expiresAt time.Time) error
+	RemoveTimeout(userID string) error
+	GetTimeout(userID string) *models.UserTimeout
+	GetTimeouts() []*models.UserTimeout
+	RemoveExpiredTimeouts() error
 }
 
 type SqlUserRepository struct {
@@ -777,6 +783,8 @@ func (r *SqlUserRepository) HasValidScopes(scopes []string) bool {
 		models.ScopeCanSendChatMessages,
 		models.ScopeCanSendSystemMessages,
 		models.ScopeHasAdminAccess,
+		models.ScopeCanSendDirectMessages,
+		models.ScopeCanManagePolls,
 	}
 
 	for _, scope := range scopes {
@@ -804,3 +812,133 @@ func (r *SqlUserRepository) GetUsersCount() int {
 	}
 	return count
 }
+
+// SetTimeout will prevent a user from taking part in chat until the
+// provided expiry time. Any existing timeout is replaced.
+func (r *SqlUserRepository) SetTimeout(userID string, reason string, expiresAt time.Time) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	tx, err := r.datastore.DB.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback() //nolint
+
+	stmt, err := tx.Prepare("INSERT OR REPLACE INTO user_timeouts(user_id, reason, created_at, expires_at) VALUES(?, ?, ?, ?)")
+	if err != nil {
+		return err
+	}
+
+	defer stmt.Close()
+
+	if _, err := stmt.Exec(userID, reason, time.Now(), expiresAt); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RemoveTimeout will remove any timeout for a single user.
+func (r *SqlUserRepository) RemoveTimeout(userID string) error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("DELETE FROM user_timeouts WHERE user_id = ?", userID)
+	return err
+}
+
+// RemoveExpiredTimeouts will remove all the timeouts that have expired.
+func (r *SqlUserRepository) RemoveExpiredTimeouts() error {
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	_, err := r.datastore.DB.Exec("DELETE FROM user_timeouts WHERE expires_at <= ?", time.Now())
+	return err
+}
+
+// GetTimeout will return the active timeout for a user, or nil if they
+// are not timed out.
+func (r *SqlUserRepository) GetTimeout(userID string) *models.UserTimeout {
+	query := `SELECT t.reason, t.created_at, t.expires_at, u.id, u.display_name, u.display_color, u.created_at, u.disabled_at, u.previous_names, u.namechanged_at, u.scopes
+		FROM user_timeouts t INNER JOIN users u ON u.id = t.user_id
+		WHERE t.user_id = ? AND t.expires_at > ?`
+
+	rows, err := r.datastore.DB.Query(query, userID, time.Now())
+	if err != nil {
+		log.Errorln(err)
+		return nil
+	}
+	defer rows.Close()
+
+	timeouts := r.getTimeoutsFromRows(rows)
+	if len(timeouts) == 0 {
+		return nil
+	}
+
+	return timeouts[0]
+}
+
+// GetTimeouts will return all the active timeouts.
+func (r *SqlUserRepository) GetTimeouts() []*models.UserTimeout {
+	query := `SELECT t.reason, t.created_at, t.expires_at, u.id, u.display_name, u.display_color, u.created_at, u.disabled_at, u.previous_names, u.namechanged_at, u.scopes
+		FROM user_timeouts t INNER JOIN users u ON u.id = t.user_id
+		WHERE t.expires_at > ?
+		ORDER BY t.expires_at`
+
+	rows, err := r.datastore.DB.Query(query, time.Now())
+	if err != nil {
+		log.Errorln(err)
+		return nil
+	}
+	defer rows.Close()
+
+	return r.getTimeoutsFromRows(rows)
+}
+
+func (r *SqlUserRepository) getTimeoutsFromRows(rows *sql.Rows) []*models.UserTimeout {
+	timeouts := make([]*models.UserTimeout, 0)
+
+	for rows.Next() {
+		var reason string
+		var createdAt time.Time
+		var expiresAt time.Time
+		var id string
+		var displayName string
+		var displayColor int
+		var userCreatedAt time.Time
+		var disabledAt *time.Time
+		var previousUsernames string
+		var userNameChangedAt *time.Time
+		var scopesString *string
+
+		if err := rows.Scan(&reason, &createdAt, &expiresAt, &id, &displayName, &displayColor, &userCreatedAt, &disabledAt, &previousUsernames, &userNameChangedAt, &scopesString); err != nil {
+			log.Errorln("error creating collection of user timeouts from results", err)
+			return nil
+		}
+
+		var scopes []string
+		if scopesString != nil {
+			scopes = strings.Split(*scopesString, ",")
+		}
+
+		timeouts = append(timeouts, &models.UserTimeout{
+			Reason:    reason,
+			CreatedAt: createdAt,
+			ExpiresAt: expiresAt,
+			User: &models.User{
+				ID:            id,
+				DisplayName:   displayName,
+				DisplayColor:  displayColor,
+				CreatedAt:     userCreatedAt,
+				DisabledAt:    disabledAt,
+				PreviousNames: strings.Split(previousUsernames, ","),
+				NameChangedAt: userNameChangedAt,
+				Scopes:        scopes,
+			},
+		})
+	}
+
+	return timeouts
+}
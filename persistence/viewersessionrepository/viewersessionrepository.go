@@ -0,0 +1,182 @@
+package viewersessionrepository
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/utils"
+	"github.com/pkg/errors"
+)
+
+type ViewerSessionRepository interface {
+	SaveViewerSessions(sessions []models.ViewerSession) error
+	GetViewerAnalytics(filter models.ViewerAnalyticsFilter) (*models.ViewerAnalytics, error)
+}
+
+type SqlViewerSessionRepository struct {
+	datastore *data.Datastore
+}
+
+// NOTE: This is temporary during the transition period.
+var temporaryGlobalInstance ViewerSessionRepository
+
+// Get will return the viewer session repository.
+func Get() ViewerSessionRepository {
+	if temporaryGlobalInstance == nil {
+		i := New(data.GetDatastore())
+		temporaryGlobalInstance = i
+	}
+	return temporaryGlobalInstance
+}
+
+// New will create a new instance of the ViewerSessionRepository.
+func New(datastore *data.Datastore) ViewerSessionRepository {
+	r := SqlViewerSessionRepository{
+		datastore: datastore,
+	}
+
+	return &r
+}
+
+// SaveViewerSessions will save new viewer sessions and update the watch
+// time and location of ones already saved.
+func (r *SqlViewerSessionRepository) SaveViewerSessions(sessions []models.ViewerSession) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	r.datastore.DbLock.Lock()
+	defer r.datastore.DbLock.Unlock()
+
+	tx, err := r.datastore.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	stmt, err := tx.Prepare(`INSERT INTO viewer_sessions(id, broadcast_id, first_seen, watch_seconds, country_code, region_name, browser, os, device_class, referrer)
+		values(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET watch_seconds = excluded.watch_seconds, country_code = excluded.country_code, region_name = excluded.region_name`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, s := range sessions {
+		if _, err := stmt.Exec(s.ID, s.BroadcastID, s.FirstSeen, s.WatchSeconds, s.CountryCode, s.RegionName, s.Browser, s.OS, s.DeviceClass, s.Referrer); err != nil {
+			return errors.Wrap(err, "error saving viewer session")
+		}
+	}
+
+	return tx.Commit()
+}
+
+// watchTimeBucket groups viewers by how long they watched for, in an
+// order that sorts the groups from shortest to longest.
+const watchTimeBucket = `CASE
+		WHEN watch_seconds < 60 THEN '1. Under a minute'
+		WHEN watch_seconds < 300 THEN '2. 1 to 5 minutes'
+		WHEN watch_seconds < 900 THEN '3. 5 to 15 minutes'
+		WHEN watch_seconds < 1800 THEN '4. 15 to 30 minutes'
+		WHEN watch_seconds < 3600 THEN '5. 30 to 60 minutes'
+		ELSE '6. Over an hour'
+	END`
+
+// GetViewerAnalytics will return the breakdown of the viewers matching the
+// filter.
+func (r *SqlViewerSessionRepository) GetViewerAnalytics(filter models.ViewerAnalyticsFilter) (*models.ViewerAnalytics, error) {
+	where, args := filterClause(filter)
+	analytics := models.ViewerAnalytics{}
+
+	if err := r.datastore.DB.QueryRow("SELECT COUNT(*), COALESCE(SUM(watch_seconds), 0) FROM viewer_sessions"+where, args...).
+		Scan(&analytics.UniqueViewers, &analytics.TotalWatchSeconds); err != nil {
+		return nil, errors.Wrap(err, "error counting viewers")
+	}
+	if analytics.UniqueViewers > 0 {
+		analytics.AverageWatchSeconds = float64(analytics.TotalWatchSeconds) / float64(analytics.UniqueViewers)
+	}
+
+	breakdowns := []struct {
+		counts   *[]models.AnalyticsCount
+		column   string
+		fallback string
+	}{
+		{&analytics.WatchTime, watchTimeBucket, ""},
+		{&analytics.Countries, "country_code", "Unknown"},
+		{&analytics.Regions, "CASE WHEN COALESCE(region_name, '') = '' THEN '' ELSE region_name || ', ' || country_code END", "Unknown"},
+		{&analytics.Browsers, "browser", "Unknown"},
+		{&analytics.OperatingSystems, "os", "Unknown"},
+		{&analytics.DeviceClasses, "device_class", "Unknown"},
+		{&analytics.Referrers, "referrer", "Direct"},
+	}
+
+	for _, b := range breakdowns {
+		counts, err := r.countBy(b.column, b.fallback, where, args)
+		if err != nil {
+			return nil, err
+		}
+		*b.counts = counts
+	}
+
+	// The watch time groups are only numbered so they sort in order.
+	sort.Slice(analytics.WatchTime, func(i, j int) bool {
+		return analytics.WatchTime[i].Name < analytics.WatchTime[j].Name
+	})
+	for i, c := range analytics.WatchTime {
+		analytics.WatchTime[i].Name = c.Name[3:]
+	}
+
+	return &analytics, nil
+}
+
+// countBy will return the number of viewers for each value of an
+// expression, most common first. Viewers without a value are counted under
+// the fallback name.
+func (r *SqlViewerSessionRepository) countBy(expression string, fallback string, where string, args []interface{}) ([]models.AnalyticsCount, error) {
+	counts := []models.AnalyticsCount{}
+
+	query := "SELECT COALESCE(NULLIF(" + expression + ", ''), '" + fallback + "') AS name, COUNT(*) AS count FROM viewer_sessions" + where + " GROUP BY name ORDER BY count DESC, name"
+	rows, err := r.datastore.DB.Query(query, args...)
+	if err != nil {
+		return counts, errors.Wrap(err, "error fetching viewer analytics")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var c models.AnalyticsCount
+		if err := rows.Scan(&c.Name, &c.Count); err != nil {
+			return counts, errors.Wrap(err, "error reading viewer analytics")
+		}
+		counts = append(counts, c)
+	}
+
+	return counts, rows.Err()
+}
+
+func filterClause(filter models.ViewerAnalyticsFilter) (string, []interface{}) {
+	conditions := []string{}
+	args := []interface{}{}
+
+	if filter.BroadcastID != "" {
+		conditions = append(conditions, "broadcast_id = ?")
+		args = append(args, filter.BroadcastID)
+	}
+	if filter.Since != nil {
+		conditions = append(conditions, "first_seen >= ?")
+		args = append(args, utils.StoredTimestamp(*filter.Since))
+	}
+	if filter.Until != nil {
+		conditions = append(conditions, "first_seen <= ?")
+		args = append(args, utils.StoredTimestamp(*filter.Until))
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
@@ -0,0 +1,181 @@
+package viewersessionrepository
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/teris-io/shortid"
+)
+
+func TestMain(m *testing.M) {
+	// Timestamps are stored in the server's zone, so make sure it isn't UTC.
+	time.Local = time.FixedZone("UTC-5", -5*60*60)
+
+	dbFile, err := os.CreateTemp(os.TempDir(), "owncast-viewersessions-test-db.db")
+	if err != nil {
+		panic(err)
+	}
+	dbFile.Close()
+	defer os.Remove(dbFile.Name())
+
+	if err := data.SetupPersistence(dbFile.Name()); err != nil {
+		panic(err)
+	}
+
+	m.Run()
+}
+
+// newTestSession will return a session for a viewer of a broadcast who was
+// first seen at the given time.
+func newTestSession(broadcastID string, firstSeen time.Time) models.ViewerSession {
+	return models.ViewerSession{
+		ID:          shortid.MustGenerate(),
+		BroadcastID: broadcastID,
+		FirstSeen:   firstSeen,
+	}
+}
+
+func TestGetViewerAnalyticsTimeRange(t *testing.T) {
+	repository := New(data.GetDatastore())
+	broadcastID := shortid.MustGenerate()
+	now := time.Now()
+
+	sessions := []models.ViewerSession{
+		newTestSession(broadcastID, now.Add(-3*time.Hour)),
+		newTestSession(broadcastID, now.Add(-90*time.Minute)),
+		newTestSession(broadcastID, now.Add(-30*time.Minute)),
+	}
+	if err := repository.SaveViewerSessions(sessions); err != nil {
+		t.Fatal(err)
+	}
+
+	// Filters arrive from the API in UTC, not the server's zone.
+	since := now.Add(-2 * time.Hour).UTC()
+	until := now.Add(-time.Hour).UTC()
+
+	tests := []struct {
+		name     string
+		filter   models.ViewerAnalyticsFilter
+		expected int
+	}{
+		{"no range", models.ViewerAnalyticsFilter{BroadcastID: broadcastID}, 3},
+		{"since", models.ViewerAnalyticsFilter{BroadcastID: broadcastID, Since: &since}, 2},
+		{"until", models.ViewerAnalyticsFilter{BroadcastID: broadcastID, Until: &until}, 2},
+		{"since and until", models.ViewerAnalyticsFilter{BroadcastID: broadcastID, Since: &since, Until: &until}, 1},
+	}
+
+	for _, test := range tests {
+		analytics, err := repository.GetViewerAnalytics(test.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if analytics.UniqueViewers != test.expected {
+			t.Errorf("%s: expected %d viewers, got %d", test.name, test.expected, analytics.UniqueViewers)
+		}
+	}
+}
+
+func TestGetViewerAnalyticsBreakdowns(t *testing.T) {
+	repository := New(data.GetDatastore())
+	broadcastID := shortid.MustGenerate()
+	now := time.Now()
+
+	details := []struct {
+		country      string
+		region       string
+		deviceClass  string
+		referrer     string
+		watchSeconds int
+	}{
+		{"US", "Oregon", "Desktop", "https://example.com", 30},
+		{"US", "Oregon", "Phone", "", 200},
+		{"DE", "", "Desktop", "https://example.com", 4000},
+		{"", "", "", "", 600},
+	}
+
+	sessions := []models.ViewerSession{}
+	for _, d := range details {
+		s := newTestSession(broadcastID, now)
+		s.CountryCode = d.country
+		s.RegionName = d.region
+		s.DeviceClass = d.deviceClass
+		s.Referrer = d.referrer
+		s.WatchSeconds = d.watchSeconds
+		sessions = append(sessions, s)
+	}
+	if err := repository.SaveViewerSessions(sessions); err != nil {
+		t.Fatal(err)
+	}
+
+	analytics, err := repository.GetViewerAnalytics(models.ViewerAnalyticsFilter{BroadcastID: broadcastID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if analytics.UniqueViewers != 4 {
+		t.Errorf("expected 4 viewers, got %d", analytics.UniqueViewers)
+	}
+	if analytics.TotalWatchSeconds != 4830 {
+		t.Errorf("expected 4830 seconds watched, got %d", analytics.TotalWatchSeconds)
+	}
+	if analytics.AverageWatchSeconds != 1207.5 {
+		t.Errorf("expected an average of 1207.5 seconds watched, got %f", analytics.AverageWatchSeconds)
+	}
+
+	expectCounts(t, "countries", analytics.Countries, []models.AnalyticsCount{{Name: "US", Count: 2}, {Name: "DE", Count: 1}, {Name: "Unknown", Count: 1}})
+	expectCounts(t, "regions", analytics.Regions, []models.AnalyticsCount{{Name: "Oregon, US", Count: 2}, {Name: "Unknown", Count: 2}})
+	expectCounts(t, "devices", analytics.DeviceClasses, []models.AnalyticsCount{{Name: "Desktop", Count: 2}, {Name: "Phone", Count: 1}, {Name: "Unknown", Count: 1}})
+	expectCounts(t, "referrers", analytics.Referrers, []models.AnalyticsCount{{Name: "Direct", Count: 2}, {Name: "https://example.com", Count: 2}})
+	expectCounts(t, "watch time", analytics.WatchTime, []models.AnalyticsCount{
+		{Name: "Under a minute", Count: 1},
+		{Name: "1 to 5 minutes", Count: 1},
+		{Name: "5 to 15 minutes", Count: 1},
+		{Name: "Over an hour", Count: 1},
+	})
+}
+
+func TestSaveViewerSessionsUpdatesWatchTime(t *testing.T) {
+	repository := New(data.GetDatastore())
+	session := newTestSession(shortid.MustGenerate(), time.Now())
+	session.WatchSeconds = 30
+
+	if err := repository.SaveViewerSessions([]models.ViewerSession{session}); err != nil {
+		t.Fatal(err)
+	}
+
+	session.WatchSeconds = 90
+	session.CountryCode = "CA"
+	if err := repository.SaveViewerSessions([]models.ViewerSession{session}); err != nil {
+		t.Fatal(err)
+	}
+
+	analytics, err := repository.GetViewerAnalytics(models.ViewerAnalyticsFilter{BroadcastID: session.BroadcastID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if analytics.UniqueViewers != 1 {
+		t.Errorf("saving a session again should not add a viewer, got %d", analytics.UniqueViewers)
+	}
+	if analytics.TotalWatchSeconds != 90 {
+		t.Errorf("expected 90 seconds watched, got %d", analytics.TotalWatchSeconds)
+	}
+	expectCounts(t, "countries", analytics.Countries, []models.AnalyticsCount{{Name: "CA", Count: 1}})
+}
+
+func expectCounts(t *testing.T, name string, counts []models.AnalyticsCount, expected []models.AnalyticsCount) {
+	t.Helper()
+
+	if len(counts) != len(expected) {
+		t.Errorf("%s: expected %v, got %v", name, expected, counts)
+		return
+	}
+	for i := range expected {
+		if counts[i] != expected[i] {
+			t.Errorf("%s: expected %v, got %v", name, expected, counts)
+			return
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package webhookrepository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/owncast/owncast/models"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxDeliveriesPerWebhook is how many deliveries are kept in the log for
+// each webhook.
+const maxDeliveriesPerWebhook = 100
+
+const deliveryColumns = "id, webhook_id, event_type, payload, status_code, latency_ms, attempts, success, error, created_at, updated_at"
+
+// InsertDelivery will add a delivery to the log and return its ID. Older
+// deliveries for the same webhook are removed.
+func (r *SqlWebhookRepository) InsertDelivery(delivery models.WebhookDelivery) (int, error) {
+	now := time.Now()
+
+	result, err := r.datastore.DB.Exec(`INSERT INTO webhook_deliveries(webhook_id, event_type, payload, status_code, latency_ms, attempts, success, error, created_at, updated_at)
+		VALUES(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		delivery.WebhookID, delivery.EventType, delivery.Payload, delivery.StatusCode, delivery.LatencyMs, delivery.Attempts, delivery.Success, delivery.Error, now, now)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := r.datastore.DB.Exec(`DELETE FROM webhook_deliveries WHERE webhook_id = ? AND id NOT IN (
+		SELECT id FROM webhook_deliveries WHERE webhook_id = ? ORDER BY id DESC LIMIT ?)`,
+		delivery.WebhookID, delivery.WebhookID, maxDeliveriesPerWebhook); err != nil {
+		log.Warnln("unable to prune webhook deliveries", err)
+	}
+
+	return int(id), nil
+}
+
+// UpdateDelivery will save the outcome of the latest attempt of a delivery.
+func (r *SqlWebhookRepository) UpdateDelivery(delivery models.WebhookDelivery) error {
+	_, err := r.datastore.DB.Exec("UPDATE webhook_deliveries SET status_code = ?, latency_ms = ?, attempts = ?, success = ?, error = ?, updated_at = ? WHERE id = ?",
+		delivery.StatusCode, delivery.LatencyMs, delivery.Attempts, delivery.Success, delivery.Error, time.Now(), delivery.ID)
+
+	return err
+}
+
+// GetDelivery will return a single delivery, or nil if it does not exist.
+func (r *SqlWebhookRepository) GetDelivery(id int) (*models.WebhookDelivery, error) {
+	row := r.datastore.DB.QueryRow("SELECT "+deliveryColumns+" FROM webhook_deliveries WHERE id = ?", id)
+
+	delivery, err := scanDelivery(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return delivery, err
+}
+
+// GetDeliveries will return a page of deliveries for a webhook, newest
+// first, along with the total number of deliveries logged for it.
+func (r *SqlWebhookRepository) GetDeliveries(webhookID int, offset int, limit int) ([]models.WebhookDelivery, int, error) {
+	deliveries := []models.WebhookDelivery{}
+
+	var total int
+	if err := r.datastore.DB.QueryRow("SELECT COUNT(*) FROM webhook_deliveries WHERE webhook_id = ?", webhookID).Scan(&total); err != nil {
+		return deliveries, 0, err
+	}
+
+	rows, err := r.datastore.DB.Query("SELECT "+deliveryColumns+" FROM webhook_deliveries WHERE webhook_id = ? ORDER BY id DESC LIMIT ? OFFSET ?", webhookID, limit, offset)
+	if err != nil {
+		return deliveries, 0, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return deliveries, 0, err
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+
+	return deliveries, total, rows.Err()
+}
+
+func scanDelivery(row scanner) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	var deliveryError sql.NullString
+
+	if err := row.Scan(&delivery.ID, &delivery.WebhookID, &delivery.EventType, &delivery.Payload, &delivery.StatusCode, &delivery.LatencyMs, &delivery.Attempts, &delivery.Success, &deliveryError, &delivery.CreatedAt, &delivery.UpdatedAt); err != nil {
+		return nil, err
+	}
+	delivery.Error = deliveryError.String
+
+	return &delivery, nil
+}
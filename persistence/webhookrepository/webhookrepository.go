@@ -1,6 +1,7 @@
 package webhookrepository
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
@@ -8,15 +9,23 @@ import (
 
 	"github.com/owncast/owncast/core/data"
 	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/utils"
 	log "github.com/sirupsen/logrus"
 )
 
 type WebhookRepository interface {
 	InsertWebhook(url string, events []models.EventType) (int, error)
+	InsertChatBotWebhook(url string, events []models.EventType, accessToken string) (int, error)
 	DeleteWebhook(id int) error
+	GetWebhook(id int) (*models.Webhook, error)
 	GetWebhooksForEvent(event models.EventType) []models.Webhook
 	GetWebhooks() ([]models.Webhook, error)
 	SetWebhookAsUsed(webhook models.Webhook) error
+	SetWebhookPayloadOptions(id int, template string, contentType string, filter string) error
+	InsertDelivery(delivery models.WebhookDelivery) (int, error)
+	UpdateDelivery(delivery models.WebhookDelivery) error
+	GetDelivery(id int) (*models.WebhookDelivery, error)
+	GetDeliveries(webhookID int, offset int, limit int) ([]models.WebhookDelivery, int, error)
 }
 
 type SqlWebhookRepository struct {
@@ -48,19 +57,37 @@ func New(datastore *data.Datastore) WebhookRepository {
 func (r *SqlWebhookRepository) InsertWebhook(url string, events []models.EventType) (int, error) {
 	log.Traceln("Adding new webhook")
 
+	return r.insertWebhook(url, events, models.WebhookTypeStandard, nil)
+}
+
+// InsertChatBotWebhook will add a new chat bot webhook that acts as the
+// integration with the provided access token.
+func (r *SqlWebhookRepository) InsertChatBotWebhook(url string, events []models.EventType, accessToken string) (int, error) {
+	log.Traceln("Adding new chat bot webhook")
+
+	return r.insertWebhook(url, events, models.WebhookTypeChatBot, &accessToken)
+}
+
+func (r *SqlWebhookRepository) insertWebhook(url string, events []models.EventType, webhookType models.WebhookType, accessToken *string) (int, error) {
 	eventsString := strings.Join(events, ",")
 
+	// Every webhook gets its own secret to sign payloads with.
+	secret, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return 0, err
+	}
+
 	tx, err := r.datastore.DB.Begin()
 	if err != nil {
 		return 0, err
 	}
-	stmt, err := tx.Prepare("INSERT INTO webhooks(url, events) values(?, ?)")
+	stmt, err := tx.Prepare("INSERT INTO webhooks(url, events, type, access_token, secret) values(?, ?, ?, ?, ?)")
 	if err != nil {
 		return 0, err
 	}
 	defer stmt.Close()
 
-	insertResult, err := stmt.Exec(url, eventsString)
+	insertResult, err := stmt.Exec(url, eventsString, webhookType, accessToken, secret)
 	if err != nil {
 		return 0, err
 	}
@@ -105,23 +132,43 @@ func (r *SqlWebhookRepository) DeleteWebhook(id int) error {
 		return err
 	}
 
+	// The delivery log is only useful alongside its webhook.
+	if _, err := r.datastore.DB.Exec("DELETE FROM webhook_deliveries WHERE webhook_id = ?", id); err != nil {
+		log.Warnln("unable to remove webhook deliveries", err)
+	}
+
 	return nil
 }
 
+// GetWebhook will return a single webhook, or nil if it does not exist.
+func (r *SqlWebhookRepository) GetWebhook(id int) (*models.Webhook, error) {
+	row := r.datastore.DB.QueryRow("SELECT "+webhookColumns+" FROM webhooks WHERE id = ?", id)
+
+	webhook, err := scanWebhook(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
 // GetWebhooksForEvent will return all of the webhooks that want to be notified about an event type.
 func (r *SqlWebhookRepository) GetWebhooksForEvent(event models.EventType) []models.Webhook {
 	webhooks := make([]models.Webhook, 0)
 
 	query := `SELECT * FROM (
-		WITH RECURSIVE split(id, url, event, rest) AS (
-		  SELECT id, url, '', events || ',' FROM webhooks
+		WITH RECURSIVE split(id, url, type, access_token, secret, template, content_type, filter, event, rest) AS (
+		  SELECT id, url, type, access_token, secret, template, content_type, filter, '', events || ',' FROM webhooks
 		   UNION ALL
-		  SELECT id, url,
+		  SELECT id, url, type, access_token, secret, template, content_type, filter,
 				 substr(rest, 0, instr(rest, ',')),
 				 substr(rest, instr(rest, ',')+1)
 			FROM split
 		   WHERE rest <> '')
-		SELECT id, url, event
+		SELECT id, url, type, access_token, secret, template, content_type, filter, event
 		  FROM split
 		 WHERE event <> ''
 	  ) AS webhook WHERE event IS ?`
@@ -135,16 +182,27 @@ func (r *SqlWebhookRepository) GetWebhooksForEvent(event models.EventType) []mod
 	for rows.Next() {
 		var id int
 		var url string
+		var webhookType string
+		var accessToken *string
+		var secret, template, contentType, filter sql.NullString
 
-		if err := rows.Scan(&id, &url, &event); err != nil {
+		if err := rows.Scan(&id, &url, &webhookType, &accessToken, &secret, &template, &contentType, &filter, &event); err != nil {
 			log.Debugln(err)
 			log.Error("There is a problem with the database.")
 			break
 		}
 
 		singleWebhook := models.Webhook{
-			ID:  id,
-			URL: url,
+			ID:          id,
+			URL:         url,
+			Type:        webhookType,
+			Secret:      secret.String,
+			Template:    template.String,
+			ContentType: contentType.String,
+			Filter:      filter.String,
+		}
+		if accessToken != nil {
+			singleWebhook.AccessToken = *accessToken
 		}
 
 		webhooks = append(webhooks, singleWebhook)
@@ -156,46 +214,20 @@ func (r *SqlWebhookRepository) GetWebhooksForEvent(event models.EventType) []mod
 func (r *SqlWebhookRepository) GetWebhooks() ([]models.Webhook, error) { //nolint
 	webhooks := make([]models.Webhook, 0)
 
-	query := "SELECT * FROM webhooks"
-
-	rows, err := r.datastore.DB.Query(query)
+	rows, err := r.datastore.DB.Query("SELECT " + webhookColumns + " FROM webhooks")
 	if err != nil {
 		return webhooks, err
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var id int
-		var url string
-		var events string
-		var timestampString string
-		var lastUsedString *string
-
-		if err := rows.Scan(&id, &url, &events, &timestampString, &lastUsedString); err != nil {
-			log.Error("There is a problem reading the database.", err)
-			return webhooks, err
-		}
-
-		timestamp, err := time.Parse(time.RFC3339, timestampString)
+		webhook, err := scanWebhook(rows)
 		if err != nil {
+			log.Error("There is a problem reading the database.", err)
 			return webhooks, err
 		}
 
-		var lastUsed *time.Time
-		if lastUsedString != nil {
-			lastUsedTime, _ := time.Parse(time.RFC3339, *lastUsedString)
-			lastUsed = &lastUsedTime
-		}
-
-		singleWebhook := models.Webhook{
-			ID:        id,
-			URL:       url,
-			Events:    strings.Split(events, ","),
-			Timestamp: timestamp,
-			LastUsed:  lastUsed,
-		}
-
-		webhooks = append(webhooks, singleWebhook)
+		webhooks = append(webhooks, *webhook)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -205,6 +237,56 @@ func (r *SqlWebhookRepository) GetWebhooks() ([]models.Webhook, error) { //nolin
 	return webhooks, nil
 }
 
+const webhookColumns = "id, url, events, timestamp, last_used, type, access_token, secret, template, content_type, filter"
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanWebhook(row scanner) (*models.Webhook, error) {
+	var id int
+	var url string
+	var events string
+	var timestampString string
+	var lastUsedString *string
+	var webhookType string
+	var accessToken *string
+	var secret, template, contentType, filter sql.NullString
+
+	if err := row.Scan(&id, &url, &events, &timestampString, &lastUsedString, &webhookType, &accessToken, &secret, &template, &contentType, &filter); err != nil {
+		return nil, err
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, timestampString)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastUsed *time.Time
+	if lastUsedString != nil {
+		lastUsedTime, _ := time.Parse(time.RFC3339, *lastUsedString)
+		lastUsed = &lastUsedTime
+	}
+
+	webhook := models.Webhook{
+		ID:          id,
+		URL:         url,
+		Type:        webhookType,
+		Secret:      secret.String,
+		Template:    template.String,
+		ContentType: contentType.String,
+		Filter:      filter.String,
+		Events:      strings.Split(events, ","),
+		Timestamp:   timestamp,
+		LastUsed:    lastUsed,
+	}
+	if accessToken != nil {
+		webhook.AccessToken = *accessToken
+	}
+
+	return &webhook, nil
+}
+
 // SetWebhookAsUsed will update the last used time for a webhook.
 func (r *SqlWebhookRepository) SetWebhookAsUsed(webhook models.Webhook) error {
 	tx, err := r.datastore.DB.Begin()
@@ -227,3 +309,18 @@ func (r *SqlWebhookRepository) SetWebhookAsUsed(webhook models.Webhook) error {
 
 	return nil
 }
+
+// SetWebhookPayloadOptions will set the payload template, its content type
+// and the event filter of a webhook. Empty values clear them.
+func (r *SqlWebhookRepository) SetWebhookPayloadOptions(id int, template string, contentType string, filter string) error {
+	result, err := r.datastore.DB.Exec("UPDATE webhooks SET template = ?, content_type = ?, filter = ? WHERE id = ?", template, contentType, filter, id)
+	if err != nil {
+		return err
+	}
+
+	if rowsUpdated, _ := result.RowsAffected(); rowsUpdated == 0 {
+		return errors.New(fmt.Sprint(id) + " not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package reports
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/owncast/owncast/activitypub"
+	"github.com/owncast/owncast/core"
+	"github.com/owncast/owncast/metrics"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/notifications"
+	"github.com/owncast/owncast/persistence/broadcastrepository"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/owncast/owncast/persistence/streamreportrepository"
+	log "github.com/sirupsen/logrus"
+	"github.com/teris-io/shortid"
+)
+
+// Start will begin generating a report each time a stream ends.
+func Start() {
+	core.SetStreamEndedHandler(handleStreamEnded)
+}
+
+func handleStreamEnded(stream core.EndedStream) {
+	viewers := metrics.GetViewersOverTime(stream.StartedAt, stream.EndedAt)
+	report := generateReport(stream, viewers)
+
+	if err := streamreportrepository.Get().SaveReport(report); err != nil {
+		log.Errorln("unable to save stream report", err)
+	}
+
+	if stream.BroadcastID != "" {
+		saveBroadcastAnalytics(stream, report, viewers)
+	}
+
+	channel := configrepository.Get().GetStreamReportChannel()
+	if channel == "" {
+		return
+	}
+
+	if err := notifications.SendAdminNotification(channel, reportText(report)); err != nil {
+		log.Errorln("unable to send stream report to", channel, err)
+	}
+}
+
+func generateReport(stream core.EndedStream, viewers []metrics.TimestampedValue) models.StreamReport {
+	peakViewerCount, averageViewerCount := viewerCounts(viewers, stream)
+
+	// Reports share the ID of the broadcast they are about.
+	id := stream.BroadcastID
+	if id == "" {
+		id = shortid.MustGenerate()
+	}
+
+	report := models.StreamReport{
+		ID:                 id,
+		StartedAt:          stream.StartedAt,
+		EndedAt:            stream.EndedAt,
+		CreatedAt:          time.Now(),
+		StreamTitle:        stream.StreamTitle,
+		DurationSeconds:    int(stream.EndedAt.Sub(stream.StartedAt).Seconds()),
+		PeakViewerCount:    peakViewerCount,
+		AverageViewerCount: averageViewerCount,
+	}
+
+	chatMessageCount, err := chatmessagerepository.Get().GetMessageCountBetween(stream.StartedAt, stream.EndedAt)
+	if err != nil {
+		log.Errorln("unable to count chat messages for stream report", err)
+	}
+	report.ChatMessageCount = chatMessageCount
+
+	newFollowerCount, err := activitypub.GetNewFollowerCount(stream.StartedAt, stream.EndedAt)
+	if err != nil {
+		log.Errorln("unable to count new followers for stream report", err)
+	}
+	report.NewFollowerCount = int(newFollowerCount)
+
+	if stream.Broadcaster != nil {
+		details := stream.Broadcaster.StreamDetails
+		report.Encoder.Name = details.Encoder
+		report.Encoder.VideoBitrate = details.VideoBitrate
+		report.Encoder.Framerate = details.VideoFramerate
+		report.Encoder.Width = details.Width
+		report.Encoder.Height = details.Height
+	}
+
+	report.Encoder.AverageCPUUsage = metrics.GetAverageCPUUtilization(stream.StartedAt, stream.EndedAt)
+
+	if health := metrics.GetStreamHealthOverview(); health != nil {
+		report.Encoder.HealthyPercentage = health.HealthyPercentage
+		report.Encoder.HealthMessage = health.Message
+	}
+
+	return report
+}
+
+// saveBroadcastAnalytics will add the viewer and chat activity of a stream
+// to its entry in the broadcast history.
+func saveBroadcastAnalytics(stream core.EndedStream, report models.StreamReport, viewers []metrics.TimestampedValue) {
+	chatterCount, err := chatmessagerepository.Get().GetChatterCountBetween(stream.StartedAt, stream.EndedAt)
+	if err != nil {
+		log.Errorln("unable to count chatters for broadcast history", err)
+	}
+
+	viewersOverTime := make([]models.TimestampedValue, 0, len(viewers))
+	for _, v := range viewers {
+		viewersOverTime = append(viewersOverTime, models.TimestampedValue(v))
+	}
+
+	if err := broadcastrepository.Get().SetBroadcastAnalytics(stream.BroadcastID, report.PeakViewerCount, report.AverageViewerCount, report.ChatMessageCount, chatterCount, viewersOverTime); err != nil {
+		log.Errorln("unable to save broadcast analytics", err)
+	}
+}
+
+// viewerCounts will return the peak and average viewer counts of a stream
+// from its sampled viewer counts. Short streams may not have been sampled,
+// so the peak the core tracked is used if it's higher.
+func viewerCounts(viewers []metrics.TimestampedValue, stream core.EndedStream) (int, float64) {
+	peak := max(int(metrics.MaxValue(viewers, stream.StartedAt, stream.EndedAt)), stream.PeakViewerCount)
+	average := metrics.AverageValue(viewers, stream.StartedAt, stream.EndedAt)
+
+	return peak, average
+}
+
+// reportText will return the report as a message, with a summary on the
+// first line.
+func reportText(report models.StreamReport) string {
+	lines := []string{"Stream report"}
+
+	if report.StreamTitle != "" {
+		lines = append(lines, "Title: "+report.StreamTitle)
+	}
+	lines = append(lines,
+		"Duration: "+formatDuration(time.Duration(report.DurationSeconds)*time.Second),
+		fmt.Sprintf("Viewers: %d peak, %.1f average", report.PeakViewerCount, report.AverageViewerCount),
+		fmt.Sprintf("Chat messages: %d", report.ChatMessageCount),
+		fmt.Sprintf("New followers: %d", report.NewFollowerCount),
+	)
+
+	encoder := report.Encoder
+	if encoder.Width > 0 && encoder.Height > 0 {
+		name := encoder.Name
+		if name == "" {
+			name = "Unknown encoder"
+		}
+		lines = append(lines, fmt.Sprintf("Encoder: %s, %dx%d at %g fps, %d kbps", name, encoder.Width, encoder.Height, encoder.Framerate, encoder.VideoBitrate))
+	}
+	lines = append(lines, fmt.Sprintf("Average CPU usage: %.0f%%", encoder.AverageCPUUsage))
+	if encoder.HealthyPercentage > 0 {
+		health := fmt.Sprintf("Stream health: %d%%", encoder.HealthyPercentage)
+		if encoder.HealthMessage != "" {
+			health += " - " + encoder.HealthMessage
+		}
+		lines = append(lines, health)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func formatDuration(duration time.Duration) string {
+	hours := int(duration.Hours())
+	minutes := int(duration.Minutes()) % 60
+
+	if hours > 0 {
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	}
+
+	return fmt.Sprintf("%dm", minutes)
+}
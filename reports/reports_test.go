@@ -0,0 +1,84 @@
+package reports
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/core"
+	"github.com/owncast/owncast/metrics"
+	"github.com/owncast/owncast/models"
+)
+
+func TestViewerCounts(t *testing.T) {
+	start := time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC)
+	stream := core.EndedStream{StartedAt: start, EndedAt: start.Add(time.Hour), PeakViewerCount: 3}
+
+	viewers := []metrics.TimestampedValue{
+		{Time: start.Add(-10 * time.Minute), Value: 50},
+		{Time: start.Add(2 * time.Minute), Value: 4},
+		{Time: start.Add(4 * time.Minute), Value: 10},
+		{Time: start.Add(6 * time.Minute), Value: 7},
+	}
+
+	peak, average := viewerCounts(viewers, stream)
+	if peak != 10 {
+		t.Errorf("Expected a peak of 10 viewers but got %d", peak)
+	}
+	if average != 7 {
+		t.Errorf("Expected an average of 7 viewers but got %f", average)
+	}
+
+	// A stream too short to be sampled falls back to the tracked peak.
+	peak, average = viewerCounts(nil, stream)
+	if peak != 3 || average != 0 {
+		t.Errorf("Expected the tracked peak of 3 and no average but got %d and %f", peak, average)
+	}
+}
+
+func TestReportText(t *testing.T) {
+	report := models.StreamReport{
+		StreamTitle:        "Speedruns",
+		DurationSeconds:    int((90*time.Minute + 30*time.Second).Seconds()),
+		PeakViewerCount:    12,
+		AverageViewerCount: 7.25,
+		ChatMessageCount:   340,
+		NewFollowerCount:   4,
+		Encoder: models.StreamReportEncoder{
+			Name:              "obs-output module",
+			Width:             1920,
+			Height:            1080,
+			Framerate:         30,
+			VideoBitrate:      4500,
+			AverageCPUUsage:   42.4,
+			HealthyPercentage: 95,
+		},
+	}
+
+	text := reportText(report)
+
+	expectedLines := []string{
+		"Stream report",
+		"Title: Speedruns",
+		"Duration: 1h 30m",
+		"Viewers: 12 peak, 7.2 average",
+		"Chat messages: 340",
+		"New followers: 4",
+		"Encoder: obs-output module, 1920x1080 at 30 fps, 4500 kbps",
+		"Average CPU usage: 42%",
+		"Stream health: 95%",
+	}
+
+	if text != strings.Join(expectedLines, "\n") {
+		t.Errorf("Unexpected report text:\n%s", text)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	if duration := formatDuration(45 * time.Minute); duration != "45m" {
+		t.Errorf("Unexpected duration %q", duration)
+	}
+	if duration := formatDuration(2*time.Hour + 5*time.Minute); duration != "2h 5m" {
+		t.Errorf("Unexpected duration %q", duration)
+	}
+}
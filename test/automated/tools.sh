@@ -51,7 +51,7 @@ function start_owncast() {
 	# Build and run owncast from source
 	echo "Building owncast..."
 	pushd "$(git rev-parse --show-toplevel)" >/dev/null
-	CGO_ENABLED=1 go build -o owncast main.go
+	CGO_ENABLED=1 go build -tags sqlite_fts5 -o owncast main.go
 
 	echo "Running owncast..."
 	./owncast -database "$TEMP_DB" &
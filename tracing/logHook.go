@@ -0,0 +1,30 @@
+package tracing
+
+import (
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// logHook adds the trace and span IDs to log entries made with a context
+// holding a span, such as log.WithContext(ctx).Errorln(...).
+type logHook struct{}
+
+func (h *logHook) Levels() []log.Level {
+	return log.AllLevels
+}
+
+func (h *logHook) Fire(entry *log.Entry) error {
+	if entry.Context == nil {
+		return nil
+	}
+
+	spanContext := trace.SpanContextFromContext(entry.Context)
+	if !spanContext.IsValid() {
+		return nil
+	}
+
+	entry.Data["trace_id"] = spanContext.TraceID().String()
+	entry.Data["span_id"] = spanContext.SpanID().String()
+
+	return nil
+}
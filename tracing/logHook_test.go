@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLogHook(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+
+	hook := &logHook{}
+
+	entry := log.NewEntry(log.StandardLogger()).WithContext(ctx)
+	if err := hook.Fire(entry); err != nil {
+		t.Fatal(err)
+	}
+	if entry.Data["trace_id"] != traceID.String() || entry.Data["span_id"] != spanID.String() {
+		t.Errorf("Expected the trace and span IDs to be logged but got %v", entry.Data)
+	}
+
+	// Entries without a span are left alone.
+	entry = log.NewEntry(log.StandardLogger()).WithContext(context.Background())
+	if err := hook.Fire(entry); err != nil {
+		t.Fatal(err)
+	}
+	if len(entry.Data) != 0 {
+		t.Errorf("Expected no trace details but got %v", entry.Data)
+	}
+}
@@ -0,0 +1,109 @@
+// Package tracing sends OpenTelemetry traces to an OTLP collector. It is
+// off unless an endpoint is set with a flag or the standard
+// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT
+// environment variables, which along with the other OTEL_ variables are
+// read by the exporter. Until then spans cost next to nothing.
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/owncast/owncast/config"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/owncast/owncast"
+
+// Setup will start sending traces to the OTLP endpoint, if one is set.
+// Like OTEL_EXPORTER_OTLP_ENDPOINT, the endpoint is the collector's base
+// URL, such as http://localhost:4318. Spans are sent in batches, so the
+// returned function must be called before exiting to send the rest. It is
+// nil if traces are not being sent.
+func Setup(endpoint string) (func(context.Context) error, error) {
+	// Always propagate incoming trace context, so requests that pass
+	// through Owncast stay in the same trace even if we don't export.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if endpoint == "" && os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return nil, nil
+	}
+
+	options := []otlptracehttp.Option{}
+	if endpoint != "" {
+		options = append(options, otlptracehttp.WithEndpointURL(strings.TrimSuffix(endpoint, "/")+"/v1/traces"))
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(context.Background(),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(
+			semconv.ServiceName("owncast"),
+			semconv.ServiceVersion(config.VersionNumber),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	log.AddHook(&logHook{})
+
+	log.Infoln("Sending traces to OpenTelemetry")
+
+	return provider.Shutdown, nil
+}
+
+// Start will begin a span, as a child of any span in the context.
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// StartFrom will begin a span as a child of the span in another context.
+// It is for work done after the parent has returned, such as queued jobs,
+// where the parent's context may have been canceled.
+func StartFrom(parent context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(parent))
+	return Start(ctx, name, attributes...)
+}
+
+// End will end a span, marking it as failed if there was an error.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Inject will add the trace context to an outgoing request so the
+// receiver can continue the trace.
+func Inject(ctx context.Context, req *http.Request) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+}
+
+// Extract will return a context holding the trace context of an incoming
+// request.
+func Extract(req *http.Request) context.Context {
+	return otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+}
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestShutdownSendsBufferedSpans(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+
+	shutdown, err := Setup("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shutdown != nil {
+		t.Error("Expected no shutdown when traces are not being sent")
+	}
+
+	var received atomic.Int32
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/traces" {
+			received.Add(1)
+		}
+	}))
+	defer collector.Close()
+
+	shutdown, err = Setup(collector.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, span := Start(context.Background(), "test")
+	End(span, nil)
+
+	// The span is still waiting to be sent in a batch.
+	if received.Load() != 0 {
+		t.Fatal("Expected the span to be batched")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if received.Load() == 0 {
+		t.Error("Expected the buffered span to be sent on shutdown")
+	}
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"database/sql"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -18,3 +19,10 @@ func MustExec(s string, db *sql.DB) {
 		log.Warnln(err)
 	}
 }
+
+// StoredTimestamp will convert a time to the zone timestamps are stored in.
+// Timestamps are compared as text, so a time in any other zone would match
+// the wrong range.
+func StoredTimestamp(t time.Time) time.Time {
+	return t.In(time.Local)
+}
@@ -149,6 +149,50 @@ func IsUserAgentABot(userAgent string) bool {
 	return ua.Bot()
 }
 
+// GetUserAgentDetails returns the browser, operating system and class of
+// device ("desktop", "mobile", "tablet" or "tv") of a web client user-agent.
+func GetUserAgentDetails(userAgent string) (string, string, string) {
+	ua := user_agent.New(userAgent)
+	browser, _ := ua.Browser()
+	os := ua.OSInfo().Name
+
+	lower := strings.ToLower(userAgent)
+	if strings.Contains(lower, "iphone") || strings.Contains(lower, "ipad") || strings.Contains(lower, "ipod") {
+		os = "iOS"
+	}
+
+	deviceClass := "desktop"
+	switch {
+	case strings.Contains(lower, "smart-tv") || strings.Contains(lower, "smarttv") || strings.Contains(lower, "appletv") || strings.Contains(lower, "roku") || strings.Contains(lower, "crkey"):
+		deviceClass = "tv"
+	case strings.Contains(lower, "ipad") || strings.Contains(lower, "tablet") || (strings.Contains(lower, "android") && !strings.Contains(lower, "mobile")):
+		deviceClass = "tablet"
+	case ua.Mobile():
+		deviceClass = "mobile"
+	}
+
+	return browser, os, deviceClass
+}
+
+// GetReferrerHost returns the host name of a referring page, or an empty
+// string if there isn't one or it is one of our own pages.
+func GetReferrerHost(referrer string, ownHost string) string {
+	u, err := url.Parse(referrer)
+	if err != nil || u.Hostname() == "" {
+		return ""
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if ownHost != "" {
+		own, _, _ := strings.Cut(strings.ToLower(ownHost), ":")
+		if host == strings.TrimPrefix(own, "www.") {
+			return ""
+		}
+	}
+
+	return host
+}
+
 // RenderSimpleMarkdown will return HTML without sanitization or specific formatting rules.
 func RenderSimpleMarkdown(raw string) string {
 	markdown := goldmark.New(
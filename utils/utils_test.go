@@ -26,3 +26,40 @@ func TestPercentageUtilsTest(t *testing.T) {
 		t.Error("Incorrect percentage calculation.")
 	}
 }
+
+func TestGetUserAgentDetails(t *testing.T) {
+	testCases := []struct {
+		userAgent   string
+		browser     string
+		os          string
+		deviceClass string
+	}{
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36", "Chrome", "Windows", "desktop"},
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1", "Safari", "iOS", "mobile"},
+		{"Mozilla/5.0 (iPad; CPU OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1", "Safari", "iOS", "tablet"},
+		{"Mozilla/5.0 (Linux; Android 13; SM-X700) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36", "Chrome", "Android", "tablet"},
+	}
+
+	for _, tc := range testCases {
+		browser, os, deviceClass := GetUserAgentDetails(tc.userAgent)
+		if browser != tc.browser || os != tc.os || deviceClass != tc.deviceClass {
+			t.Errorf("%s: expected %s, %s, %s but got %s, %s, %s", tc.userAgent, tc.browser, tc.os, tc.deviceClass, browser, os, deviceClass)
+		}
+	}
+}
+
+func TestGetReferrerHost(t *testing.T) {
+	testCases := map[string]string{
+		"https://www.example.com/some/page?q=1": "example.com",
+		"https://Mastodon.social/@someone":      "mastodon.social",
+		"https://live.example.org/":             "",
+		"not a url":                             "",
+		"":                                      "",
+	}
+
+	for referrer, expected := range testCases {
+		if host := GetReferrerHost(referrer, "live.example.org:8080"); host != expected {
+			t.Errorf("%q: expected %q but got %q", referrer, expected, host)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+import { Button, Checkbox, InputNumber, Select, Switch, Table, Typography } from 'antd';
+import React, { useContext, useEffect, useState } from 'react';
+import { ServerStatusContext } from '../../utils/server-status-context';
+import { FormStatusIndicator } from './FormStatusIndicator';
+import {
+  NOTIFICATION_CHANNELS,
+  postConfigUpdateToAPI,
+  RESET_TIMEOUT,
+} from '../../utils/config-constants';
+import {
+  createInputStatus,
+  StatusState,
+  STATUS_ERROR,
+  STATUS_SUCCESS,
+} from '../../utils/input-statuses';
+import { AlertRule } from '../../types/config-section';
+
+const { Title } = Typography;
+
+export const ALERT_METRICS = [
+  { value: 'cpu', label: 'CPU utilization (%)' },
+  { value: 'memory', label: 'Memory utilization (%)' },
+  { value: 'disk', label: 'Disk utilization (%)' },
+  { value: 'playbackErrors', label: 'Playback errors' },
+  { value: 'streamHealth', label: 'Stream health (%)' },
+  { value: 'transcoderSpeed', label: 'Transcoder speed (x real time)' },
+  { value: 'ingestBitrateDrop', label: 'Inbound bitrate drop (%)' },
+];
+
+const SEVERITIES = [
+  { value: 'info', label: 'Info' },
+  { value: 'warning', label: 'Warning' },
+  { value: 'critical', label: 'Critical' },
+];
+
+// Browser pushes go to every subscriber, so they can't carry alerts.
+const ALERT_CHANNELS = [
+  { value: '', label: 'Logs only' },
+  { value: 'DISCORD', label: 'Discord' },
+  ...NOTIFICATION_CHANNELS.map(({ channel, title }) => ({ value: channel, label: title })),
+];
+
+const NEW_RULE: AlertRule = {
+  metric: 'cpu',
+  comparison: 'above',
+  threshold: 85,
+  durationSeconds: 120,
+  severity: 'warning',
+  webhooks: false,
+  enabled: true,
+};
+
+// AlertRules is the form for the rules that decide when an alert fires and
+// where it is sent.
+export const AlertRules = () => {
+  const serverStatusData = useContext(ServerStatusContext);
+  const { serverConfig, setFieldInConfigState } = serverStatusData || {};
+
+  const [rules, setRules] = useState<AlertRule[]>([]);
+  const [submitStatus, setSubmitStatus] = useState<StatusState>(null);
+
+  useEffect(() => {
+    setRules(serverConfig?.alertRules || []);
+  }, [serverConfig]);
+
+  const updateRule = (index: number, values: Partial<AlertRule>) => {
+    setRules(rules.map((rule, i) => (i === index ? { ...rule, ...values } : rule)));
+  };
+
+  const save = async () => {
+    await postConfigUpdateToAPI({
+      apiPath: '/alerts/rules',
+      data: { value: rules },
+      onSuccess: () => {
+        setFieldInConfigState({ fieldName: 'alertRules', value: rules, path: '' });
+        setSubmitStatus(createInputStatus(STATUS_SUCCESS, 'Updated.'));
+        setTimeout(() => setSubmitStatus(null), RESET_TIMEOUT);
+      },
+      onError: (message: string) => {
+        setSubmitStatus(createInputStatus(STATUS_ERROR, message));
+        setTimeout(() => setSubmitStatus(null), RESET_TIMEOUT);
+      },
+    });
+  };
+
+  const columns = [
+    {
+      title: 'Enabled',
+      key: 'enabled',
+      render: (_, rule: AlertRule, index: number) => (
+        <Switch checked={rule.enabled} onChange={enabled => updateRule(index, { enabled })} />
+      ),
+    },
+    {
+      title: 'When',
+      key: 'condition',
+      render: (_, rule: AlertRule, index: number) => (
+        <>
+          <Select
+            value={rule.metric}
+            style={{ width: 230 }}
+            onChange={metric => updateRule(index, { metric })}
+            options={ALERT_METRICS}
+          />{' '}
+          <Select
+            value={rule.comparison}
+            onChange={comparison => updateRule(index, { comparison })}
+            options={[
+              { value: 'above', label: 'is above' },
+              { value: 'below', label: 'is below' },
+            ]}
+          />{' '}
+          <InputNumber
+            value={rule.threshold}
+            onChange={threshold => updateRule(index, { threshold: threshold || 0 })}
+          />
+        </>
+      ),
+    },
+    {
+      title: 'For (seconds)',
+      key: 'durationSeconds',
+      render: (_, rule: AlertRule, index: number) => (
+        <InputNumber
+          min={0}
+          step={30}
+          value={rule.durationSeconds}
+          onChange={durationSeconds => updateRule(index, { durationSeconds: durationSeconds || 0 })}
+        />
+      ),
+    },
+    {
+      title: 'Severity',
+      key: 'severity',
+      render: (_, rule: AlertRule, index: number) => (
+        <Select
+          value={rule.severity}
+          onChange={severity => updateRule(index, { severity })}
+          options={SEVERITIES}
+        />
+      ),
+    },
+    {
+      title: 'Send to',
+      key: 'delivery',
+      render: (_, rule: AlertRule, index: number) => (
+        <>
+          <Select
+            value={rule.channel || ''}
+            style={{ width: 150 }}
+            onChange={channel => updateRule(index, { channel })}
+            options={ALERT_CHANNELS}
+          />{' '}
+          <Checkbox
+            checked={rule.webhooks}
+            onChange={e => updateRule(index, { webhooks: e.target.checked })}
+          >
+            Webhooks
+          </Checkbox>
+        </>
+      ),
+    },
+    {
+      title: '',
+      key: 'remove',
+      render: (_, rule: AlertRule, index: number) => (
+        <Button danger onClick={() => setRules(rules.filter((_r, i) => i !== index))}>
+          Remove
+        </Button>
+      ),
+    },
+  ];
+
+  return (
+    <>
+      <Title level={3}>Rules</Title>
+      <p className="description reduced-margins">
+        An alert fires once its condition has held for the whole duration, and resolves when it
+        clears. Alerts are always logged, and can also be sent to a notification channel or to
+        webhooks subscribed to alert events.
+      </p>
+
+      <Table
+        dataSource={rules}
+        columns={columns}
+        size="small"
+        pagination={false}
+        rowKey={(rule, index) => rule.id || `new-${index}`}
+      />
+
+      <div style={{ marginTop: '20px' }}>
+        <Button onClick={() => setRules([...rules, { ...NEW_RULE }])}>Add rule</Button>{' '}
+        <Button type="primary" onClick={save}>
+          Save
+        </Button>
+      </div>
+      <FormStatusIndicator status={submitStatus} />
+    </>
+  );
+};
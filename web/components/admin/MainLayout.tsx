@@ -30,6 +30,10 @@ const HomeOutlined = dynamic(() => import('@ant-design/icons/HomeOutlined'), {
   ssr: false,
 });
 
+const CalendarOutlined = dynamic(() => import('@ant-design/icons/CalendarOutlined'), {
+  ssr: false,
+});
+
 const LineChartOutlined = dynamic(() => import('@ant-design/icons/LineChartOutlined'), {
   ssr: false,
 });
@@ -186,6 +190,22 @@ export const MainLayout: FC<MainLayoutProps> = ({ children }) => {
       label: <Link href="/admin/stream-health">Stream Health</Link>,
       key: '/admin/stream-health',
     },
+    {
+      label: <Link href="/admin/stream-reports">Stream Reports</Link>,
+      key: '/admin/stream-reports',
+    },
+    {
+      label: <Link href="/admin/broadcasts">Broadcast History</Link>,
+      key: '/admin/broadcasts',
+    },
+    {
+      label: <Link href="/admin/viewer-analytics">Viewer Analytics</Link>,
+      key: '/admin/viewer-analytics',
+    },
+    {
+      label: <Link href="/admin/alerts">Alerts</Link>,
+      key: '/admin/alerts',
+    },
     {
       label: <Link href="/admin/logs">Logs</Link>,
       key: '/admin/logs',
@@ -230,6 +250,11 @@ export const MainLayout: FC<MainLayoutProps> = ({ children }) => {
       icon: <LineChartOutlined />,
       key: '/admin/viewer-info',
     },
+    {
+      label: <Link href="/admin/schedule">Schedule</Link>,
+      icon: <CalendarOutlined />,
+      key: '/admin/schedule',
+    },
     !chatDisabled && {
       label: <span>Chat &amp; Users</span>,
       icon: <MessageOutlined />,
@@ -0,0 +1,173 @@
+import { Button, Typography } from 'antd';
+import React, { useState, useContext, useEffect } from 'react';
+import { ServerStatusContext } from '../../../utils/server-status-context';
+import { TextField } from '../TextField';
+import { FormStatusIndicator } from '../FormStatusIndicator';
+import {
+  postConfigUpdateToAPI,
+  RESET_TIMEOUT,
+  NotificationChannelDefinition,
+} from '../../../utils/config-constants';
+import { ToggleSwitch } from '../ToggleSwitch';
+import {
+  createInputStatus,
+  StatusState,
+  STATUS_ERROR,
+  STATUS_SUCCESS,
+} from '../../../utils/input-statuses';
+import { UpdateArgs } from '../../../types/config-section';
+
+const { Title } = Typography;
+
+export type ChannelNotifyProps = {
+  channel: NotificationChannelDefinition;
+};
+
+// ChannelNotify is the settings form for a notification channel that is
+// configured with a set of text fields.
+export const ChannelNotify = ({ channel }: ChannelNotifyProps) => {
+  const serverStatusData = useContext(ServerStatusContext);
+  const { serverConfig, setFieldInConfigState } = serverStatusData || {};
+  const { notifications } = serverConfig || {};
+  const config = notifications?.[channel.configKey];
+
+  const [formDataValues, setFormDataValues] = useState<any>({});
+  const [submitStatus, setSubmitStatus] = useState<StatusState>(null);
+  const [enableSaveButton, setEnableSaveButton] = useState<boolean>(false);
+
+  useEffect(() => {
+    const values = { enabled: false, ...config };
+    channel.fields
+      .filter(field => field.list)
+      .forEach(field => {
+        values[field.fieldName] = (values[field.fieldName] || []).join(', ');
+      });
+    setFormDataValues(values);
+  }, [notifications, config]);
+
+  // update individual values in state
+  const handleFieldChange = ({ fieldName, value }: UpdateArgs) => {
+    setFormDataValues({
+      ...formDataValues,
+      [fieldName]: value,
+    });
+
+    setEnableSaveButton(true);
+  };
+
+  let resetTimer = null;
+  const resetStates = () => {
+    setSubmitStatus(null);
+    resetTimer = null;
+    clearTimeout(resetTimer);
+  };
+
+  const postValue = () => {
+    const value = { ...formDataValues };
+    channel.fields.forEach(field => {
+      if (field.list) {
+        value[field.fieldName] = (value[field.fieldName] || '')
+          .split(',')
+          .map(item => item.trim())
+          .filter(item => item !== '');
+      } else if (field.number) {
+        value[field.fieldName] = Number(value[field.fieldName]) || 0;
+      }
+    });
+    return value;
+  };
+
+  const save = async () => {
+    const value = postValue();
+
+    await postConfigUpdateToAPI({
+      apiPath: `/notifications/${channel.apiPath}`,
+      data: { value },
+      onSuccess: () => {
+        setFieldInConfigState({
+          fieldName: channel.configKey,
+          value,
+          path: 'notifications',
+        });
+        setEnableSaveButton(false);
+        setSubmitStatus(createInputStatus(STATUS_SUCCESS, 'Updated.'));
+        resetTimer = setTimeout(resetStates, RESET_TIMEOUT);
+      },
+      onError: (message: string) => {
+        setSubmitStatus(createInputStatus(STATUS_ERROR, message));
+        resetTimer = setTimeout(resetStates, RESET_TIMEOUT);
+      },
+    });
+  };
+
+  const sendTest = async () => {
+    await postConfigUpdateToAPI({
+      apiPath: '/notifications/test',
+      data: { channel: channel.channel },
+      onSuccess: () => {
+        setSubmitStatus(createInputStatus(STATUS_SUCCESS, 'Test notification sent.'));
+        resetTimer = setTimeout(resetStates, RESET_TIMEOUT);
+      },
+      onError: (message: string) => {
+        setSubmitStatus(createInputStatus(STATUS_ERROR, message));
+        resetTimer = setTimeout(resetStates, RESET_TIMEOUT);
+      },
+    });
+  };
+
+  // toggle switch.
+  const handleSwitchChange = (switchEnabled: boolean) => {
+    handleFieldChange({ fieldName: 'enabled', value: switchEnabled });
+  };
+
+  return (
+    <>
+      <Title>{channel.title}</Title>
+      <p className="description reduced-margins">{channel.description}</p>
+
+      <ToggleSwitch
+        apiPath=""
+        fieldName={`${channel.configKey}Enabled`}
+        label={`Enable ${channel.title}`}
+        checked={formDataValues.enabled}
+        onChange={handleSwitchChange}
+      />
+      <div style={{ display: formDataValues.enabled ? 'block' : 'none' }}>
+        {channel.fields.map(({ list, number, toggle, ...field }) =>
+          toggle ? (
+            <ToggleSwitch
+              key={field.fieldName}
+              apiPath=""
+              fieldName={field.fieldName}
+              label={field.label}
+              tip={field.tip}
+              checked={formDataValues[field.fieldName]}
+              onChange={value => handleFieldChange({ fieldName: field.fieldName, value })}
+            />
+          ) : (
+            <TextField
+              key={field.fieldName}
+              {...field}
+              value={formDataValues[field.fieldName]}
+              onChange={handleFieldChange}
+            />
+          ),
+        )}
+      </div>
+
+      <div style={{ marginLeft: 'auto', marginTop: '20px' }}>
+        <Button onClick={sendTest} style={{ marginRight: '8px' }}>
+          Send test
+        </Button>
+        <Button
+          type="primary"
+          onClick={save}
+          style={{ display: enableSaveButton ? 'inline-block' : 'none' }}
+        >
+          Save
+        </Button>
+      </div>
+      <FormStatusIndicator status={submitStatus} />
+    </>
+  );
+};
@@ -0,0 +1,62 @@
+import { Select, Typography } from 'antd';
+import React, { useContext, useState } from 'react';
+import { ServerStatusContext } from '../../../utils/server-status-context';
+import {
+  NOTIFICATION_CHANNELS,
+  postConfigUpdateToAPI,
+  RESET_TIMEOUT,
+} from '../../../utils/config-constants';
+import { FormStatusIndicator } from '../FormStatusIndicator';
+import {
+  createInputStatus,
+  StatusState,
+  STATUS_ERROR,
+  STATUS_SUCCESS,
+} from '../../../utils/input-statuses';
+
+const { Title } = Typography;
+
+// Browser pushes go to every subscriber, so they can't carry reports.
+const REPORT_CHANNELS = [
+  { value: '', label: "Don't send reports" },
+  { value: 'DISCORD', label: 'Discord' },
+  ...NOTIFICATION_CHANNELS.map(({ channel, title }) => ({ value: channel, label: title })),
+];
+
+// StreamReportChannel is the setting for where end of stream reports are
+// sent.
+export const StreamReportChannel = () => {
+  const serverStatusData = useContext(ServerStatusContext);
+  const { serverConfig, setFieldInConfigState } = serverStatusData || {};
+  const reportChannel = serverConfig?.notifications?.reportChannel || '';
+
+  const [submitStatus, setSubmitStatus] = useState<StatusState>(null);
+
+  const save = async (value: string) => {
+    await postConfigUpdateToAPI({
+      apiPath: '/notifications/reports',
+      data: { value },
+      onSuccess: () => {
+        setFieldInConfigState({ fieldName: 'reportChannel', value, path: 'notifications' });
+        setSubmitStatus(createInputStatus(STATUS_SUCCESS, 'Updated.'));
+        setTimeout(() => setSubmitStatus(null), RESET_TIMEOUT);
+      },
+      onError: (message: string) => {
+        setSubmitStatus(createInputStatus(STATUS_ERROR, message));
+        setTimeout(() => setSubmitStatus(null), RESET_TIMEOUT);
+      },
+    });
+  };
+
+  return (
+    <>
+      <Title>Stream Reports</Title>
+      <p className="description reduced-margins">
+        Get a summary of viewers, chat and stream health each time a stream ends. Only the
+        channel&apos;s own recipients get reports, never its subscribers.
+      </p>
+      <Select value={reportChannel} onChange={save} options={REPORT_CHANNELS} />
+      <FormStatusIndicator status={submitStatus} />
+    </>
+  );
+};
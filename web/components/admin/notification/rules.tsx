@@ -0,0 +1,153 @@
+import { Button, Input, InputNumber, Select, Space, Typography } from 'antd';
+import React, { useContext, useEffect, useState } from 'react';
+import { ServerStatusContext } from '../../../utils/server-status-context';
+import { FormStatusIndicator } from '../FormStatusIndicator';
+import {
+  NOTIFICATION_CHANNELS,
+  postConfigUpdateToAPI,
+  RESET_TIMEOUT,
+} from '../../../utils/config-constants';
+import {
+  createInputStatus,
+  StatusState,
+  STATUS_ERROR,
+  STATUS_SUCCESS,
+} from '../../../utils/input-statuses';
+
+const { Title } = Typography;
+
+export type NotificationRule = {
+  minIntervalMinutes: number;
+  minUptimeMinutes: number;
+  quietHoursStart?: string;
+  quietHoursEnd?: string;
+  timeZone?: string;
+  messageTemplate?: string;
+};
+
+// Channels without a saved rule use these values.
+const DEFAULT_RULE: NotificationRule = { minIntervalMinutes: 10, minUptimeMinutes: 2 };
+
+const RULE_CHANNELS = [
+  { channel: 'FEDIVERSE', title: 'Fediverse' },
+  { channel: 'BROWSER_PUSH_NOTIFICATION', title: 'Browser' },
+  { channel: 'DISCORD', title: 'Discord' },
+  ...NOTIFICATION_CHANNELS.map(({ channel, title }) => ({ channel, title })),
+];
+
+// NotificationRules is the form for the per-channel rules that decide when
+// and how going live is announced.
+export const NotificationRules = () => {
+  const serverStatusData = useContext(ServerStatusContext);
+  const { serverConfig, setFieldInConfigState } = serverStatusData || {};
+  const savedRules: Record<string, NotificationRule> = serverConfig?.notifications?.rules || {};
+
+  const [channel, setChannel] = useState(RULE_CHANNELS[0].channel);
+  const [rule, setRule] = useState<NotificationRule>(DEFAULT_RULE);
+  const [submitStatus, setSubmitStatus] = useState<StatusState>(null);
+
+  useEffect(() => {
+    setRule({ ...DEFAULT_RULE, ...savedRules[channel] });
+  }, [channel, serverConfig]);
+
+  const updateRule = (values: Partial<NotificationRule>) => {
+    setRule({ ...rule, ...values });
+  };
+
+  let resetTimer = null;
+  const resetStates = () => {
+    setSubmitStatus(null);
+    resetTimer = null;
+    clearTimeout(resetTimer);
+  };
+
+  const save = async () => {
+    const value = { ...savedRules, [channel]: rule };
+
+    await postConfigUpdateToAPI({
+      apiPath: '/notifications/rules',
+      data: { value },
+      onSuccess: () => {
+        setFieldInConfigState({ fieldName: 'rules', value, path: 'notifications' });
+        setSubmitStatus(createInputStatus(STATUS_SUCCESS, 'Updated.'));
+        resetTimer = setTimeout(resetStates, RESET_TIMEOUT);
+      },
+      onError: (message: string) => {
+        setSubmitStatus(createInputStatus(STATUS_ERROR, message));
+        resetTimer = setTimeout(resetStates, RESET_TIMEOUT);
+      },
+    });
+  };
+
+  return (
+    <>
+      <Title>Rules</Title>
+      <p className="description reduced-margins">
+        Decide when each channel announces that you&apos;ve gone live. A message template replaces
+        the channel&apos;s go live text and can use {'{{.StreamTitle}}'}, {'{{.ServerName}}'},{' '}
+        {'{{.ServerURL}}'} and {'{{.Summary}}'}.
+      </p>
+
+      <Space direction="vertical" style={{ width: '100%' }}>
+        <Select
+          value={channel}
+          onChange={setChannel}
+          options={RULE_CHANNELS.map(c => ({ value: c.channel, label: c.title }))}
+        />
+        <span>
+          Only notify once the stream has been up for{' '}
+          <InputNumber
+            min={0}
+            value={rule.minUptimeMinutes}
+            onChange={value => updateRule({ minUptimeMinutes: value || 0 })}
+          />{' '}
+          minutes
+        </span>
+        <span>
+          Wait at least{' '}
+          <InputNumber
+            min={0}
+            value={rule.minIntervalMinutes}
+            onChange={value => updateRule({ minIntervalMinutes: value || 0 })}
+          />{' '}
+          minutes between notifications
+        </span>
+        <span>
+          Quiet hours from{' '}
+          <Input
+            type="time"
+            style={{ width: 'auto' }}
+            value={rule.quietHoursStart || ''}
+            onChange={e => updateRule({ quietHoursStart: e.target.value })}
+          />{' '}
+          to{' '}
+          <Input
+            type="time"
+            style={{ width: 'auto' }}
+            value={rule.quietHoursEnd || ''}
+            onChange={e => updateRule({ quietHoursEnd: e.target.value })}
+          />
+        </span>
+        <Input
+          value={rule.timeZone || ''}
+          placeholder="Time zone, e.g. Europe/Berlin (defaults to the server's)"
+          onChange={e => updateRule({ timeZone: e.target.value.trim() })}
+        />
+        <Input.TextArea
+          value={rule.messageTemplate || ''}
+          placeholder="{{.StreamTitle}} is live at {{.ServerURL}}"
+          rows={3}
+          maxLength={1000}
+          onChange={e => updateRule({ messageTemplate: e.target.value })}
+        />
+      </Space>
+
+      <div style={{ marginLeft: 'auto', marginTop: '20px' }}>
+        <Button type="primary" onClick={save}>
+          Save
+        </Button>
+      </div>
+      <FormStatusIndicator status={submitStatus} />
+    </>
+  );
+};
@@ -1,4 +1,4 @@
-import { Row, Spin, Typography, Button } from 'antd';
+import { Row, Spin, Typography, Button, Input } from 'antd';
 import React, { FC, useState } from 'react';
 import UploadOutlined from '@ant-design/icons/lib/icons/UploadOutlined';
 import PlusSquareOutlined from '@ant-design/icons/lib/icons/PlusSquareOutlined';
@@ -8,6 +8,7 @@ import { accessTokenAtom, clientConfigStateAtom } from '../../stores/ClientConfi
 import {
   registerWebPushNotifications,
   saveNotificationRegistration,
+  subscribeToEmailNotifications,
 } from '../../../services/notifications-service';
 import styles from './BrowserNotifyModal.module.scss';
 import { ComponentError } from '../../ui/ComponentError/ComponentError';
@@ -110,7 +111,41 @@ const NotificationsDenied = () => (
   </div>
 );
 
-export const BrowserNotifyModal = () => {
+const EmailSubscribe = () => {
+  const accessToken = useRecoilValue(accessTokenAtom);
+  const [email, setEmail] = useState('');
+  const [pending, setPending] = useState(false);
+  const [result, setResult] = useState<string>(null);
+
+  const subscribe = async () => {
+    setPending(true);
+    try {
+      const { message } = await subscribeToEmailNotifications(email, accessToken);
+      setResult(message);
+    } catch (e) {
+      setResult(`Unable to subscribe: ${e.message}`);
+    }
+    setPending(false);
+  };
+
+  return (
+    <div className={styles.email}>
+      <Title level={3}>Get notified by email</Title>
+      <Input.Search
+        type="email"
+        placeholder="you@example.com"
+        enterButton="Subscribe"
+        value={email}
+        loading={pending}
+        onChange={e => setEmail(e.target.value)}
+        onSearch={subscribe}
+      />
+      <Row>{result}</Row>
+    </div>
+  );
+};
+
+const BrowserPushNotify = () => {
   const [error, setError] = useState<string>(null);
   const accessToken = useRecoilValue(accessTokenAtom);
   const config = useRecoilValue(clientConfigStateAtom);
@@ -195,3 +230,15 @@ export const BrowserNotifyModal = () => {
     </ErrorBoundary>
   );
 };
+
+export const BrowserNotifyModal = () => {
+  const config = useRecoilValue(clientConfigStateAtom);
+  const emailEnabled = config.notifications.email?.enabled;
+
+  return (
+    <>
+      <BrowserPushNotify />
+      {emailEnabled && <EmailSubscribe />}
+    </>
+  );
+};
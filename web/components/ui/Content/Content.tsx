@@ -127,6 +127,7 @@ export const Content: FC = () => {
   const { account: fediverseAccount, enabled: fediverseEnabled } = federation;
   const { browser: browserNotifications } = notifications;
   const { enabled: browserNotificationsEnabled } = browserNotifications;
+  const emailNotificationsEnabled = notifications.email?.enabled;
   const { online: isStreamLive } = serverStatus;
   const [externalActionToDisplay, setExternalActionToDisplay] = useState<ExternalAction>(null);
   const [currentBrowserWindowUrl, setCurrentBrowserWindowUrl] = useState('');
@@ -209,9 +210,10 @@ export const Content: FC = () => {
     // isPushNotificationSupported relies on `navigator` so that needs to be
     // fired from this useEffect.
     setSupportsBrowserNotifications(
-      canPushNotificationsBeSupported() && browserNotificationsEnabled,
+      (canPushNotificationsBeSupported() && browserNotificationsEnabled) ||
+        emailNotificationsEnabled,
     );
-  }, [browserNotificationsEnabled]);
+  }, [browserNotificationsEnabled, emailNotificationsEnabled]);
 
   useEffect(() => {
     setCurrentBrowserWindowUrl(window.location.href);
@@ -3,7 +3,8 @@ const INTERVAL = 4000;
 
 function ping() {
   try {
-    fetch(URL);
+    // The referring page is only used for anonymous viewer analytics.
+    fetch(`${URL}?referrer=${encodeURIComponent(document.referrer)}`);
   } catch (e) {
     console.error(e);
   }
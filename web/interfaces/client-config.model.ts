@@ -31,6 +31,11 @@ interface Federation {
 
 interface Notifications {
   browser: Browser;
+  email?: EmailNotifications;
+}
+
+interface EmailNotifications {
+  enabled: boolean;
 }
 
 interface Browser {
@@ -69,6 +74,9 @@ export function makeEmptyClientConfig(): ClientConfig {
         enabled: false,
         publicKey: '',
       },
+      email: {
+        enabled: false,
+      },
     },
     authentication: {
       indieAuthEnabled: false,
@@ -44,6 +44,16 @@ const availableScopes = {
     description: 'Can send chat messages on behalf of the owner of this token.',
     color: 'green',
   },
+  CAN_SEND_DIRECT_MESSAGES: {
+    name: 'Direct messages',
+    description: 'Can send private messages to chat users on behalf of the owner of this token.',
+    color: 'blue',
+  },
+  CAN_MANAGE_POLLS: {
+    name: 'Polls',
+    description: 'Can start and end chat polls.',
+    color: 'lime',
+  },
   HAS_ADMIN_ACCESS: {
     name: 'Has admin access',
     description: 'Can perform administrative actions such as moderation, get server statuses, etc.',
@@ -0,0 +1,108 @@
+import { Table, Tag, Typography } from 'antd';
+import { format } from 'date-fns';
+import React, { ReactElement, useEffect, useState } from 'react';
+import { ALERTS, fetchData } from '../../utils/apis';
+
+import { AdminLayout } from '../../components/layouts/AdminLayout';
+import { ALERT_METRICS, AlertRules } from '../../components/admin/AlertRules';
+
+const { Title, Paragraph } = Typography;
+
+const PAGE_SIZE = 50;
+
+const SEVERITY_COLORS = { info: 'blue', warning: 'orange', critical: 'red' };
+
+type Alert = {
+  id: string;
+  ruleId: string;
+  metric: string;
+  comparison: string;
+  severity: string;
+  value: number;
+  threshold: number;
+  firedAt: string;
+  resolvedAt?: string;
+};
+
+const Alerts = () => {
+  const [alerts, setAlerts] = useState<Alert[]>([]);
+  const [totalCount, setTotalCount] = useState<number>(0);
+  const [currentPage, setCurrentPage] = useState<number>(1);
+  const [error, setError] = useState<string>(null);
+
+  useEffect(() => {
+    const offset = (currentPage - 1) * PAGE_SIZE;
+    fetchData(`${ALERTS}?offset=${offset}&limit=${PAGE_SIZE}`)
+      .then(({ results, total }) => {
+        setAlerts(results || []);
+        setTotalCount(total);
+      })
+      .catch(e => setError(e.message));
+  }, [currentPage]);
+
+  const columns = [
+    {
+      title: 'Fired',
+      dataIndex: 'firedAt',
+      key: 'firedAt',
+      render: (firedAt: string) => format(new Date(firedAt), 'PPpp'),
+    },
+    {
+      title: 'Severity',
+      dataIndex: 'severity',
+      key: 'severity',
+      render: (severity: string) => <Tag color={SEVERITY_COLORS[severity]}>{severity}</Tag>,
+    },
+    {
+      title: 'Alert',
+      key: 'alert',
+      render: (_, record: Alert) => {
+        const metric = ALERT_METRICS.find(m => m.value === record.metric)?.label || record.metric;
+        return `${metric} was ${record.value.toFixed(2)}, ${record.comparison} ${record.threshold}`;
+      },
+    },
+    {
+      title: 'Resolved',
+      dataIndex: 'resolvedAt',
+      key: 'resolvedAt',
+      render: (resolvedAt?: string) =>
+        resolvedAt ? format(new Date(resolvedAt), 'PPpp') : <Tag color="red">Active</Tag>,
+    },
+  ];
+
+  return (
+    <div>
+      <Title>Alerts</Title>
+      <Paragraph>
+        Get told when your server or stream needs attention, such as when the transcoder can&apos;t
+        keep up or viewers are having trouble playing your stream.
+      </Paragraph>
+
+      <AlertRules />
+
+      <Title level={3} style={{ marginTop: '2em' }}>
+        History
+      </Title>
+      {error && <Paragraph type="danger">{error}</Paragraph>}
+      <Table
+        rowKey={record => record.id}
+        columns={columns}
+        dataSource={alerts}
+        size="small"
+        pagination={{
+          pageSize: PAGE_SIZE,
+          hideOnSinglePage: true,
+          showSizeChanger: false,
+          total: totalCount,
+        }}
+        onChange={pagination => setCurrentPage(pagination.current)}
+      />
+    </div>
+  );
+};
+
+Alerts.getLayout = function getLayout(page: ReactElement) {
+  return <AdminLayout page={page} />;
+};
+
+export default Alerts;
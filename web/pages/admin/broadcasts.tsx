@@ -0,0 +1,170 @@
+import { Table, Typography } from 'antd';
+import Link from 'next/link';
+import { format, formatDuration, intervalToDuration } from 'date-fns';
+import React, { ReactElement, useEffect, useState } from 'react';
+import { BROADCASTS, BROADCAST_VIEWERS_OVER_TIME, fetchData } from '../../utils/apis';
+
+import { AdminLayout } from '../../components/layouts/AdminLayout';
+import { Chart } from '../../components/admin/Chart';
+
+const { Title, Paragraph } = Typography;
+
+const PAGE_SIZE = 50;
+
+type Broadcast = {
+  id: string;
+  startedAt: string;
+  endedAt?: string;
+  title?: string;
+  tags: string[];
+  outputSettings: { name?: string; videoBitrate?: number; framerate?: number }[];
+  latencyLevel: number;
+  broadcaster?: {
+    streamDetails?: { encoder?: string; width?: number; height?: number };
+  };
+  peakViewerCount: number;
+  averageViewerCount: number;
+  chatMessageCount: number;
+  chatterCount: number;
+};
+
+// BroadcastViewers is the viewer count over time of a single broadcast,
+// loaded when its row is expanded.
+const BroadcastViewers = ({ id }: { id: string }) => {
+  const [viewers, setViewers] = useState([]);
+
+  useEffect(() => {
+    fetchData(`${BROADCAST_VIEWERS_OVER_TIME}?id=${id}`)
+      .then(setViewers)
+      .catch(() => setViewers([]));
+  }, [id]);
+
+  return (
+    <>
+      {viewers.length > 0 ? (
+        <Chart
+          title="Viewers"
+          data={viewers}
+          color="#2087E2"
+          unit="viewers"
+          minYValue={0}
+          yStepSize={1}
+        />
+      ) : (
+        <Paragraph>No viewer data was collected during this broadcast.</Paragraph>
+      )}
+      <Link href={`/admin/viewer-analytics?broadcastId=${id}`}>
+        Where these viewers came from and how long they watched
+      </Link>
+    </>
+  );
+};
+
+const Broadcasts = () => {
+  const [broadcasts, setBroadcasts] = useState<Broadcast[]>([]);
+  const [totalCount, setTotalCount] = useState<number>(0);
+  const [currentPage, setCurrentPage] = useState<number>(1);
+  const [error, setError] = useState<string>(null);
+
+  useEffect(() => {
+    const offset = (currentPage - 1) * PAGE_SIZE;
+    fetchData(`${BROADCASTS}?offset=${offset}&limit=${PAGE_SIZE}`)
+      .then(({ results, total }) => {
+        setBroadcasts(results || []);
+        setTotalCount(total);
+      })
+      .catch(e => setError(e.message));
+  }, [currentPage]);
+
+  const columns = [
+    {
+      title: 'Started',
+      dataIndex: 'startedAt',
+      key: 'startedAt',
+      render: (startedAt: string) => format(new Date(startedAt), 'PPpp'),
+    },
+    {
+      title: 'Title',
+      key: 'title',
+      render: (_, record: Broadcast) => (
+        <>
+          <div>{record.title}</div>
+          {record.tags.length > 0 && <div>{record.tags.map(tag => `#${tag}`).join(' ')}</div>}
+        </>
+      ),
+    },
+    {
+      title: 'Duration',
+      key: 'duration',
+      render: (_, record: Broadcast) =>
+        record.endedAt
+          ? formatDuration(
+              intervalToDuration({
+                start: new Date(record.startedAt),
+                end: new Date(record.endedAt),
+              }),
+              { format: ['hours', 'minutes'] },
+            ) || 'Less than a minute'
+          : 'Live',
+    },
+    {
+      title: 'Viewers',
+      key: 'viewers',
+      render: (_, record: Broadcast) =>
+        `${record.peakViewerCount} peak, ${record.averageViewerCount.toFixed(1)} average`,
+    },
+    {
+      title: 'Chat',
+      key: 'chat',
+      render: (_, record: Broadcast) =>
+        `${record.chatMessageCount} messages from ${record.chatterCount} chatters`,
+    },
+    {
+      title: 'Output',
+      key: 'output',
+      render: (_, record: Broadcast) => (
+        <>
+          {record.outputSettings.map(variant => (
+            <div key={variant.name}>
+              {variant.name}: {variant.videoBitrate} kbps at {variant.framerate} fps
+            </div>
+          ))}
+          {record.broadcaster?.streamDetails?.encoder && (
+            <div>Encoder: {record.broadcaster.streamDetails.encoder}</div>
+          )}
+        </>
+      ),
+    },
+  ];
+
+  return (
+    <div>
+      <Title>Broadcast History</Title>
+      <Paragraph>
+        Every time you go live is recorded here. Expand a broadcast to see its viewers over time.
+      </Paragraph>
+
+      {error && <Paragraph type="danger">{error}</Paragraph>}
+
+      <Table
+        rowKey={record => record.id}
+        columns={columns}
+        dataSource={broadcasts}
+        expandable={{ expandedRowRender: record => <BroadcastViewers id={record.id} /> }}
+        pagination={{
+          pageSize: PAGE_SIZE,
+          hideOnSinglePage: true,
+          showSizeChanger: false,
+          total: totalCount,
+        }}
+        onChange={pagination => setCurrentPage(pagination.current)}
+      />
+    </div>
+  );
+};
+
+Broadcasts.getLayout = function getLayout(page: ReactElement) {
+  return <AdminLayout page={page} />;
+};
+
+export default Broadcasts;
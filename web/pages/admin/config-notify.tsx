@@ -5,11 +5,17 @@ import Link from 'next/link';
 import { DiscordNotify as Discord } from '../../components/admin/notification/discord';
 import { BrowserNotify as Browser } from '../../components/admin/notification/browser';
 import { FediverseNotify as Federation } from '../../components/admin/notification/federation';
+import { ChannelNotify } from '../../components/admin/notification/channel';
+import { NotificationRules } from '../../components/admin/notification/rules';
+import { StreamReportChannel } from '../../components/admin/notification/reports';
 import {
   TextFieldWithSubmit,
   TEXTFIELD_TYPE_URL,
 } from '../../components/admin/TextFieldWithSubmit';
-import { TEXTFIELD_PROPS_FEDERATION_INSTANCE_URL } from '../../utils/config-constants';
+import {
+  NOTIFICATION_CHANNELS,
+  TEXTFIELD_PROPS_FEDERATION_INSTANCE_URL,
+} from '../../utils/config-constants';
 import { ServerStatusContext } from '../../utils/server-status-context';
 import { UpdateArgs } from '../../types/config-section';
 import { isValidUrl } from '../../utils/validators';
@@ -115,6 +121,33 @@ export default function ConfigNotify() {
           <Federation />
         </Col>
 
+        {NOTIFICATION_CHANNELS.map(channel => (
+          <Col
+            key={channel.channel}
+            span={10}
+            className={`form-module ${enabled ? '' : 'disabled'}`}
+            style={{ margin: '5px', display: 'flex', flexDirection: 'column' }}
+          >
+            <ChannelNotify channel={channel} />
+          </Col>
+        ))}
+
+        <Col
+          span={10}
+          className={`form-module ${enabled ? '' : 'disabled'}`}
+          style={{ margin: '5px', display: 'flex', flexDirection: 'column' }}
+        >
+          <NotificationRules />
+        </Col>
+
+        <Col
+          span={10}
+          className={`form-module ${enabled ? '' : 'disabled'}`}
+          style={{ margin: '5px', display: 'flex', flexDirection: 'column' }}
+        >
+          <StreamReportChannel />
+        </Col>
+
         <Col
           span={10}
           className={`form-module ${enabled ? '' : 'disabled'}`}
@@ -0,0 +1,240 @@
+import { Button, Input, InputNumber, Modal, Space, Table, Typography } from 'antd';
+import dynamic from 'next/dynamic';
+import { format } from 'date-fns';
+import React, { ReactElement, useContext, useEffect, useState } from 'react';
+import {
+  CREATE_SCHEDULED_STREAM,
+  DELETE_SCHEDULED_STREAM,
+  fetchData,
+  SCHEDULED_STREAMS,
+  SET_SCHEDULE_REMINDER_MINUTES,
+  UPDATE_SCHEDULED_STREAM,
+} from '../../utils/apis';
+import { ServerStatusContext } from '../../utils/server-status-context';
+
+import { AdminLayout } from '../../components/layouts/AdminLayout';
+
+const { Title, Paragraph } = Typography;
+
+// Lazy loaded components
+
+const DeleteOutlined = dynamic(() => import('@ant-design/icons/DeleteOutlined'), {
+  ssr: false,
+});
+
+const EditOutlined = dynamic(() => import('@ant-design/icons/EditOutlined'), {
+  ssr: false,
+});
+
+type ScheduledStream = {
+  id: string;
+  title: string;
+  description?: string;
+  thumbnail?: string;
+  startTime: string;
+  reminderSentAt?: string;
+};
+
+// datetime-local inputs use local time without a timezone.
+const toLocalInputValue = (date: Date) => format(date, "yyyy-MM-dd'T'HH:mm");
+
+interface ScheduledStreamModalProps {
+  stream: ScheduledStream | null;
+  open: boolean;
+  onCancel: () => void;
+  onOk: (values: Omit<ScheduledStream, 'id'>) => void;
+}
+
+const ScheduledStreamModal = ({ stream, open, onCancel, onOk }: ScheduledStreamModalProps) => {
+  const [title, setTitle] = useState('');
+  const [description, setDescription] = useState('');
+  const [thumbnail, setThumbnail] = useState('');
+  const [startTime, setStartTime] = useState('');
+
+  useEffect(() => {
+    setTitle(stream?.title || '');
+    setDescription(stream?.description || '');
+    setThumbnail(stream?.thumbnail || '');
+    setStartTime(stream ? toLocalInputValue(new Date(stream.startTime)) : '');
+  }, [stream, open]);
+
+  const save = () => {
+    onOk({ title, description, thumbnail, startTime: new Date(startTime).toISOString() });
+  };
+
+  return (
+    <Modal
+      title={stream ? 'Edit scheduled stream' : 'Schedule a stream'}
+      open={open}
+      onOk={save}
+      onCancel={onCancel}
+      okButtonProps={{ disabled: !title.trim() || !startTime }}
+    >
+      <Space direction="vertical" style={{ width: '100%' }}>
+        <Input value={title} placeholder="Title" onChange={e => setTitle(e.target.value)} />
+        <Input.TextArea
+          value={description}
+          placeholder="Description (optional)"
+          rows={4}
+          onChange={e => setDescription(e.target.value)}
+        />
+        <Input
+          value={thumbnail}
+          placeholder="Thumbnail image URL (optional)"
+          onChange={e => setThumbnail(e.target.value)}
+        />
+        <Input
+          type="datetime-local"
+          value={startTime}
+          onChange={e => setStartTime(e.target.value)}
+        />
+      </Space>
+    </Modal>
+  );
+};
+
+const Schedule = () => {
+  const serverStatusData = useContext(ServerStatusContext);
+  const { serverConfig, setFieldInConfigState } = serverStatusData || {};
+
+  const [streams, setStreams] = useState<ScheduledStream[]>([]);
+  const [editingStream, setEditingStream] = useState<ScheduledStream>(null);
+  const [isModalOpen, setIsModalOpen] = useState(false);
+  const [reminderMinutes, setReminderMinutes] = useState<number>(null);
+  const [error, setError] = useState<string>(null);
+
+  useEffect(() => {
+    setReminderMinutes(serverConfig?.scheduleReminderMinutes ?? 15);
+  }, [serverConfig]);
+
+  async function getStreams() {
+    try {
+      const result = await fetchData(SCHEDULED_STREAMS);
+      setStreams(result);
+    } catch (e) {
+      setError(e.message);
+    }
+  }
+
+  useEffect(() => {
+    getStreams();
+  }, []);
+
+  async function handleSave(values: Omit<ScheduledStream, 'id'>) {
+    try {
+      if (editingStream) {
+        await fetchData(UPDATE_SCHEDULED_STREAM, {
+          method: 'POST',
+          data: { id: editingStream.id, ...values },
+        });
+      } else {
+        await fetchData(CREATE_SCHEDULED_STREAM, { method: 'POST', data: values });
+      }
+      setError(null);
+      setIsModalOpen(false);
+      getStreams();
+    } catch (e) {
+      setError(e.message);
+    }
+  }
+
+  async function handleDelete(id: string) {
+    try {
+      await fetchData(DELETE_SCHEDULED_STREAM, { method: 'POST', data: { id } });
+      getStreams();
+    } catch (e) {
+      setError(e.message);
+    }
+  }
+
+  async function saveReminderMinutes() {
+    try {
+      await fetchData(SET_SCHEDULE_REMINDER_MINUTES, {
+        method: 'POST',
+        data: { value: reminderMinutes || 0 },
+      });
+      setFieldInConfigState({ fieldName: 'scheduleReminderMinutes', value: reminderMinutes });
+      setError(null);
+    } catch (e) {
+      setError(e.message);
+    }
+  }
+
+  const openModal = (stream: ScheduledStream) => {
+    setEditingStream(stream);
+    setIsModalOpen(true);
+  };
+
+  const columns = [
+    {
+      title: '',
+      key: 'actions',
+      render: (_, record: ScheduledStream) => (
+        <Space size="middle">
+          <Button onClick={() => openModal(record)} icon={<EditOutlined />} />
+          <Button onClick={() => handleDelete(record.id)} icon={<DeleteOutlined />} />
+        </Space>
+      ),
+    },
+    {
+      title: 'Starts',
+      dataIndex: 'startTime',
+      key: 'startTime',
+      render: (startTime: string) => format(new Date(startTime), 'PPpp'),
+    },
+    {
+      title: 'Title',
+      dataIndex: 'title',
+      key: 'title',
+    },
+    {
+      title: 'Reminder',
+      dataIndex: 'reminderSentAt',
+      key: 'reminderSentAt',
+      render: (reminderSentAt: string) =>
+        reminderSentAt ? `Sent ${format(new Date(reminderSentAt), 'PPpp')}` : 'Not sent',
+    },
+  ];
+
+  return (
+    <div>
+      <Title>Schedule</Title>
+      <Paragraph>
+        Let people know when you&apos;ll be live next. Upcoming streams are shared with your
+        followers, listed in a calendar feed at <a href="/api/schedule.ics">/api/schedule.ics</a>,
+        and a reminder is sent through your notification channels before each one starts.
+      </Paragraph>
+
+      <Paragraph>
+        Send reminders{' '}
+        <InputNumber min={0} value={reminderMinutes} onChange={value => setReminderMinutes(value)} />{' '}
+        minutes before a stream starts. <Button onClick={saveReminderMinutes}>Save</Button>
+      </Paragraph>
+
+      {error && <Paragraph type="danger">{error}</Paragraph>}
+
+      <Table
+        rowKey={record => record.id}
+        columns={columns}
+        dataSource={streams}
+        pagination={false}
+      />
+      <br />
+      <Button type="primary" onClick={() => openModal(null)}>
+        Schedule a stream
+      </Button>
+      <ScheduledStreamModal
+        stream={editingStream}
+        open={isModalOpen}
+        onOk={handleSave}
+        onCancel={() => setIsModalOpen(false)}
+      />
+    </div>
+  );
+};
+
+Schedule.getLayout = function getLayout(page: ReactElement) {
+  return <AdminLayout page={page} />;
+};
+
+export default Schedule;
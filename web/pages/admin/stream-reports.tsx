@@ -0,0 +1,148 @@
+import { Button, Table, Typography } from 'antd';
+import dynamic from 'next/dynamic';
+import { format, formatDuration, intervalToDuration } from 'date-fns';
+import React, { ReactElement, useEffect, useState } from 'react';
+import { DELETE_STREAM_REPORT, fetchData, STREAM_REPORTS } from '../../utils/apis';
+
+import { AdminLayout } from '../../components/layouts/AdminLayout';
+
+const { Title, Paragraph } = Typography;
+
+// Lazy loaded components
+
+const DeleteOutlined = dynamic(() => import('@ant-design/icons/DeleteOutlined'), {
+  ssr: false,
+});
+
+type StreamReport = {
+  id: string;
+  startedAt: string;
+  endedAt: string;
+  streamTitle?: string;
+  durationSeconds: number;
+  peakViewerCount: number;
+  averageViewerCount: number;
+  chatMessageCount: number;
+  newFollowerCount: number;
+  encoder: {
+    name?: string;
+    width: number;
+    height: number;
+    framerate: number;
+    videoBitrate: number;
+    averageCpuUsage: number;
+    healthyPercentage: number;
+    healthMessage?: string;
+  };
+};
+
+const StreamReports = () => {
+  const [reports, setReports] = useState<StreamReport[]>([]);
+  const [error, setError] = useState<string>(null);
+
+  async function getReports() {
+    try {
+      const result = await fetchData(STREAM_REPORTS);
+      setReports(result);
+    } catch (e) {
+      setError(e.message);
+    }
+  }
+
+  useEffect(() => {
+    getReports();
+  }, []);
+
+  async function handleDelete(id: string) {
+    try {
+      await fetchData(DELETE_STREAM_REPORT, { method: 'POST', data: { id } });
+      getReports();
+    } catch (e) {
+      setError(e.message);
+    }
+  }
+
+  const columns = [
+    {
+      title: '',
+      key: 'delete',
+      render: (_, record: StreamReport) => (
+        <Button onClick={() => handleDelete(record.id)} icon={<DeleteOutlined />} />
+      ),
+    },
+    {
+      title: 'Started',
+      dataIndex: 'startedAt',
+      key: 'startedAt',
+      render: (startedAt: string) => format(new Date(startedAt), 'PPpp'),
+    },
+    {
+      title: 'Title',
+      dataIndex: 'streamTitle',
+      key: 'streamTitle',
+    },
+    {
+      title: 'Duration',
+      dataIndex: 'durationSeconds',
+      key: 'durationSeconds',
+      render: (seconds: number) =>
+        formatDuration(intervalToDuration({ start: 0, end: seconds * 1000 }), {
+          format: ['hours', 'minutes'],
+        }) || 'Less than a minute',
+    },
+    {
+      title: 'Viewers',
+      key: 'viewers',
+      render: (_, record: StreamReport) =>
+        `${record.peakViewerCount} peak, ${record.averageViewerCount.toFixed(1)} average`,
+    },
+    {
+      title: 'Chat messages',
+      dataIndex: 'chatMessageCount',
+      key: 'chatMessageCount',
+    },
+    {
+      title: 'New followers',
+      dataIndex: 'newFollowerCount',
+      key: 'newFollowerCount',
+    },
+    {
+      title: 'Encoder',
+      key: 'encoder',
+      render: (_, { encoder }: StreamReport) => (
+        <>
+          {encoder.width > 0 && (
+            <div>
+              {encoder.name || 'Unknown encoder'}, {encoder.width}x{encoder.height} at{' '}
+              {encoder.framerate} fps, {encoder.videoBitrate} kbps
+            </div>
+          )}
+          <div>Average CPU usage {Math.round(encoder.averageCpuUsage)}%</div>
+          {encoder.healthyPercentage > 0 && (
+            <div title={encoder.healthMessage}>Stream health {encoder.healthyPercentage}%</div>
+          )}
+        </>
+      ),
+    },
+  ];
+
+  return (
+    <div>
+      <Title>Stream Reports</Title>
+      <Paragraph>
+        A report is saved each time a stream ends. Choose a notification channel to send them to
+        on the Notifications page.
+      </Paragraph>
+
+      {error && <Paragraph type="danger">{error}</Paragraph>}
+
+      <Table rowKey={record => record.id} columns={columns} dataSource={reports} />
+    </div>
+  );
+};
+
+StreamReports.getLayout = function getLayout(page: ReactElement) {
+  return <AdminLayout page={page} />;
+};
+
+export default StreamReports;
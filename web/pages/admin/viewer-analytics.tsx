@@ -0,0 +1,172 @@
+import { Col, Input, Row, Select, Space, Statistic, Table, Typography } from 'antd';
+import { formatDuration, intervalToDuration } from 'date-fns';
+import { useRouter } from 'next/router';
+import React, { ReactElement, useEffect, useState } from 'react';
+import { BROADCASTS, fetchData, VIEWER_ANALYTICS } from '../../utils/apis';
+
+import { AdminLayout } from '../../components/layouts/AdminLayout';
+
+const { Title, Paragraph } = Typography;
+
+const BROADCAST_OPTIONS = 100;
+
+type AnalyticsCount = {
+  name: string;
+  count: number;
+};
+
+type ViewerAnalyticsData = {
+  uniqueViewers: number;
+  totalWatchSeconds: number;
+  averageWatchSeconds: number;
+  watchTime: AnalyticsCount[];
+  countries: AnalyticsCount[];
+  regions: AnalyticsCount[];
+  browsers: AnalyticsCount[];
+  operatingSystems: AnalyticsCount[];
+  deviceClasses: AnalyticsCount[];
+  referrers: AnalyticsCount[];
+};
+
+type BroadcastOption = {
+  id: string;
+  startedAt: string;
+  title?: string;
+};
+
+const BREAKDOWNS: { key: keyof ViewerAnalyticsData; title: string }[] = [
+  { key: 'watchTime', title: 'Watch time' },
+  { key: 'countries', title: 'Countries' },
+  { key: 'regions', title: 'Regions' },
+  { key: 'deviceClasses', title: 'Devices' },
+  { key: 'browsers', title: 'Browsers' },
+  { key: 'operatingSystems', title: 'Operating systems' },
+  { key: 'referrers', title: 'Referrers' },
+];
+
+function formatWatchTime(seconds: number): string {
+  return (
+    formatDuration(intervalToDuration({ start: 0, end: Math.round(seconds) * 1000 }), {
+      format: ['hours', 'minutes'],
+    }) || 'Less than a minute'
+  );
+}
+
+const ViewerAnalytics = () => {
+  const router = useRouter();
+  const [broadcasts, setBroadcasts] = useState<BroadcastOption[]>([]);
+  const [broadcastId, setBroadcastId] = useState<string>('');
+  const [since, setSince] = useState<string>('');
+  const [until, setUntil] = useState<string>('');
+  const [analytics, setAnalytics] = useState<ViewerAnalyticsData>(null);
+  const [error, setError] = useState<string>(null);
+
+  useEffect(() => {
+    // Only the most recent broadcasts are offered to choose from.
+    fetchData(`${BROADCASTS}?limit=${BROADCAST_OPTIONS}`)
+      .then(({ results }) => setBroadcasts(results || []))
+      .catch(e => setError(e.message));
+  }, []);
+
+  useEffect(() => {
+    if (typeof router.query.broadcastId === 'string') {
+      setBroadcastId(router.query.broadcastId);
+    }
+  }, [router.query.broadcastId]);
+
+  useEffect(() => {
+    const params = new URLSearchParams();
+    if (broadcastId) {
+      params.set('broadcastId', broadcastId);
+    }
+    if (since) {
+      params.set('since', new Date(`${since}T00:00:00`).toISOString());
+    }
+    if (until) {
+      params.set('until', new Date(`${until}T23:59:59`).toISOString());
+    }
+
+    fetchData(`${VIEWER_ANALYTICS}?${params.toString()}`)
+      .then(setAnalytics)
+      .catch(e => setError(e.message));
+  }, [broadcastId, since, until]);
+
+  const countColumns = [
+    { title: '', dataIndex: 'name', key: 'name' },
+    { title: 'Viewers', dataIndex: 'count', key: 'count' },
+  ];
+
+  return (
+    <div>
+      <Title>Viewer Analytics</Title>
+      <Paragraph>
+        Where your viewers came from, what they watched on and for how long. Viewers are recorded
+        anonymously, without their IP address or any other details that could identify them.
+      </Paragraph>
+
+      {error && <Paragraph type="danger">{error}</Paragraph>}
+
+      <Space wrap style={{ marginBottom: '20px' }}>
+        <Select
+          style={{ minWidth: 300 }}
+          value={broadcastId}
+          onChange={setBroadcastId}
+          options={[
+            { value: '', label: 'All broadcasts' },
+            ...broadcasts.map(b => ({
+              value: b.id,
+              label: `${new Date(b.startedAt).toLocaleString()} ${b.title || ''}`,
+            })),
+          ]}
+        />
+        <span>
+          From <Input type="date" value={since} onChange={e => setSince(e.target.value)} /> to{' '}
+          <Input type="date" value={until} onChange={e => setUntil(e.target.value)} />
+        </span>
+      </Space>
+
+      {analytics && (
+        <>
+          <Row gutter={[16, 16]}>
+            <Col span={8}>
+              <Statistic title="Unique viewers" value={analytics.uniqueViewers} />
+            </Col>
+            <Col span={8}>
+              <Statistic
+                title="Average watch time"
+                value={formatWatchTime(analytics.averageWatchSeconds)}
+              />
+            </Col>
+            <Col span={8}>
+              <Statistic
+                title="Total watch time"
+                value={formatWatchTime(analytics.totalWatchSeconds)}
+              />
+            </Col>
+          </Row>
+
+          <Row gutter={[16, 16]}>
+            {BREAKDOWNS.map(({ key, title }) => (
+              <Col key={key} xs={24} md={12} xl={8}>
+                <Title level={4}>{title}</Title>
+                <Table
+                  size="small"
+                  rowKey={record => record.name}
+                  columns={countColumns}
+                  dataSource={analytics[key] as AnalyticsCount[]}
+                  pagination={{ pageSize: 10, hideOnSinglePage: true }}
+                />
+              </Col>
+            ))}
+          </Row>
+        </>
+      )}
+    </div>
+  );
+};
+
+ViewerAnalytics.getLayout = function getLayout(page: ReactElement) {
+  return <AdminLayout page={page} />;
+};
+
+export default ViewerAnalytics;
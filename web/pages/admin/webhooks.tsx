@@ -3,6 +3,7 @@ import {
   Button,
   Checkbox,
   Col,
+  Collapse,
   Input,
   Modal,
   Row,
@@ -14,7 +15,15 @@ import {
 } from 'antd';
 import dynamic from 'next/dynamic';
 import React, { ReactElement, useEffect, useState } from 'react';
-import { CREATE_WEBHOOK, DELETE_WEBHOOK, fetchData, WEBHOOKS } from '../../utils/apis';
+import {
+  CREATE_WEBHOOK,
+  DELETE_WEBHOOK,
+  fetchData,
+  REPLAY_WEBHOOK_DELIVERY,
+  TEST_WEBHOOK,
+  WEBHOOK_DELIVERIES,
+  WEBHOOKS,
+} from '../../utils/apis';
 import { isValidUrl, DEFAULT_TEXTFIELD_URL_PATTERN } from '../../utils/validators';
 
 import { AdminLayout } from '../../components/layouts/AdminLayout';
@@ -41,6 +50,27 @@ const availableEvents = {
     description: 'When a message visibility changes, likely due to moderation',
     color: 'red',
   },
+  MESSAGE_REACTION_ADDED: {
+    name: 'Message reaction added',
+    description: 'When a user reacts to a chat message',
+    color: 'magenta',
+  },
+  MESSAGE_REACTION_REMOVED: {
+    name: 'Message reaction removed',
+    description: 'When a user removes their reaction to a chat message',
+    color: 'magenta',
+  },
+  MESSAGE_EDITED: {
+    name: 'Message edited',
+    description: 'When a user edits their chat message',
+    color: 'geekblue',
+  },
+  POLL_STARTED: { name: 'Poll started', description: 'When a chat poll starts', color: 'lime' },
+  POLL_ENDED: {
+    name: 'Poll ended',
+    description: 'When a chat poll ends, with its results',
+    color: 'lime',
+  },
   STREAM_STARTED: { name: 'Stream started', description: 'When a stream starts', color: 'orange' },
   STREAM_STOPPED: { name: 'Stream stopped', description: 'When a stream stops', color: 'cyan' },
   STREAM_TITLE_UPDATED: {
@@ -48,6 +78,16 @@ const availableEvents = {
     description: 'When a stream title is changed',
     color: 'yellow',
   },
+  ALERT_FIRED: {
+    name: 'Alert fired',
+    description: 'When an alert rule has been met for its duration',
+    color: 'volcano',
+  },
+  ALERT_RESOLVED: {
+    name: 'Alert resolved',
+    description: 'When a fired alert is no longer met',
+    color: 'volcano',
+  },
 };
 
 function convertEventStringToTag(eventString: string) {
@@ -74,6 +114,11 @@ const NewWebhookModal = (props: Props) => {
 
   const [selectedEvents, setSelectedEvents] = useState([]);
   const [webhookUrl, setWebhookUrl] = useState('');
+  const [isChatBot, setIsChatBot] = useState(false);
+  const [accessToken, setAccessToken] = useState('');
+  const [template, setTemplate] = useState('');
+  const [contentType, setContentType] = useState('');
+  const [filter, setFilter] = useState('');
 
   const events = Object.keys(availableEvents).map(key => ({
     value: key,
@@ -89,15 +134,25 @@ const NewWebhookModal = (props: Props) => {
   }
 
   function save() {
-    onOk(webhookUrl, selectedEvents);
+    onOk(webhookUrl, selectedEvents, isChatBot ? accessToken : null, {
+      template,
+      contentType,
+      filter,
+    });
 
     // Reset the modal
     setWebhookUrl('');
     setSelectedEvents(null);
+    setIsChatBot(false);
+    setAccessToken('');
+    setTemplate('');
+    setContentType('');
+    setFilter('');
   }
 
   const okButtonProps = {
-    disabled: selectedEvents?.length === 0 || !isValidUrl(webhookUrl),
+    disabled:
+      selectedEvents?.length === 0 || !isValidUrl(webhookUrl) || (isChatBot && !accessToken),
   };
 
   const checkboxes = events.map(singleEvent => (
@@ -124,6 +179,21 @@ const NewWebhookModal = (props: Props) => {
         />
       </div>
 
+      <p>
+        <Checkbox checked={isChatBot} onChange={e => setIsChatBot(e.target.checked)}>
+          Chat bot: respond to webhooks with chat actions
+        </Checkbox>
+      </p>
+      {isChatBot && (
+        <div>
+          <Input
+            value={accessToken}
+            placeholder="Access token the bot acts as"
+            onChange={input => setAccessToken(input.currentTarget.value.trim())}
+          />
+        </div>
+      )}
+
       <p>Select the events that will be sent to this webhook.</p>
       <Checkbox.Group style={{ width: '100%' }} value={selectedEvents} onChange={onChange}>
         <Row>{checkboxes}</Row>
@@ -133,6 +203,114 @@ const NewWebhookModal = (props: Props) => {
           Select all
         </Button>
       </p>
+
+      <Collapse ghost>
+        <Collapse.Panel header="Payload template and filter" key="payload">
+          <p>
+            Optionally replace the event JSON with a Go template, e.g.{' '}
+            <code>{'{"text": {{json .eventData.rawBody}}}'}</code>.
+          </p>
+          <Input.TextArea
+            value={template}
+            placeholder="Payload template"
+            onChange={e => setTemplate(e.target.value)}
+            autoSize={{ minRows: 3 }}
+          />
+          <Input
+            value={contentType}
+            placeholder="Content type, e.g. text/plain"
+            onChange={e => setContentType(e.target.value.trim())}
+          />
+          <p>
+            Only send events when a template renders &quot;true&quot;, e.g.{' '}
+            <code>{'{{isModerator .eventData.user}}'}</code>.
+          </p>
+          <Input value={filter} placeholder="Filter" onChange={e => setFilter(e.target.value)} />
+        </Collapse.Panel>
+      </Collapse>
+    </Modal>
+  );
+};
+
+interface DeliveriesModalProps {
+  webhook: any;
+  onClose: () => void;
+}
+
+const DeliveriesModal = ({ webhook, onClose }: DeliveriesModalProps) => {
+  const [deliveries, setDeliveries] = useState([]);
+
+  async function getDeliveries() {
+    if (!webhook) {
+      return;
+    }
+    try {
+      const result = await fetchData(`${WEBHOOK_DELIVERIES}?webhookId=${webhook.id}&limit=100`);
+      setDeliveries(result.results);
+    } catch (error) {
+      console.error('error', error);
+    }
+  }
+
+  useEffect(() => {
+    getDeliveries();
+  }, [webhook]);
+
+  async function replay(id: number) {
+    try {
+      await fetchData(REPLAY_WEBHOOK_DELIVERY, { method: 'POST', data: { id } });
+      setTimeout(getDeliveries, 1000);
+    } catch (error) {
+      console.error('error', error);
+    }
+  }
+
+  const columns = [
+    {
+      title: 'Sent',
+      dataIndex: 'createdAt',
+      key: 'createdAt',
+      render: createdAt => new Date(createdAt).toLocaleString(),
+    },
+    {
+      title: 'Event',
+      dataIndex: 'eventType',
+      key: 'eventType',
+      render: eventType => convertEventStringToTag(eventType),
+    },
+    {
+      title: 'Status',
+      key: 'status',
+      render: (_, record) => (
+        <Tooltip title={record.error}>
+          <Tag color={record.success ? 'green' : 'red'}>{record.statusCode || 'No response'}</Tag>
+        </Tooltip>
+      ),
+    },
+    { title: 'Attempts', dataIndex: 'attempts', key: 'attempts' },
+    {
+      title: 'Latency',
+      dataIndex: 'latencyMs',
+      key: 'latencyMs',
+      render: latency => `${latency}ms`,
+    },
+    {
+      title: '',
+      key: 'replay',
+      render: (_, record) =>
+        !record.success && <Button onClick={() => replay(record.id)}>Replay</Button>,
+    },
+  ];
+
+  return (
+    <Modal
+      title={`Deliveries to ${webhook?.url}`}
+      open={!!webhook}
+      onCancel={onClose}
+      footer={null}
+      width={900}
+    >
+      <Table rowKey={record => record.id} columns={columns} dataSource={deliveries} />
     </Modal>
   );
 };
@@ -140,6 +318,7 @@ const NewWebhookModal = (props: Props) => {
 const Webhooks = () => {
   const [webhooks, setWebhooks] = useState([]);
   const [isModalOpen, setIsModalOpen] = useState(false);
+  const [deliveriesWebhook, setDeliveriesWebhook] = useState(null);
 
   function handleError(error) {
     console.error('error', error);
@@ -167,11 +346,13 @@ const Webhooks = () => {
     }
   }
 
-  async function handleSave(url: string, events: string[]) {
+  async function handleSave(url: string, events: string[], accessToken: string, payloadOptions) {
+    const chatBotOptions = accessToken ? { type: 'CHAT_BOT', accessToken } : {};
+    const data = { url, events, ...chatBotOptions, ...payloadOptions };
     try {
       const newHook = await fetchData(CREATE_WEBHOOK, {
         method: 'POST',
-        data: { url, events },
+        data,
       });
       setWebhooks(webhooks.concat(newHook));
     } catch (error) {
@@ -183,9 +364,27 @@ const Webhooks = () => {
     setIsModalOpen(true);
   };
 
-  const handleModalSaveButton = (url, events) => {
+  async function handleTest(id) {
+    try {
+      const result = await fetchData(TEST_WEBHOOK, { method: 'POST', data: { id } });
+      Modal.info({
+        title: result.sent ? 'Test event sent' : 'Test event filtered out',
+        width: 700,
+        content: (
+          <>
+            <Paragraph>Content type: {result.contentType}</Paragraph>
+            <pre style={{ whiteSpace: 'pre-wrap' }}>{result.body}</pre>
+          </>
+        ),
+      });
+    } catch (error) {
+      handleError(error);
+    }
+  }
+
+  const handleModalSaveButton = (url, events, accessToken, payloadOptions) => {
     setIsModalOpen(false);
-    handleSave(url, events);
+    handleSave(url, events, accessToken, payloadOptions);
   };
 
   const handleModalCancelButton = () => {
@@ -199,6 +398,8 @@ const Webhooks = () => {
       render: (_, record) => (
         <Space size="middle">
           <Button onClick={() => handleDelete(record.id)} icon={<DeleteOutlined />} />
+          <Button onClick={() => setDeliveriesWebhook(record)}>Deliveries</Button>
+          <Button onClick={() => handleTest(record.id)}>Test</Button>
         </Space>
       ),
     },
@@ -206,6 +407,11 @@ const Webhooks = () => {
       title: 'URL',
       dataIndex: 'url',
       key: 'url',
+      render: (url, record) => (
+        <>
+          {url} {record.type === 'CHAT_BOT' && <Tag color="gold">Chat bot</Tag>}
+        </>
+      ),
     },
     {
       title: 'Events',
@@ -222,6 +428,13 @@ const Webhooks = () => {
         </>
       ),
     },
+    {
+      title: 'Signing secret',
+      dataIndex: 'secret',
+      key: 'secret',
+      render: secret =>
+        secret && <Typography.Text copyable={{ text: secret }}>••••••</Typography.Text>,
+    },
   ];
 
   return (
@@ -259,6 +472,7 @@ const Webhooks = () => {
         onOk={handleModalSaveButton}
         onCancel={handleModalCancelButton}
       />
+      <DeliveriesModal webhook={deliveriesWebhook} onClose={() => setDeliveriesWebhook(null)} />
     </div>
   );
 };
@@ -13,6 +13,22 @@ export async function saveNotificationRegistration(channel, destination, accessT
   await fetch(`${URL_REGISTER_NOTIFICATION}?accessToken=${accessToken}`, options);
 }
 
+export async function subscribeToEmailNotifications(email: string, accessToken: string) {
+  const options = {
+    method: 'POST',
+    headers: {
+      'Content-Type': 'application/json',
+    },
+    body: JSON.stringify({ email }),
+  };
+
+  const response = await fetch(
+    `/api/notifications/email/subscribe?accessToken=${accessToken}`,
+    options,
+  );
+  return response.json();
+}
+
 function urlBase64ToUint8Array(base64String: string) {
   const padding = '='.repeat((4 - (base64String.length % 4)) % 4);
   const base64 = (base64String + padding).replace(/-/g, '+').replace(/_/g, '/');
@@ -119,6 +119,26 @@ export interface DiscordNotification {
 export interface NotificationsConfig {
   browser: BrowserNotification;
   discord: DiscordNotification;
+  matrix?: Record<string, any>;
+  telegram?: Record<string, any>;
+  ntfy?: Record<string, any>;
+  gotify?: Record<string, any>;
+  slack?: Record<string, any>;
+  email?: Record<string, any>;
+  rules?: Record<string, Record<string, any>>;
+  reportChannel?: string;
+}
+
+export interface AlertRule {
+  id?: string;
+  metric: string;
+  comparison: 'above' | 'below';
+  threshold: number;
+  durationSeconds: number;
+  severity: 'info' | 'warning' | 'critical';
+  channel?: string;
+  webhooks: boolean;
+  enabled: boolean;
 }
 
 export interface Health {
@@ -156,8 +176,10 @@ export interface ConfigDetails {
   chatSlurFilterEnabled: boolean;
   federation: Federation;
   notifications: NotificationsConfig;
+  alertRules?: AlertRule[];
   chatJoinMessagesEnabled: boolean;
   chatEstablishedUserMode: boolean;
   hideViewerCount: boolean;
   disableSearchIndexing: boolean;
+  scheduleReminderMinutes: number;
 }
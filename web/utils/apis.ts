@@ -84,6 +84,48 @@ export const DELETE_WEBHOOK = `${API_LOCATION}webhooks/delete`;
 // Create a single webhook
 export const CREATE_WEBHOOK = `${API_LOCATION}webhooks/create`;
 
+// Get the delivery log of a webhook
+export const WEBHOOK_DELIVERIES = `${API_LOCATION}webhooks/deliveries`;
+
+// Send a logged webhook delivery again
+export const REPLAY_WEBHOOK_DELIVERY = `${API_LOCATION}webhooks/deliveries/replay`;
+
+// Send a webhook an example event
+export const TEST_WEBHOOK = `${API_LOCATION}webhooks/test`;
+
+// Get scheduled streams
+export const SCHEDULED_STREAMS = `${API_LOCATION}schedule`;
+
+// Schedule an upcoming stream
+export const CREATE_SCHEDULED_STREAM = `${API_LOCATION}schedule/create`;
+
+// Update a scheduled stream
+export const UPDATE_SCHEDULED_STREAM = `${API_LOCATION}schedule/update`;
+
+// Delete a scheduled stream
+export const DELETE_SCHEDULED_STREAM = `${API_LOCATION}schedule/delete`;
+
+// Set when scheduled stream reminders are sent
+export const SET_SCHEDULE_REMINDER_MINUTES = `${API_LOCATION}config/schedule/reminder`;
+
+// Get end of stream reports
+export const STREAM_REPORTS = `${API_LOCATION}reports`;
+
+// Delete an end of stream report
+export const DELETE_STREAM_REPORT = `${API_LOCATION}reports/delete`;
+
+// Get the broadcast history
+export const BROADCASTS = `${API_LOCATION}broadcasts`;
+
+// Get the viewers over time of a single broadcast
+export const BROADCAST_VIEWERS_OVER_TIME = `${API_LOCATION}broadcasts/viewersOverTime`;
+
+// Get an anonymous breakdown of viewers
+export const VIEWER_ANALYTICS = `${API_LOCATION}analytics/viewers`;
+
+// Get the history of fired alerts
+export const ALERTS = `${API_LOCATION}alerts`;
+
 // hard coded social icons list
 export const SOCIAL_PLATFORMS_LIST = `${NEXT_PUBLIC_API_HOST}api/socialplatforms`;
 
@@ -53,6 +53,7 @@ const API_FEDERATION_SHOW_ENGAGEMENT = '/federation/showengagement';
 export const API_FEDERATION_BLOCKED_DOMAINS = '/federation/blockdomains';
 
 const TEXTFIELD_TYPE_URL = 'url';
+const TEXTFIELD_TYPE_PASSWORD = 'password';
 
 export async function postConfigUpdateToAPI(args: ApiPostArgs) {
   const { apiPath, data, onSuccess, onError } = args;
@@ -593,6 +594,188 @@ export const DISCORD_CONFIG_FIELDS = {
   },
 };
 
+export type NotificationChannelField = {
+  fieldName: string;
+  label: string;
+  placeholder?: string;
+  tip?: string;
+  type?: string;
+  maxLength?: number;
+  useTrim?: boolean;
+  // list fields are edited as comma separated text.
+  list?: boolean;
+  number?: boolean;
+  // toggle fields are edited with a switch.
+  toggle?: boolean;
+};
+
+export type NotificationChannelDefinition = {
+  // channel is the name used to send test notifications.
+  channel: string;
+  configKey: string;
+  apiPath: string;
+  title: string;
+  description: string;
+  fields: NotificationChannelField[];
+};
+
+const GO_LIVE_MESSAGE_FIELD: NotificationChannelField = {
+  fieldName: 'goLiveMessage',
+  label: 'Go Live Text',
+  maxLength: 300,
+  tip: 'The text to send when you go live.',
+  placeholder: `I've gone live! Come watch!`,
+};
+
+export const NOTIFICATION_CHANNELS: NotificationChannelDefinition[] = [
+  {
+    channel: 'MATRIX',
+    configKey: 'matrix',
+    apiPath: 'matrix',
+    title: 'Matrix',
+    description: 'Post to a Matrix room each time you go live.',
+    fields: [
+      {
+        fieldName: 'homeserver',
+        label: 'Homeserver',
+        placeholder: 'https://matrix.org',
+        type: TEXTFIELD_TYPE_URL,
+        useTrim: true,
+      },
+      {
+        fieldName: 'accessToken',
+        label: 'Access Token',
+        tip: 'The access token of the account that posts to the room.',
+        type: TEXTFIELD_TYPE_PASSWORD,
+        useTrim: true,
+      },
+      { fieldName: 'roomId', label: 'Room ID', placeholder: '!abc123:matrix.org', useTrim: true },
+      GO_LIVE_MESSAGE_FIELD,
+    ],
+  },
+  {
+    channel: 'TELEGRAM',
+    configKey: 'telegram',
+    apiPath: 'telegram',
+    title: 'Telegram',
+    description: 'Have a Telegram bot post to a chat or channel each time you go live.',
+    fields: [
+      {
+        fieldName: 'botToken',
+        label: 'Bot Token',
+        tip: 'The token BotFather gave you.',
+        type: TEXTFIELD_TYPE_PASSWORD,
+        useTrim: true,
+      },
+      { fieldName: 'chatId', label: 'Chat ID', placeholder: '@mychannel', useTrim: true },
+      GO_LIVE_MESSAGE_FIELD,
+    ],
+  },
+  {
+    channel: 'NTFY',
+    configKey: 'ntfy',
+    apiPath: 'ntfy',
+    title: 'ntfy',
+    description: 'Publish to an ntfy topic each time you go live.',
+    fields: [
+      {
+        fieldName: 'serverUrl',
+        label: 'Server URL',
+        placeholder: 'https://ntfy.sh',
+        type: TEXTFIELD_TYPE_URL,
+        useTrim: true,
+      },
+      { fieldName: 'topic', label: 'Topic', useTrim: true },
+      {
+        fieldName: 'accessToken',
+        label: 'Access Token',
+        tip: 'Only needed for protected topics.',
+        type: TEXTFIELD_TYPE_PASSWORD,
+        useTrim: true,
+      },
+      GO_LIVE_MESSAGE_FIELD,
+    ],
+  },
+  {
+    channel: 'GOTIFY',
+    configKey: 'gotify',
+    apiPath: 'gotify',
+    title: 'Gotify',
+    description: 'Push through a Gotify application each time you go live.',
+    fields: [
+      {
+        fieldName: 'serverUrl',
+        label: 'Server URL',
+        type: TEXTFIELD_TYPE_URL,
+        useTrim: true,
+      },
+      {
+        fieldName: 'appToken',
+        label: 'Application Token',
+        type: TEXTFIELD_TYPE_PASSWORD,
+        useTrim: true,
+      },
+      GO_LIVE_MESSAGE_FIELD,
+    ],
+  },
+  {
+    channel: 'SLACK',
+    configKey: 'slack',
+    apiPath: 'slack',
+    title: 'Slack',
+    description: 'Let your Slack channel know each time you go live.',
+    fields: [
+      {
+        fieldName: 'webhook',
+        label: 'Webhook URL',
+        placeholder: 'https://hooks.slack.com/services/T000/B000/XXXX',
+        tip: 'An incoming webhook for your channel.',
+        type: TEXTFIELD_TYPE_URL,
+        useTrim: true,
+      },
+      GO_LIVE_MESSAGE_FIELD,
+    ],
+  },
+  {
+    channel: 'EMAIL',
+    configKey: 'email',
+    apiPath: 'email',
+    title: 'Email',
+    description: 'Email a list of people over SMTP each time you go live.',
+    fields: [
+      { fieldName: 'smtpHost', label: 'SMTP Host', placeholder: 'smtp.example.com', useTrim: true },
+      {
+        fieldName: 'smtpPort',
+        label: 'SMTP Port',
+        placeholder: '587',
+        tip: 'Port 465 uses TLS, other ports use STARTTLS when offered.',
+        number: true,
+      },
+      { fieldName: 'username', label: 'Username', useTrim: true },
+      { fieldName: 'password', label: 'Password', type: TEXTFIELD_TYPE_PASSWORD },
+      {
+        fieldName: 'fromAddress',
+        label: 'From Address',
+        placeholder: 'live@example.com',
+        useTrim: true,
+      },
+      {
+        fieldName: 'recipients',
+        label: 'Recipients',
+        tip: 'Comma separated email addresses.',
+        list: true,
+      },
+      {
+        fieldName: 'allowSubscriptions',
+        label: 'Allow viewers to subscribe',
+        tip: 'Viewers can sign up by email. They confirm their address and can unsubscribe from every email.',
+        toggle: true,
+      },
+      GO_LIVE_MESSAGE_FIELD,
+    ],
+  },
+];
+
 export const BROWSER_PUSH_CONFIG_FIELDS = {
   goLiveMessage: {
     fieldName: 'goLiveMessage',
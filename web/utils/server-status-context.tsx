@@ -75,6 +75,7 @@ const initialServerConfigState: ConfigDetails = {
   chatEstablishedUserMode: false,
   hideViewerCount: false,
   disableSearchIndexing: false,
+  scheduleReminderMinutes: 15,
 };
 
 const initialServerStatusState = {
@@ -73,6 +73,134 @@ func (*ServerInterfaceImpl) GetChatMessagesAdminOptions(w http.ResponseWriter, r
 	middleware.RequireAdminAuth(admin.GetChatMessages)(w, r)
 }
 
+func (*ServerInterfaceImpl) GetAlerts(w http.ResponseWriter, r *http.Request, params generated.GetAlertsParams) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetAlerts))(w, r)
+}
+
+func (*ServerInterfaceImpl) GetAlertsOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetAlerts))(w, r)
+}
+
+func (*ServerInterfaceImpl) SetAlertRules(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetAlertRules)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetAlertRulesOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetAlertRules)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetBroadcasts(w http.ResponseWriter, r *http.Request, params generated.GetBroadcastsParams) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetBroadcasts))(w, r)
+}
+
+func (*ServerInterfaceImpl) GetBroadcastsOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetBroadcasts))(w, r)
+}
+
+func (*ServerInterfaceImpl) GetBroadcastViewersOverTime(w http.ResponseWriter, r *http.Request, params generated.GetBroadcastViewersOverTimeParams) {
+	middleware.RequireAdminAuth(admin.GetBroadcastViewersOverTime)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetBroadcastViewersOverTimeOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetBroadcastViewersOverTime)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetViewerAnalytics(w http.ResponseWriter, r *http.Request, params generated.GetViewerAnalyticsParams) {
+	middleware.RequireAdminAuth(admin.GetViewerAnalytics)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetViewerAnalyticsOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetViewerAnalytics)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetStreamReports(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetStreamReports)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetStreamReportsOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetStreamReports)(w, r)
+}
+
+func (*ServerInterfaceImpl) DeleteStreamReport(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.DeleteStreamReport)(w, r)
+}
+
+func (*ServerInterfaceImpl) DeleteStreamReportOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.DeleteStreamReport)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetChatArchives(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetChatArchives)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetChatArchivesOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetChatArchives)(w, r)
+}
+
+func (*ServerInterfaceImpl) DownloadChatArchive(w http.ResponseWriter, r *http.Request, params generated.DownloadChatArchiveParams) {
+	middleware.RequireAdminAuth(admin.DownloadChatArchive)(w, r)
+}
+
+func (*ServerInterfaceImpl) DownloadChatArchiveOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.DownloadChatArchive)(w, r)
+}
+
+func (*ServerInterfaceImpl) DeleteChatArchive(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.DeleteChatArchive)(w, r)
+}
+
+func (*ServerInterfaceImpl) DeleteChatArchiveOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.DeleteChatArchive)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetPollsAdmin(w http.ResponseWriter, r *http.Request, params generated.GetPollsAdminParams) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetPolls))(w, r)
+}
+
+func (*ServerInterfaceImpl) GetPollsAdminOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetPolls))(w, r)
+}
+
+func (*ServerInterfaceImpl) StartPollAdmin(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.StartPoll)(w, r)
+}
+
+func (*ServerInterfaceImpl) StartPollAdminOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.StartPoll)(w, r)
+}
+
+func (*ServerInterfaceImpl) EndPollAdmin(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.EndPoll)(w, r)
+}
+
+func (*ServerInterfaceImpl) EndPollAdminOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.EndPoll)(w, r)
+}
+
+func (*ServerInterfaceImpl) SearchChatMessagesAdmin(w http.ResponseWriter, r *http.Request, params generated.SearchChatMessagesAdminParams) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.SearchChatMessages))(w, r)
+}
+
+func (*ServerInterfaceImpl) SearchChatMessagesAdminOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.SearchChatMessages))(w, r)
+}
+
+func (*ServerInterfaceImpl) GetHeldMessagesAdmin(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetHeldMessages)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetHeldMessagesAdminOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetHeldMessages)(w, r)
+}
+
+func (*ServerInterfaceImpl) ReviewHeldMessageAdmin(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.ReviewHeldMessage)(w, r)
+}
+
+func (*ServerInterfaceImpl) ReviewHeldMessageAdminOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.ReviewHeldMessage)(w, r)
+}
+
 func (*ServerInterfaceImpl) UpdateMessageVisibilityAdmin(w http.ResponseWriter, r *http.Request) {
 	middleware.RequireAdminAuth(admin.UpdateMessageVisibility)(w, r)
 }
@@ -81,6 +209,54 @@ func (*ServerInterfaceImpl) UpdateMessageVisibilityAdminOptions(w http.ResponseW
 	middleware.RequireAdminAuth(admin.UpdateMessageVisibility)(w, r)
 }
 
+func (*ServerInterfaceImpl) UpdateChatModesAdmin(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.UpdateChatModes)(w, r)
+}
+
+func (*ServerInterfaceImpl) UpdateChatModesAdminOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.UpdateChatModes)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetChatFilterRules(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetChatFilterRules)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetChatFilterRulesOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetChatFilterRules)(w, r)
+}
+
+func (*ServerInterfaceImpl) CreateChatFilterRule(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.CreateChatFilterRule)(w, r)
+}
+
+func (*ServerInterfaceImpl) CreateChatFilterRuleOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.CreateChatFilterRule)(w, r)
+}
+
+func (*ServerInterfaceImpl) UpdateChatFilterRule(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.UpdateChatFilterRule)(w, r)
+}
+
+func (*ServerInterfaceImpl) UpdateChatFilterRuleOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.UpdateChatFilterRule)(w, r)
+}
+
+func (*ServerInterfaceImpl) DeleteChatFilterRule(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.DeleteChatFilterRule)(w, r)
+}
+
+func (*ServerInterfaceImpl) DeleteChatFilterRuleOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.DeleteChatFilterRule)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetModerationActions(w http.ResponseWriter, r *http.Request, params generated.GetModerationActionsParams) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetModerationActions))(w, r)
+}
+
+func (*ServerInterfaceImpl) GetModerationActionsOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetModerationActions))(w, r)
+}
+
 func (*ServerInterfaceImpl) UpdateUserEnabledAdmin(w http.ResponseWriter, r *http.Request) {
 	middleware.RequireAdminAuth(admin.UpdateUserEnabled)(w, r)
 }
@@ -89,6 +265,22 @@ func (*ServerInterfaceImpl) UpdateUserEnabledAdminOptions(w http.ResponseWriter,
 	middleware.RequireAdminAuth(admin.UpdateUserEnabled)(w, r)
 }
 
+func (*ServerInterfaceImpl) UpdateUserTimeoutAdmin(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.UpdateUserTimeout)(w, r)
+}
+
+func (*ServerInterfaceImpl) UpdateUserTimeoutAdminOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.UpdateUserTimeout)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetUserTimeouts(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetUserTimeouts)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetUserTimeoutsOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetUserTimeouts)(w, r)
+}
+
 func (*ServerInterfaceImpl) GetDisabledUsers(w http.ResponseWriter, r *http.Request) {
 	middleware.RequireAdminAuth(admin.GetDisabledUsers)(w, r)
 }
@@ -217,6 +409,38 @@ func (*ServerInterfaceImpl) DeleteWebhookOptions(w http.ResponseWriter, r *http.
 	middleware.RequireAdminAuth(admin.DeleteWebhook)(w, r)
 }
 
+func (*ServerInterfaceImpl) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, params generated.GetWebhookDeliveriesParams) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetWebhookDeliveries))(w, r)
+}
+
+func (*ServerInterfaceImpl) GetWebhookDeliveriesOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(middleware.HandlePagination(admin.GetWebhookDeliveries))(w, r)
+}
+
+func (*ServerInterfaceImpl) ReplayWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.ReplayWebhookDelivery)(w, r)
+}
+
+func (*ServerInterfaceImpl) ReplayWebhookDeliveryOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.ReplayWebhookDelivery)(w, r)
+}
+
+func (*ServerInterfaceImpl) UpdateWebhookPayload(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.UpdateWebhookPayload)(w, r)
+}
+
+func (*ServerInterfaceImpl) UpdateWebhookPayloadOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.UpdateWebhookPayload)(w, r)
+}
+
+func (*ServerInterfaceImpl) SendWebhookTestEvent(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SendWebhookTestEvent)(w, r)
+}
+
+func (*ServerInterfaceImpl) SendWebhookTestEventOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SendWebhookTestEvent)(w, r)
+}
+
 func (*ServerInterfaceImpl) CreateWebhook(w http.ResponseWriter, r *http.Request) {
 	middleware.RequireAdminAuth(admin.CreateWebhook)(w, r)
 }
@@ -310,3 +534,35 @@ func DisconnectInboundConnection(w http.ResponseWriter, r *http.Request) {
 	rtmp.Disconnect()
 	w.WriteHeader(http.StatusOK)
 }
+
+func (*ServerInterfaceImpl) GetScheduledStreamsAdmin(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetScheduledStreams)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetScheduledStreamsAdminOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.GetScheduledStreams)(w, r)
+}
+
+func (*ServerInterfaceImpl) CreateScheduledStream(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.CreateScheduledStream)(w, r)
+}
+
+func (*ServerInterfaceImpl) CreateScheduledStreamOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.CreateScheduledStream)(w, r)
+}
+
+func (*ServerInterfaceImpl) UpdateScheduledStream(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.UpdateScheduledStream)(w, r)
+}
+
+func (*ServerInterfaceImpl) UpdateScheduledStreamOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.UpdateScheduledStream)(w, r)
+}
+
+func (*ServerInterfaceImpl) DeleteScheduledStream(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.DeleteScheduledStream)(w, r)
+}
+
+func (*ServerInterfaceImpl) DeleteScheduledStreamOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.DeleteScheduledStream)(w, r)
+}
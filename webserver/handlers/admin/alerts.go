@@ -0,0 +1,81 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/notifications"
+	"github.com/owncast/owncast/persistence/alertrepository"
+	"github.com/owncast/owncast/persistence/configrepository"
+	webutils "github.com/owncast/owncast/webserver/utils"
+	"github.com/teris-io/shortid"
+)
+
+// SetAlertRules will set the rules alerts are fired from.
+func SetAlertRules(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type request struct {
+		Value []models.AlertRule `json:"value"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var config request
+	if err := decoder.Decode(&config); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update alert rules with provided values")
+		return
+	}
+
+	rules := []models.AlertRule{}
+	ids := map[string]bool{}
+	for _, rule := range config.Value {
+		if rule.ID == "" {
+			rule.ID = shortid.MustGenerate()
+		}
+		if ids[rule.ID] {
+			webutils.WriteSimpleResponse(w, false, fmt.Sprintf("more than one alert rule has the id %s", rule.ID))
+			return
+		}
+		ids[rule.ID] = true
+
+		if err := rule.Validate(); err != nil {
+			webutils.WriteSimpleResponse(w, false, err.Error())
+			return
+		}
+		if rule.Channel != "" {
+			if err := notifications.ValidateAdminChannel(rule.Channel); err != nil {
+				webutils.WriteSimpleResponse(w, false, err.Error())
+				return
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if err := configrepository.Get().SetAlertRules(rules); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update alert rules with provided values")
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "updated alert rules with provided values")
+}
+
+// GetAlerts will return a page of the alert history.
+func GetAlerts(offset int, limit int, w http.ResponseWriter, r *http.Request) {
+	alerts, total, err := alertrepository.Get().GetAlerts(offset, limit)
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	response := webutils.PaginatedResponse{
+		Total:   total,
+		Results: alerts,
+	}
+
+	webutils.WriteResponse(w, response)
+}
@@ -0,0 +1,75 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/owncast/owncast/metrics"
+	"github.com/owncast/owncast/persistence/broadcastrepository"
+	webutils "github.com/owncast/owncast/webserver/utils"
+)
+
+// GetBroadcasts will return a page of the broadcast history.
+func GetBroadcasts(offset int, limit int, w http.ResponseWriter, r *http.Request) {
+	broadcasts, total, err := broadcastrepository.Get().GetBroadcasts(offset, limit)
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	response := webutils.PaginatedResponse{
+		Total:   total,
+		Results: broadcasts,
+	}
+
+	webutils.WriteResponse(w, response)
+}
+
+// broadcastIDMatch matches the characters broadcast IDs are made of.
+var broadcastIDMatch = regexp.MustCompile(`^[0-9A-Za-z_-]+$`)
+
+// GetBroadcastViewersOverTime will return the number of viewers at points
+// in time during a single broadcast.
+func GetBroadcastViewersOverTime(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		webutils.BadRequestHandler(w, errors.New("a broadcast id is required"))
+		return
+	}
+	if !broadcastIDMatch.MatchString(id) {
+		webutils.BadRequestHandler(w, errors.New("invalid broadcast id"))
+		return
+	}
+
+	broadcastRepository := broadcastrepository.Get()
+	broadcast, err := broadcastRepository.GetBroadcast(id)
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+	if broadcast == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	// Viewers are saved with the broadcast once it has ended.
+	viewers, err := broadcastRepository.GetBroadcastViewersOverTime(id)
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+	if viewers != nil {
+		webutils.WriteResponse(w, viewers)
+		return
+	}
+
+	// A broadcast that is still live has no end yet.
+	end := time.Now()
+	if broadcast.EndedAt != nil {
+		end = *broadcast.EndedAt
+	}
+
+	webutils.WriteResponse(w, metrics.GetViewersOverTime(broadcast.StartedAt, end))
+}
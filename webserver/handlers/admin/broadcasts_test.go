@@ -0,0 +1,69 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/broadcastrepository"
+	"github.com/teris-io/shortid"
+)
+
+func getBroadcastViewersOverTime(id string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	GetBroadcastViewersOverTime(recorder, httptest.NewRequest(http.MethodGet, "/api/admin/broadcasts/viewersOverTime?id="+id, nil))
+
+	return recorder
+}
+
+func TestGetBroadcastViewersOverTime(t *testing.T) {
+	broadcastRepository := broadcastrepository.Get()
+	id := shortid.MustGenerate()
+	startedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	if err := broadcastRepository.StartBroadcast(models.Broadcast{ID: id, StartedAt: startedAt}); err != nil {
+		t.Fatal(err)
+	}
+	if err := broadcastRepository.EndBroadcast(id, time.Now(), nil, 5); err != nil {
+		t.Fatal(err)
+	}
+
+	viewers := []models.TimestampedValue{{Time: startedAt.Add(time.Minute), Value: 5}}
+	if err := broadcastRepository.SetBroadcastAnalytics(id, 5, 5, 0, 0, viewers); err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := getBroadcastViewersOverTime(id)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, recorder.Code)
+	}
+
+	var response []models.TimestampedValue
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	if len(response) != 1 || response[0].Value != 5 {
+		t.Errorf("expected the saved viewer counts, got %v", response)
+	}
+}
+
+func TestGetBroadcastViewersOverTimeErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		expected int
+	}{
+		{"missing id", "", http.StatusBadRequest},
+		{"malformed id", "not%20an%20id", http.StatusBadRequest},
+		{"unknown broadcast", shortid.MustGenerate(), http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		if recorder := getBroadcastViewersOverTime(test.id); recorder.Code != test.expected {
+			t.Errorf("%s: expected status %d, got %d", test.name, test.expected, recorder.Code)
+		}
+	}
+}
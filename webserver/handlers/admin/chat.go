@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/owncast/owncast/core/chat"
 	"github.com/owncast/owncast/core/chat/events"
@@ -24,11 +25,15 @@ import (
 
 // ExternalUpdateMessageVisibility updates an array of message IDs to have the same visiblity.
 func ExternalUpdateMessageVisibility(integration models.ExternalAPIUser, w http.ResponseWriter, r *http.Request) {
-	UpdateMessageVisibility(w, r)
+	updateMessageVisibility(integrationModerationActor(integration), w, r)
 }
 
 // UpdateMessageVisibility updates an array of message IDs to have the same visiblity.
 func UpdateMessageVisibility(w http.ResponseWriter, r *http.Request) {
+	updateMessageVisibility(moderationActorFromRequest(r), w, r)
+}
+
+func updateMessageVisibility(actor models.ModerationActor, w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		// nolint:goconst
 		webutils.WriteSimpleResponse(w, false, r.Method+" not supported")
@@ -49,9 +54,74 @@ func UpdateMessageVisibility(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	action := models.ModerationActionHideMessage
+	if *request.Visible {
+		action = models.ModerationActionShowMessage
+	}
+
+	for _, messageID := range *request.IdArray {
+		recordModerationAction(actor, action, messageID, stringValue(request.Reason))
+	}
+
 	webutils.WriteSimpleResponse(w, true, "changed")
 }
 
+// UpdateChatModes will change the chat modes applied for the current broadcast.
+func UpdateChatModes(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var modes models.ChatModes
+
+	if err := decoder.Decode(&modes); err != nil {
+		log.Errorln(err)
+		webutils.WriteSimpleResponse(w, false, "unable to update chat modes")
+		return
+	}
+
+	if err := chat.SetChatModes(modes); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "chat modes updated")
+}
+
+// GetHeldMessages will return the chat messages waiting for review.
+func GetHeldMessages(w http.ResponseWriter, r *http.Request) {
+	webutils.WriteResponse(w, chat.GetHeldMessages())
+}
+
+// ReviewHeldMessage will approve or reject a chat message held for review.
+func ReviewHeldMessage(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var request generated.HeldMessageReview
+
+	if err := decoder.Decode(&request); err != nil {
+		log.Errorln(err)
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	if request.MessageId == nil || *request.MessageId == "" || request.Approved == nil {
+		webutils.WriteSimpleResponse(w, false, "must provide messageId and approved state")
+		return
+	}
+
+	if err := chat.ReviewHeldMessage(*request.MessageId, *request.Approved, moderationActorFromRequest(r)); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, fmt.Sprintf("%s approved: %t", *request.MessageId, *request.Approved))
+}
+
 // BanIPAddress will manually ban an IP address.
 func BanIPAddress(w http.ResponseWriter, r *http.Request) {
 	if !requirePOST(w, r) {
@@ -71,6 +141,8 @@ func BanIPAddress(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	recordModerationAction(moderationActorFromRequest(r), models.ModerationActionBanIP, configValue.Value.(string), "")
+
 	webutils.WriteSimpleResponse(w, true, "IP address banned")
 }
 
@@ -93,6 +165,8 @@ func UnBanIPAddress(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	recordModerationAction(moderationActorFromRequest(r), models.ModerationActionUnbanIP, configValue.Value.(string), "")
+
 	webutils.WriteSimpleResponse(w, true, "IP address unbanned")
 }
 
@@ -135,8 +209,15 @@ func UpdateUserEnabled(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	actor := moderationActorFromRequest(r)
+	action := models.ModerationActionEnableUser
 	if !*request.Enabled {
-		if err := handleUserDisabling(*request.UserId); err != nil {
+		action = models.ModerationActionDisableUser
+	}
+	recordModerationAction(actor, action, *request.UserId, stringValue(request.Reason))
+
+	if !*request.Enabled {
+		if err := handleUserDisabling(actor, *request.UserId); err != nil {
 			webutils.WriteSimpleResponse(w, false, err.Error())
 			return
 		}
@@ -168,7 +249,7 @@ func updateUserStatus(request generated.UpdateUserEnabledJSONBody) error {
 	return nil
 }
 
-func handleUserDisabling(userID string) error {
+func handleUserDisabling(actor models.ModerationActor, userID string) error {
 	clients, err := chat.GetClientsForUser(userID)
 	if len(clients) == 0 {
 		return nil
@@ -194,12 +275,69 @@ func handleUserDisabling(userID string) error {
 			reason := fmt.Sprintf("Banning of %s", disconnectedUser.DisplayName)
 			if err := authRepository.BanIPAddress(ipAddress, reason); err != nil {
 				log.Errorln("error banning IP address: ", err)
+			} else {
+				recordModerationAction(actor, models.ModerationActionBanIP, ipAddress, reason)
 			}
 		}
 	}
 	return nil
 }
 
+// UpdateUserTimeout will time out a user for a duration, or remove an
+// existing timeout when the duration is 0.
+func UpdateUserTimeout(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var request generated.UserTimeoutUpdate
+
+	if err := decoder.Decode(&request); err != nil {
+		log.Errorln(err)
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	if request.UserId == nil || *request.UserId == "" || request.DurationSeconds == nil {
+		webutils.WriteSimpleResponse(w, false, "must provide userId and durationSeconds")
+		return
+	}
+
+	actor := moderationActorFromRequest(r)
+
+	if *request.DurationSeconds == 0 {
+		if err := chat.RemoveTimeout(*request.UserId); err != nil {
+			webutils.WriteSimpleResponse(w, false, err.Error())
+			return
+		}
+
+		recordModerationAction(actor, models.ModerationActionRemoveTimeout, *request.UserId, "")
+
+		webutils.WriteSimpleResponse(w, true, fmt.Sprintf("%s timeout removed", *request.UserId))
+		return
+	}
+
+	reason := stringValue(request.Reason)
+	duration := time.Duration(*request.DurationSeconds) * time.Second
+	if err := chat.TimeoutUser(*request.UserId, duration, reason); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	recordModerationAction(actor, models.ModerationActionTimeoutUser, *request.UserId, reason)
+
+	webutils.WriteSimpleResponse(w, true, fmt.Sprintf("%s timed out for %d seconds", *request.UserId, *request.DurationSeconds))
+}
+
+// GetUserTimeouts will return all the users that are currently timed out.
+func GetUserTimeouts(w http.ResponseWriter, r *http.Request) {
+	userRepository := userrepository.Get()
+
+	timeouts := userRepository.GetTimeouts()
+	webutils.WriteResponse(w, timeouts)
+}
+
 // GetDisabledUsers will return all the disabled users.
 func GetDisabledUsers(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
@@ -233,6 +371,12 @@ func UpdateUserModerator(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	action := models.ModerationActionRemoveModerator
+	if *req.IsModerator {
+		action = models.ModerationActionAddModerator
+	}
+	recordModerationAction(moderationActorFromRequest(r), action, *req.UserId, stringValue(req.Reason))
+
 	// Update the clients for this user to know about the moderator access change.
 	if err := chat.SendConnectedClientInfoToUser(*req.UserId); err != nil {
 		log.Debugln(err)
@@ -358,6 +502,46 @@ func SendIntegrationChatMessage(integration models.ExternalAPIUser, w http.Respo
 	webutils.WriteSimpleResponse(w, true, "sent")
 }
 
+// SendIntegrationDirectMessage will privately send a message to a chat user
+// on behalf of an external chat integration.
+func SendIntegrationDirectMessage(integration models.ExternalAPIUser, w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	name := integration.DisplayName
+
+	if name == "" {
+		webutils.BadRequestHandler(w, errors.New("unknown integration for provided access token"))
+		return
+	}
+
+	var request generated.DirectMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	body := events.RenderAndSanitize(request.Body)
+	if body == "" {
+		webutils.BadRequestHandler(w, errors.New("invalid message"))
+		return
+	}
+
+	sender := &models.User{
+		ID:           integration.ID,
+		DisplayName:  name,
+		DisplayColor: integration.DisplayColor,
+		CreatedAt:    integration.CreatedAt,
+		IsBot:        true,
+	}
+
+	if err := chat.SendDirectMessage(sender, request.UserId, body); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "sent")
+}
+
 // SendChatAction will send a generic chat action.
 func SendChatAction(integration models.ExternalAPIUser, w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/owncast/owncast/core/chat"
+	"github.com/owncast/owncast/persistence/chatarchiverepository"
+	"github.com/owncast/owncast/webserver/handlers/generated"
+	"github.com/owncast/owncast/webserver/router/middleware"
+	webutils "github.com/owncast/owncast/webserver/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetChatArchives will return the saved broadcast chat archives.
+func GetChatArchives(w http.ResponseWriter, r *http.Request) {
+	archives, err := chatarchiverepository.Get().GetArchives()
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	webutils.WriteResponse(w, archives)
+}
+
+// DownloadChatArchive will return a single broadcast chat archive as a
+// JSON, HTML or plain text file.
+func DownloadChatArchive(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	format := query.Get("format")
+	if format == "" {
+		format = chat.ChatArchiveFormatJSON
+	}
+
+	archive, err := chatarchiverepository.Get().GetArchive(query.Get("id"))
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+	if archive == nil {
+		webutils.WriteSimpleResponse(w, false, "chat archive not found")
+		return
+	}
+
+	data, contentType, err := chat.RenderChatArchive(archive, format)
+	if err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	filename := fmt.Sprintf("chat-%s.%s", archive.StartedAt.Format("2006-01-02-1504"), format)
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	middleware.DisableCache(w)
+
+	if _, err := w.Write(data); err != nil {
+		log.Debugln(err)
+	}
+}
+
+// DeleteChatArchive will remove a single broadcast chat archive.
+func DeleteChatArchive(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var request generated.DeleteChatArchiveJSONBody
+	if err := decoder.Decode(&request); err != nil || request.Id == nil {
+		webutils.WriteSimpleResponse(w, false, "unable to delete chat archive with provided values")
+		return
+	}
+
+	if err := chatarchiverepository.Get().DeleteArchive(*request.Id); err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "deleted chat archive")
+}
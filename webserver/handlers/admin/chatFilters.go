@@ -0,0 +1,128 @@
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/owncast/owncast/core/chat"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/chatfilterrepository"
+	"github.com/owncast/owncast/webserver/handlers/generated"
+	webutils "github.com/owncast/owncast/webserver/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetChatFilterRules will return all the chat filter rules.
+func GetChatFilterRules(w http.ResponseWriter, r *http.Request) {
+	chatFilterRepository := chatfilterrepository.Get()
+	rules, err := chatFilterRepository.GetRules()
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	webutils.WriteResponse(w, rules)
+}
+
+// CreateChatFilterRule will add a single chat filter rule.
+func CreateChatFilterRule(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var rule models.ChatFilterRule
+	if err := decoder.Decode(&rule); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	if err := rule.Validate(); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	chatFilterRepository := chatfilterrepository.Get()
+	newRuleID, err := chatFilterRepository.InsertRule(rule)
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	reloadChatFilterRules()
+
+	rule.ID = newRuleID
+	rule.CreatedAt = time.Now()
+	webutils.WriteResponse(w, rule)
+}
+
+// UpdateChatFilterRule will replace a single chat filter rule.
+func UpdateChatFilterRule(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var rule models.ChatFilterRule
+	if err := decoder.Decode(&rule); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	if rule.ID == 0 {
+		webutils.BadRequestHandler(w, errors.New("must provide a rule id"))
+		return
+	}
+
+	if err := rule.Validate(); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	chatFilterRepository := chatfilterrepository.Get()
+	if err := chatFilterRepository.UpdateRule(rule); err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	reloadChatFilterRules()
+
+	webutils.WriteSimpleResponse(w, true, "updated chat filter rule")
+}
+
+// DeleteChatFilterRule will delete a single chat filter rule.
+func DeleteChatFilterRule(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var request generated.DeleteChatFilterRuleJSONBody
+	if err := decoder.Decode(&request); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	if request.Id == nil {
+		webutils.BadRequestHandler(w, errors.New("must provide a rule id"))
+		return
+	}
+
+	chatFilterRepository := chatfilterrepository.Get()
+	if err := chatFilterRepository.DeleteRule(*request.Id); err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	reloadChatFilterRules()
+
+	webutils.WriteSimpleResponse(w, true, "deleted chat filter rule")
+}
+
+func reloadChatFilterRules() {
+	if err := chat.ReloadChatFilterRules(); err != nil {
+		log.Errorln("error reloading chat filter rules", err)
+	}
+}
@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
+	webutils "github.com/owncast/owncast/webserver/utils"
+	"github.com/pkg/errors"
+)
+
+// SearchChatMessages will return a page of the chat messages matching the
+// search parameters.
+func SearchChatMessages(offset int, limit int, w http.ResponseWriter, r *http.Request) {
+	search, err := chatMessageSearchFromRequest(r)
+	if err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	chatMessageRepository := chatmessagerepository.Get()
+	messages, total, err := chatMessageRepository.SearchMessages(search, offset, limit)
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	response := webutils.PaginatedResponse{
+		Total:   total,
+		Results: messages,
+	}
+
+	webutils.WriteResponse(w, response)
+}
+
+func chatMessageSearchFromRequest(r *http.Request) (models.ChatMessageSearch, error) {
+	query := r.URL.Query()
+	search := models.ChatMessageSearch{
+		Text:        query.Get("text"),
+		UserID:      query.Get("userId"),
+		DisplayName: query.Get("displayName"),
+		Visibility:  query.Get("visibility"),
+	}
+
+	switch search.Visibility {
+	case "", models.ChatMessageVisibilityAll, models.ChatMessageVisibilityVisible, models.ChatMessageVisibilityHidden:
+	default:
+		return search, errors.New("visibility must be one of all, visible or hidden")
+	}
+
+	var err error
+	if search.Since, err = timeFromQuery(r, "since"); err != nil {
+		return search, err
+	}
+	if search.Until, err = timeFromQuery(r, "until"); err != nil {
+		return search, err
+	}
+
+	return search, nil
+}
+
+// timeFromQuery will return the RFC3339 time in a query parameter, or nil
+// if it was not provided.
+func timeFromQuery(r *http.Request, param string) (*time.Time, error) {
+	value := r.URL.Query().Get(param)
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s time", param)
+	}
+
+	return &t, nil
+}
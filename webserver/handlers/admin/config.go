@@ -858,6 +858,26 @@ func SetChatSpamProtectionEnabled(w http.ResponseWriter, r *http.Request) {
 	webutils.WriteSimpleResponse(w, true, "chat spam protection changed")
 }
 
+// SetChatUserDirectMessagesEnabled will allow or prevent chat users sending
+// direct messages to moderators.
+func SetChatUserDirectMessagesEnabled(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	configValue, success := getValueFromRequest(w, r)
+	if !success {
+		return
+	}
+
+	configRepository := configrepository.Get()
+	if err := configRepository.SetChatUserDirectMessagesEnabled(configValue.Value.(bool)); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+	webutils.WriteSimpleResponse(w, true, "chat user direct messages changed")
+}
+
 // SetChatSlurFilterEnabled will enable or disable the chat slur filter.
 func SetChatSlurFilterEnabled(w http.ResponseWriter, r *http.Request) {
 	if !requirePOST(w, r) {
@@ -877,6 +897,87 @@ func SetChatSlurFilterEnabled(w http.ResponseWriter, r *http.Request) {
 	webutils.WriteSimpleResponse(w, true, "chat message slur filter changed")
 }
 
+// SetChatReviewQueue will set which chat messages are held for review.
+func SetChatReviewQueue(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type chatReviewQueueRequest struct {
+		Value models.ChatReviewQueue `json:"value"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var request chatReviewQueueRequest
+	if err := decoder.Decode(&request); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update chat review queue with provided values")
+		return
+	}
+
+	configRepository := configrepository.Get()
+	if err := configRepository.SetChatReviewQueue(request.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "chat review queue changed")
+}
+
+// SetChatRetention will set how long chat messages are kept.
+func SetChatRetention(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type chatRetentionRequest struct {
+		Value models.ChatRetention `json:"value"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var request chatRetentionRequest
+	if err := decoder.Decode(&request); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update chat retention with provided values")
+		return
+	}
+
+	configRepository := configrepository.Get()
+	if err := configRepository.SetChatRetention(request.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "chat retention changed")
+}
+
+// SetChatCustomCommands will set the admin defined chat commands.
+func SetChatCustomCommands(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type chatCustomCommandsRequest struct {
+		Value []models.ChatCommand `json:"value"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var request chatCustomCommandsRequest
+	if err := decoder.Decode(&request); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update custom chat commands with provided values")
+		return
+	}
+
+	if request.Value == nil {
+		request.Value = []models.ChatCommand{}
+	}
+
+	if err := chat.SetCustomCommands(request.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "custom chat commands changed")
+}
+
 func requirePOST(w http.ResponseWriter, r *http.Request) bool {
 	if r.Method != http.MethodPost {
 		webutils.WriteSimpleResponse(w, false, r.Method+" not supported")
@@ -0,0 +1,89 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/moderationrepository"
+	"github.com/owncast/owncast/persistence/userrepository"
+	webutils "github.com/owncast/owncast/webserver/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetModerationActions will return a page of the moderation log.
+func GetModerationActions(offset int, limit int, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := models.ModerationActionFilter{
+		Action:  query.Get("action"),
+		ActorID: query.Get("actorId"),
+		Target:  query.Get("target"),
+	}
+
+	moderationRepository := moderationrepository.Get()
+	actions, total, err := moderationRepository.GetActions(filter, offset, limit)
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	response := webutils.PaginatedResponse{
+		Total:   total,
+		Results: actions,
+	}
+
+	webutils.WriteResponse(w, response)
+}
+
+// moderationActorFromRequest will return who is making an already
+// authorized moderation request. Moderators authenticate with a user
+// access token, otherwise the request was made by the admin.
+func moderationActorFromRequest(r *http.Request) models.ModerationActor {
+	if accessToken := r.URL.Query().Get("accessToken"); accessToken != "" {
+		userRepository := userrepository.Get()
+		if user := userRepository.GetUserByToken(accessToken); user != nil {
+			return models.ModerationActor{
+				Type: models.ModerationActorModerator,
+				ID:   user.ID,
+				Name: user.DisplayName,
+			}
+		}
+	}
+
+	return models.ModerationActor{
+		Type: models.ModerationActorAdmin,
+		ID:   "admin",
+		Name: "admin",
+	}
+}
+
+// integrationModerationActor will return the moderation actor for a 3rd
+// party integration.
+func integrationModerationActor(integration models.ExternalAPIUser) models.ModerationActor {
+	return models.ModerationActor{
+		Type: models.ModerationActorIntegration,
+		ID:   integration.ID,
+		Name: integration.DisplayName,
+	}
+}
+
+// recordModerationAction will add an entry to the moderation log.
+func recordModerationAction(actor models.ModerationActor, action models.ModerationActionType, target string, reason string) {
+	moderationRepository := moderationrepository.Get()
+	if err := moderationRepository.RecordAction(models.ModerationAction{
+		ActorType: actor.Type,
+		ActorID:   actor.ID,
+		ActorName: actor.Name,
+		Action:    action,
+		Target:    target,
+		Reason:    reason,
+	}); err != nil {
+		log.Errorln("error recording moderation action", err)
+	}
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
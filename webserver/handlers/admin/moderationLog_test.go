@@ -0,0 +1,156 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/owncast/owncast/core/chat"
+	"github.com/owncast/owncast/core/chat/events"
+	"github.com/owncast/owncast/core/data"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/chatmessagerepository"
+	"github.com/owncast/owncast/persistence/moderationrepository"
+	"github.com/owncast/owncast/persistence/userrepository"
+	"github.com/teris-io/shortid"
+)
+
+func TestMain(m *testing.M) {
+	dbFile, err := os.CreateTemp(os.TempDir(), "owncast-admin-test-db.db")
+	if err != nil {
+		panic(err)
+	}
+	dbFile.Close()
+	defer os.Remove(dbFile.Name())
+
+	if err := data.SetupPersistence(dbFile.Name()); err != nil {
+		panic(err)
+	}
+
+	if err := chat.Start(func() models.Status { return models.Status{} }); err != nil {
+		panic(err)
+	}
+
+	m.Run()
+}
+
+// postToHandler will make a request to a handler and fail the test if it
+// doesn't succeed.
+func postToHandler(t *testing.T, handler http.HandlerFunc, path string, body interface{}) {
+	t.Helper()
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodPost, path, bytes.NewReader(payload)))
+
+	var response models.BaseAPIResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	if !response.Success {
+		t.Fatalf("Expected the request to succeed but got %q", response.Message)
+	}
+}
+
+// moderationActionsFor will return the moderation log entries for a target.
+func moderationActionsFor(t *testing.T, target string) []models.ModerationAction {
+	t.Helper()
+
+	actions, _, err := moderationrepository.Get().GetActions(models.ModerationActionFilter{Target: target}, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return actions
+}
+
+func newModerationTestUser(t *testing.T, name string) (*models.User, string) {
+	t.Helper()
+
+	user, accessToken, err := userrepository.Get().CreateAnonymousUser(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return user, accessToken
+}
+
+func TestMessageVisibilityIsAudited(t *testing.T) {
+	user, _ := newModerationTestUser(t, "audited-message-user")
+	messageID := shortid.MustGenerate()
+	chatmessagerepository.Get().SaveUserMessage(events.UserMessageEvent{
+		Event:        events.Event{ID: messageID, Type: events.MessageSent, Timestamp: time.Now()},
+		UserEvent:    events.UserEvent{User: user},
+		MessageEvent: events.MessageEvent{Body: "<p>spam</p>"},
+	})
+
+	postToHandler(t, UpdateMessageVisibility, "/", map[string]interface{}{"idArray": []string{messageID}, "visible": false, "reason": "spam"})
+
+	actions := moderationActionsFor(t, messageID)
+	if len(actions) != 1 {
+		t.Fatalf("Expected one moderation action for the message but got %d", len(actions))
+	}
+	if a := actions[0]; a.Action != models.ModerationActionHideMessage || a.Reason != "spam" || a.ActorType != models.ModerationActorAdmin {
+		t.Errorf("Unexpected moderation action %+v", a)
+	}
+}
+
+func TestUserEnabledIsAudited(t *testing.T) {
+	user, _ := newModerationTestUser(t, "audited-disabled-user")
+
+	postToHandler(t, UpdateUserEnabled, "/", map[string]interface{}{"userId": user.ID, "enabled": false, "reason": "abuse"})
+	postToHandler(t, UpdateUserEnabled, "/", map[string]interface{}{"userId": user.ID, "enabled": true})
+
+	actions := moderationActionsFor(t, user.ID)
+	if len(actions) != 2 {
+		t.Fatalf("Expected two moderation actions for the user but got %d", len(actions))
+	}
+
+	// The newest action is first.
+	if actions[0].Action != models.ModerationActionEnableUser {
+		t.Errorf("Expected the user to have been enabled but got %s", actions[0].Action)
+	}
+	if actions[1].Action != models.ModerationActionDisableUser || actions[1].Reason != "abuse" {
+		t.Errorf("Unexpected moderation action %+v", actions[1])
+	}
+}
+
+func TestModeratorChangeIsAudited(t *testing.T) {
+	moderator, accessToken := newModerationTestUser(t, "audited-moderator")
+	if err := userrepository.Get().SetModerator(moderator.ID, true); err != nil {
+		t.Fatal(err)
+	}
+	user, _ := newModerationTestUser(t, "audited-promoted-user")
+
+	// Moderators are recorded as themselves rather than as the admin.
+	postToHandler(t, UpdateUserModerator, "/?accessToken="+accessToken, map[string]interface{}{"userId": user.ID, "isModerator": true})
+
+	actions := moderationActionsFor(t, user.ID)
+	if len(actions) != 1 {
+		t.Fatalf("Expected one moderation action for the user but got %d", len(actions))
+	}
+	a := actions[0]
+	if a.Action != models.ModerationActionAddModerator || a.ActorType != models.ModerationActorModerator || a.ActorID != moderator.ID || a.ActorName != moderator.DisplayName {
+		t.Errorf("Unexpected moderation action %+v", a)
+	}
+}
+
+func TestBanIPAddressIsAudited(t *testing.T) {
+	const ipAddress = "192.0.2.10"
+
+	postToHandler(t, BanIPAddress, "/", map[string]interface{}{"value": ipAddress})
+	postToHandler(t, UnBanIPAddress, "/", map[string]interface{}{"value": ipAddress})
+
+	actions := moderationActionsFor(t, ipAddress)
+	if len(actions) < 2 || actions[0].Action != models.ModerationActionUnbanIP || actions[1].Action != models.ModerationActionBanIP {
+		t.Errorf("Expected the IP address to be banned then unbanned but got %+v", actions)
+	}
+}
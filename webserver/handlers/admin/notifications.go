@@ -3,9 +3,12 @@ package admin
 import (
 	"encoding/json"
 	"net/http"
+	"net/mail"
 
 	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/notifications"
 	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/owncast/owncast/webserver/handlers/generated"
 	webutils "github.com/owncast/owncast/webserver/utils"
 )
 
@@ -61,3 +64,254 @@ func SetBrowserNotificationConfiguration(w http.ResponseWriter, r *http.Request)
 
 	webutils.WriteSimpleResponse(w, true, "updated browser push config with provided values")
 }
+
+// SetMatrixNotificationConfiguration will set the matrix notification configuration.
+func SetMatrixNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type request struct {
+		Value models.MatrixConfiguration `json:"value"`
+	}
+
+	configRepository := configrepository.Get()
+	decoder := json.NewDecoder(r.Body)
+	var config request
+	if err := decoder.Decode(&config); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update matrix config with provided values")
+		return
+	}
+
+	if err := configRepository.SetMatrixConfig(config.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update matrix config with provided values")
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "updated matrix config with provided values")
+}
+
+// SetTelegramNotificationConfiguration will set the telegram notification configuration.
+func SetTelegramNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type request struct {
+		Value models.TelegramConfiguration `json:"value"`
+	}
+
+	configRepository := configrepository.Get()
+	decoder := json.NewDecoder(r.Body)
+	var config request
+	if err := decoder.Decode(&config); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update telegram config with provided values")
+		return
+	}
+
+	if err := configRepository.SetTelegramConfig(config.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update telegram config with provided values")
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "updated telegram config with provided values")
+}
+
+// SetNtfyNotificationConfiguration will set the ntfy notification configuration.
+func SetNtfyNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type request struct {
+		Value models.NtfyConfiguration `json:"value"`
+	}
+
+	configRepository := configrepository.Get()
+	decoder := json.NewDecoder(r.Body)
+	var config request
+	if err := decoder.Decode(&config); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update ntfy config with provided values")
+		return
+	}
+
+	if err := configRepository.SetNtfyConfig(config.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update ntfy config with provided values")
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "updated ntfy config with provided values")
+}
+
+// SetGotifyNotificationConfiguration will set the gotify notification configuration.
+func SetGotifyNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type request struct {
+		Value models.GotifyConfiguration `json:"value"`
+	}
+
+	configRepository := configrepository.Get()
+	decoder := json.NewDecoder(r.Body)
+	var config request
+	if err := decoder.Decode(&config); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update gotify config with provided values")
+		return
+	}
+
+	if err := configRepository.SetGotifyConfig(config.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update gotify config with provided values")
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "updated gotify config with provided values")
+}
+
+// SetSlackNotificationConfiguration will set the slack notification configuration.
+func SetSlackNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type request struct {
+		Value models.SlackConfiguration `json:"value"`
+	}
+
+	configRepository := configrepository.Get()
+	decoder := json.NewDecoder(r.Body)
+	var config request
+	if err := decoder.Decode(&config); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update slack config with provided values")
+		return
+	}
+
+	if err := configRepository.SetSlackConfig(config.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update slack config with provided values")
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "updated slack config with provided values")
+}
+
+// SetEmailNotificationConfiguration will set the email notification configuration.
+func SetEmailNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type request struct {
+		Value models.EmailConfiguration `json:"value"`
+	}
+
+	configRepository := configrepository.Get()
+	decoder := json.NewDecoder(r.Body)
+	var config request
+	if err := decoder.Decode(&config); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update email config with provided values")
+		return
+	}
+
+	addresses := append([]string{config.Value.FromAddress}, config.Value.Recipients...)
+	for _, address := range addresses {
+		if address == "" {
+			continue
+		}
+		if _, err := mail.ParseAddress(address); err != nil {
+			webutils.WriteSimpleResponse(w, false, "invalid email address "+address)
+			return
+		}
+	}
+
+	if err := configRepository.SetEmailConfig(config.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update email config with provided values")
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "updated email config with provided values")
+}
+
+// SetNotificationRules will set the per-channel go-live notification rules.
+func SetNotificationRules(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	type request struct {
+		Value map[string]models.NotificationRule `json:"value"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var config request
+	if err := decoder.Decode(&config); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update notification rules with provided values")
+		return
+	}
+
+	if config.Value == nil {
+		config.Value = map[string]models.NotificationRule{}
+	}
+
+	if err := notifications.ValidateNotificationRules(config.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	if err := configrepository.Get().SetNotificationRules(config.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update notification rules with provided values")
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "updated notification rules with provided values")
+}
+
+// SetStreamReportChannel will set the notification channel end of stream
+// reports are sent to.
+func SetStreamReportChannel(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	configValue, success := getValueFromRequest(w, r)
+	if !success {
+		return
+	}
+
+	channel, _ := configValue.Value.(string)
+	if channel != "" {
+		if err := notifications.ValidateAdminChannel(channel); err != nil {
+			webutils.WriteSimpleResponse(w, false, err.Error())
+			return
+		}
+	}
+
+	if err := configrepository.Get().SetStreamReportChannel(channel); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "updated stream report channel")
+}
+
+// SendTestNotification will send a test notification through a single
+// notification channel using its saved configuration.
+func SendTestNotification(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var request generated.SendTestNotificationJSONBody
+	if err := decoder.Decode(&request); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	if err := notifications.SendTestNotification(string(request.Channel)); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "sent test notification")
+}
@@ -0,0 +1,93 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/owncast/owncast/core/chat"
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/pollrepository"
+	"github.com/owncast/owncast/webserver/handlers/generated"
+	webutils "github.com/owncast/owncast/webserver/utils"
+)
+
+// GetPolls will return a page of the chat polls and their results.
+func GetPolls(offset int, limit int, w http.ResponseWriter, r *http.Request) {
+	polls, total, err := pollrepository.Get().GetPolls(offset, limit)
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	response := webutils.PaginatedResponse{
+		Total:   total,
+		Results: polls,
+	}
+
+	webutils.WriteResponse(w, response)
+}
+
+// GetActivePoll will return the chat poll currently accepting votes.
+func GetActivePoll(w http.ResponseWriter, r *http.Request) {
+	webutils.WriteResponse(w, chat.GetActivePoll())
+}
+
+// StartPoll will start a chat poll on behalf of the admin or a moderator.
+func StartPoll(w http.ResponseWriter, r *http.Request) {
+	startPoll(moderationActorFromRequest(r), w, r)
+}
+
+// EndPoll will end a chat poll on behalf of the admin or a moderator.
+func EndPoll(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	var request generated.EndPollRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	poll, err := chat.EndPoll(request.Id)
+	if err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteResponse(w, poll)
+}
+
+// StartIntegrationPoll will start a chat poll on behalf of a 3rd party
+// integration.
+func StartIntegrationPoll(integration models.ExternalAPIUser, w http.ResponseWriter, r *http.Request) {
+	startPoll(integrationModerationActor(integration), w, r)
+}
+
+// EndIntegrationPoll will end a chat poll on behalf of a 3rd party
+// integration.
+func EndIntegrationPoll(integration models.ExternalAPIUser, w http.ResponseWriter, r *http.Request) {
+	EndPoll(w, r)
+}
+
+func startPoll(actor models.ModerationActor, w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	var request generated.PollRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	duration := time.Duration(request.DurationSeconds) * time.Second
+	poll, err := chat.StartPoll(request.Question, request.Options, duration, actor)
+	if err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteResponse(w, poll)
+}
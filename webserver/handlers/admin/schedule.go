@@ -0,0 +1,106 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/owncast/owncast/core/schedule"
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/owncast/owncast/persistence/schedulerepository"
+	"github.com/owncast/owncast/webserver/handlers/generated"
+	webutils "github.com/owncast/owncast/webserver/utils"
+)
+
+// GetScheduledStreams will return every scheduled stream.
+func GetScheduledStreams(w http.ResponseWriter, r *http.Request) {
+	streams, err := schedulerepository.Get().GetScheduledStreams()
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	webutils.WriteResponse(w, streams)
+}
+
+// CreateScheduledStream will schedule an upcoming stream.
+func CreateScheduledStream(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	var request generated.ScheduledStreamRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	stream, err := schedule.CreateScheduledStream(request.Title, stringValue(request.Description), stringValue(request.Thumbnail), request.StartTime)
+	if err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	webutils.WriteResponse(w, stream)
+}
+
+// UpdateScheduledStream will change the details of a scheduled stream.
+func UpdateScheduledStream(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	var request generated.UpdateScheduledStreamRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	stream, err := schedule.UpdateScheduledStream(request.Id, request.Title, stringValue(request.Description), stringValue(request.Thumbnail), request.StartTime)
+	if err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	webutils.WriteResponse(w, stream)
+}
+
+// DeleteScheduledStream will remove a scheduled stream.
+func DeleteScheduledStream(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	var request generated.DeleteScheduledStreamJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	if err := schedule.DeleteScheduledStream(request.Id); err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "deleted scheduled stream")
+}
+
+// SetScheduleReminderMinutes will set how long before a scheduled stream a
+// reminder is sent.
+func SetScheduleReminderMinutes(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	var request generated.SetScheduleReminderMinutesJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to update reminder time with provided values")
+		return
+	}
+
+	if err := configrepository.Get().SetScheduleReminderMinutes(request.Value); err != nil {
+		webutils.WriteSimpleResponse(w, false, err.Error())
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "reminder time changed")
+}
@@ -65,6 +65,12 @@ func GetServerConfig(w http.ResponseWriter, r *http.Request) {
 		ChatEstablishedUserMode:   configRepository.GetChatEstbalishedUsersOnlyMode(),
 		ChatSpamProtectionEnabled: configRepository.GetChatSpamProtectionEnabled(),
 		ChatSlurFilterEnabled:     configRepository.GetChatSlurFilterEnabled(),
+		ChatModes:                 configRepository.GetChatModes(),
+		ChatReviewQueue:           configRepository.GetChatReviewQueue(),
+		ChatUserDirectMessages:    configRepository.GetChatUserDirectMessagesEnabled(),
+		ChatRetention:             configRepository.GetChatRetention(),
+		ChatCustomCommands:        configRepository.GetChatCustomCommands(),
+		ScheduleReminderMinutes:   configRepository.GetScheduleReminderMinutes(),
 		HideViewerCount:           configRepository.GetHideViewerCount(),
 		DisableSearchIndexing:     configRepository.GetDisableSearchIndexing(),
 		VideoSettings: videoSettings{
@@ -90,9 +96,18 @@ func GetServerConfig(w http.ResponseWriter, r *http.Request) {
 			BlockedDomains: configRepository.GetBlockedFederatedDomains(),
 		},
 		Notifications: notificationsConfigResponse{
-			Discord: configRepository.GetDiscordConfig(),
-			Browser: configRepository.GetBrowserPushConfig(),
+			Discord:       configRepository.GetDiscordConfig(),
+			Browser:       configRepository.GetBrowserPushConfig(),
+			Matrix:        configRepository.GetMatrixConfig(),
+			Telegram:      configRepository.GetTelegramConfig(),
+			Ntfy:          configRepository.GetNtfyConfig(),
+			Gotify:        configRepository.GetGotifyConfig(),
+			Slack:         configRepository.GetSlackConfig(),
+			Email:         configRepository.GetEmailConfig(),
+			Rules:         configRepository.GetNotificationRules(),
+			ReportChannel: configRepository.GetStreamReportChannel(),
 		},
+		AlertRules: configRepository.GetAlertRules(),
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -106,6 +121,7 @@ func GetServerConfig(w http.ResponseWriter, r *http.Request) {
 type serverConfigAdminResponse struct {
 	InstanceDetails           webConfigResponse           `json:"instanceDetails"`
 	Notifications             notificationsConfigResponse `json:"notifications"`
+	AlertRules                []models.AlertRule          `json:"alertRules"`
 	YP                        yp                          `json:"yp"`
 	FFmpegPath                string                      `json:"ffmpegPath"`
 	AdminPassword             string                      `json:"adminPassword"`
@@ -121,7 +137,13 @@ type serverConfigAdminResponse struct {
 	SuggestedUsernames        []string                    `json:"suggestedUsernames"`
 	StreamKeys                []generated.StreamKey       `json:"streamKeys"`
 	VideoSettings             videoSettings               `json:"videoSettings"`
+	ChatModes                 models.ChatModes            `json:"chatModes"`
+	ChatReviewQueue           models.ChatReviewQueue      `json:"chatReviewQueue"`
+	ChatUserDirectMessages    bool                        `json:"chatUserDirectMessagesEnabled"`
+	ChatRetention             models.ChatRetention        `json:"chatRetention"`
+	ChatCustomCommands        []models.ChatCommand        `json:"chatCustomCommands"`
 	RTMPServerPort            int                         `json:"rtmpServerPort"`
+	ScheduleReminderMinutes   int                         `json:"scheduleReminderMinutes"`
 	WebServerPort             int                         `json:"webServerPort"`
 	ChatDisabled              bool                        `json:"chatDisabled"`
 	ChatJoinMessagesEnabled   bool                        `json:"chatJoinMessagesEnabled"`
@@ -171,6 +193,14 @@ type federationConfigResponse struct {
 }
 
 type notificationsConfigResponse struct {
-	Browser models.BrowserNotificationConfiguration `json:"browser"`
-	Discord models.DiscordConfiguration             `json:"discord"`
+	Browser       models.BrowserNotificationConfiguration `json:"browser"`
+	Discord       models.DiscordConfiguration             `json:"discord"`
+	Matrix        models.MatrixConfiguration              `json:"matrix"`
+	Telegram      models.TelegramConfiguration            `json:"telegram"`
+	Ntfy          models.NtfyConfiguration                `json:"ntfy"`
+	Gotify        models.GotifyConfiguration              `json:"gotify"`
+	Slack         models.SlackConfiguration               `json:"slack"`
+	Email         models.EmailConfiguration               `json:"email"`
+	Rules         map[string]models.NotificationRule      `json:"rules"`
+	ReportChannel string                                  `json:"reportChannel"`
 }
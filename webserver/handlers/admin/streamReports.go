@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/owncast/owncast/persistence/streamreportrepository"
+	"github.com/owncast/owncast/webserver/handlers/generated"
+	webutils "github.com/owncast/owncast/webserver/utils"
+)
+
+// GetStreamReports will return the saved end of stream reports.
+func GetStreamReports(w http.ResponseWriter, r *http.Request) {
+	reports, err := streamreportrepository.Get().GetReports()
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	webutils.WriteResponse(w, reports)
+}
+
+// DeleteStreamReport will remove a single end of stream report.
+func DeleteStreamReport(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var request generated.DeleteStreamReportJSONBody
+	if err := decoder.Decode(&request); err != nil || request.Id == "" {
+		webutils.WriteSimpleResponse(w, false, "unable to delete stream report with provided values")
+		return
+	}
+
+	if err := streamreportrepository.Get().DeleteReport(request.Id); err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "deleted stream report")
+}
@@ -0,0 +1,35 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/viewersessionrepository"
+	webutils "github.com/owncast/owncast/webserver/utils"
+)
+
+// GetViewerAnalytics will return an anonymous breakdown of viewers,
+// optionally limited to a single broadcast and a range of dates.
+func GetViewerAnalytics(w http.ResponseWriter, r *http.Request) {
+	filter := models.ViewerAnalyticsFilter{
+		BroadcastID: r.URL.Query().Get("broadcastId"),
+	}
+
+	var err error
+	if filter.Since, err = timeFromQuery(r, "since"); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+	if filter.Until, err = timeFromQuery(r, "until"); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	analytics, err := viewersessionrepository.Get().GetViewerAnalytics(filter)
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	webutils.WriteResponse(w, analytics)
+}
@@ -3,18 +3,43 @@ package admin
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
-	"time"
+	"strconv"
 
+	"github.com/owncast/owncast/core/webhooks"
 	"github.com/owncast/owncast/models"
+	"github.com/owncast/owncast/persistence/userrepository"
 	"github.com/owncast/owncast/persistence/webhookrepository"
 	"github.com/owncast/owncast/webserver/handlers/generated"
 	webutils "github.com/owncast/owncast/webserver/utils"
 )
 
 type createWebhookRequest struct {
-	URL    string             `json:"url"`
-	Events []models.EventType `json:"events"`
+	URL         string             `json:"url"`
+	Type        models.WebhookType `json:"type"`
+	AccessToken string             `json:"accessToken"`
+	Template    string             `json:"template"`
+	ContentType string             `json:"contentType"`
+	Filter      string             `json:"filter"`
+	Events      []models.EventType `json:"events"`
+}
+
+type createWebhookResponse struct {
+	models.Webhook
+	AccessToken string `json:"accessToken,omitempty"`
+}
+
+// validatePayloadOptions will return an error if a webhook payload template
+// or filter can't be used.
+func validatePayloadOptions(template string, filter string) error {
+	if err := webhooks.ValidateTemplate(template); err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	if err := webhooks.ValidateTemplate(filter); err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+	return nil
 }
 
 // CreateWebhook will add a single webhook.
@@ -32,20 +57,55 @@ func CreateWebhook(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := validatePayloadOptions(request.Template, request.Filter); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
 	webhooksrepo := webhookrepository.Get()
-	newWebhookID, err := webhooksrepo.InsertWebhook(request.URL, request.Events)
+
+	var newWebhookID int
+	var err error
+
+	switch request.Type {
+	case "", models.WebhookTypeStandard:
+		request.Type = models.WebhookTypeStandard
+		request.AccessToken = ""
+		newWebhookID, err = webhooksrepo.InsertWebhook(request.URL, request.Events)
+	case models.WebhookTypeChatBot:
+		// Chat bots act as an existing integration.
+		if userrepository.Get().GetIntegrationNameForAccessToken(request.AccessToken) == nil {
+			webutils.BadRequestHandler(w, errors.New("chat bots require a valid access token"))
+			return
+		}
+		newWebhookID, err = webhooksrepo.InsertChatBotWebhook(request.URL, request.Events, request.AccessToken)
+	default:
+		webutils.BadRequestHandler(w, errors.New("invalid webhook type provided"))
+		return
+	}
+
 	if err != nil {
 		webutils.InternalErrorHandler(w, err)
 		return
 	}
 
-	webutils.WriteResponse(w, models.Webhook{
-		ID:        newWebhookID,
-		URL:       request.URL,
-		Events:    request.Events,
-		Timestamp: time.Now(),
-		LastUsed:  nil,
-	})
+	if request.Template != "" || request.ContentType != "" || request.Filter != "" {
+		if err := webhooksrepo.SetWebhookPayloadOptions(newWebhookID, request.Template, request.ContentType, request.Filter); err != nil {
+			_ = webhooksrepo.DeleteWebhook(newWebhookID)
+			webutils.InternalErrorHandler(w, err)
+			return
+		}
+	}
+
+	// Respond with the stored webhook so the generated secret is included.
+	webhook, err := webhooksrepo.GetWebhook(newWebhookID)
+	if err != nil || webhook == nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	// The access token is only ever shown when the webhook is created.
+	webutils.WriteResponse(w, createWebhookResponse{Webhook: *webhook, AccessToken: webhook.AccessToken})
 }
 
 // GetWebhooks will return all webhooks.
@@ -79,6 +139,132 @@ func DeleteWebhook(w http.ResponseWriter, r *http.Request) {
 		webutils.InternalErrorHandler(w, err)
 		return
 	}
+	webhooks.ForgetPayloadTemplates(*request.Id)
 
 	webutils.WriteSimpleResponse(w, true, "deleted webhook")
 }
+
+// GetWebhookDeliveries will return a page of the delivery log for a webhook.
+func GetWebhookDeliveries(offset int, limit int, w http.ResponseWriter, r *http.Request) {
+	webhookID, err := strconv.Atoi(r.URL.Query().Get("webhookId"))
+	if err != nil {
+		webutils.BadRequestHandler(w, errors.New("a valid webhook id is required"))
+		return
+	}
+
+	deliveries, total, err := webhookrepository.Get().GetDeliveries(webhookID, offset, limit)
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	response := webutils.PaginatedResponse{
+		Total:   total,
+		Results: deliveries,
+	}
+
+	webutils.WriteResponse(w, response)
+}
+
+// ReplayWebhookDelivery will send the payload of a logged delivery to its
+// webhook again.
+func ReplayWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var request generated.ReplayWebhookDeliveryJSONBody
+	if err := decoder.Decode(&request); err != nil || request.Id == nil {
+		webutils.WriteSimpleResponse(w, false, "unable to replay delivery with provided values")
+		return
+	}
+
+	if err := webhooks.ReplayDelivery(*request.Id); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "replaying delivery")
+}
+
+// UpdateWebhookPayload will set the payload template and filter of a
+// webhook.
+func UpdateWebhookPayload(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var request generated.UpdateWebhookPayloadJSONBody
+	if err := decoder.Decode(&request); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	template, contentType, filter := "", "", ""
+	if request.Template != nil {
+		template = *request.Template
+	}
+	if request.ContentType != nil {
+		contentType = *request.ContentType
+	}
+	if request.Filter != nil {
+		filter = *request.Filter
+	}
+
+	if err := validatePayloadOptions(template, filter); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	if err := webhookrepository.Get().SetWebhookPayloadOptions(request.Id, template, contentType, filter); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+	webhooks.ForgetPayloadTemplates(request.Id)
+
+	webutils.WriteSimpleResponse(w, true, "updated webhook")
+}
+
+// SendWebhookTestEvent will send a webhook an example event and respond
+// with the payload it was sent.
+func SendWebhookTestEvent(w http.ResponseWriter, r *http.Request) {
+	if !requirePOST(w, r) {
+		return
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var request generated.SendWebhookTestEventJSONBody
+	if err := decoder.Decode(&request); err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	eventType := models.MessageSent
+	if request.EventType != nil {
+		eventType = string(*request.EventType)
+	}
+	if !models.HasValidEvents([]models.EventType{eventType}) {
+		webutils.BadRequestHandler(w, errors.New("invalid event provided"))
+		return
+	}
+
+	webhook, err := webhookrepository.Get().GetWebhook(request.Id)
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+	if webhook == nil {
+		webutils.BadRequestHandler(w, errors.New("webhook not found"))
+		return
+	}
+
+	result, err := webhooks.SendTestEvent(*webhook, eventType)
+	if err != nil {
+		webutils.BadRequestHandler(w, err)
+		return
+	}
+
+	webutils.WriteResponse(w, result)
+}
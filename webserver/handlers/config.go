@@ -32,6 +32,8 @@ type webConfigResponse struct {
 	ExternalActions            []models.ExternalAction      `json:"externalActions"`
 	Notifications              notificationsConfigResponse  `json:"notifications"`
 	Federation                 federationConfigResponse     `json:"federation"`
+	ChatModes                  models.ChatModes             `json:"chatModes"`
+	ChatUserDirectMessages     bool                         `json:"chatUserDirectMessagesEnabled"`
 	MaxSocketPayloadSize       int                          `json:"maxSocketPayloadSize"`
 	HideViewerCount            bool                         `json:"hideViewerCount"`
 	ChatDisabled               bool                         `json:"chatDisabled"`
@@ -51,8 +53,13 @@ type browserNotificationsConfigResponse struct {
 	Enabled   bool   `json:"enabled"`
 }
 
+type emailNotificationsConfigResponse struct {
+	Enabled bool `json:"enabled"`
+}
+
 type notificationsConfigResponse struct {
 	Browser browserNotificationsConfigResponse `json:"browser"`
+	Email   emailNotificationsConfigResponse   `json:"email"`
 }
 
 type authenticationConfigResponse struct {
@@ -109,11 +116,16 @@ func getConfigResponse() webConfigResponse {
 		browserPushEnabled = false
 	}
 
+	emailConfig := configRepository.GetEmailConfig()
+
 	notificationsResponse := notificationsConfigResponse{
 		Browser: browserNotificationsConfigResponse{
 			Enabled:   browserPushEnabled,
 			PublicKey: browserPushPublicKey,
 		},
+		Email: emailNotificationsConfigResponse{
+			Enabled: emailConfig.Enabled && emailConfig.AllowSubscriptions && configRepository.GetServerURL() != "",
+		},
 	}
 
 	authenticationResponse := authenticationConfigResponse{
@@ -134,6 +146,8 @@ func getConfigResponse() webConfigResponse {
 		SocialHandles:              socialHandles,
 		ChatDisabled:               configRepository.GetChatDisabled(),
 		ChatSpamProtectionDisabled: configRepository.GetChatSpamProtectionEnabled(),
+		ChatModes:                  configRepository.GetChatModes(),
+		ChatUserDirectMessages:     configRepository.GetChatUserDirectMessagesEnabled(),
 		ExternalActions:            configRepository.GetExternalActions(),
 		CustomStyles:               configRepository.GetCustomStyles(),
 		MaxSocketPayloadSize:       config.MaxSocketPayloadSize,
@@ -111,6 +111,14 @@ func (*ServerInterfaceImpl) SetSuggestedUsernameListOptions(w http.ResponseWrite
 	middleware.RequireAdminAuth(admin.SetSuggestedUsernameList)(w, r)
 }
 
+func (*ServerInterfaceImpl) SetChatUserDirectMessagesEnabled(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetChatUserDirectMessagesEnabled)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetChatUserDirectMessagesEnabledOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetChatUserDirectMessagesEnabled)(w, r)
+}
+
 func (*ServerInterfaceImpl) SetChatSpamProtectionEnabled(w http.ResponseWriter, r *http.Request) {
 	middleware.RequireAdminAuth(admin.SetChatSpamProtectionEnabled)(w, r)
 }
@@ -127,6 +135,30 @@ func (*ServerInterfaceImpl) SetChatSlurFilterEnabledOptions(w http.ResponseWrite
 	middleware.RequireAdminAuth(admin.SetChatSlurFilterEnabled)(w, r)
 }
 
+func (*ServerInterfaceImpl) SetChatCustomCommands(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetChatCustomCommands)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetChatCustomCommandsOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetChatCustomCommands)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetChatRetention(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetChatRetention)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetChatRetentionOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetChatRetention)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetChatReviewQueue(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetChatReviewQueue)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetChatReviewQueueOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetChatReviewQueue)(w, r)
+}
+
 func (*ServerInterfaceImpl) SetVideoCodec(w http.ResponseWriter, r *http.Request) {
 	middleware.RequireAdminAuth(admin.SetVideoCodec)(w, r)
 }
@@ -366,3 +398,83 @@ func (*ServerInterfaceImpl) SetBrowserNotificationConfiguration(w http.ResponseW
 func (*ServerInterfaceImpl) SetBrowserNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 	middleware.RequireAdminAuth(admin.SetBrowserNotificationConfiguration)(w, r)
 }
+
+func (*ServerInterfaceImpl) SetMatrixNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetMatrixNotificationConfiguration)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetMatrixNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetMatrixNotificationConfiguration)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetTelegramNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetTelegramNotificationConfiguration)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetTelegramNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetTelegramNotificationConfiguration)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNtfyNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNtfyNotificationConfiguration)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNtfyNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNtfyNotificationConfiguration)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetGotifyNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetGotifyNotificationConfiguration)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetGotifyNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetGotifyNotificationConfiguration)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetSlackNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetSlackNotificationConfiguration)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetSlackNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetSlackNotificationConfiguration)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetEmailNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetEmailNotificationConfiguration)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetEmailNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetEmailNotificationConfiguration)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetStreamReportChannel(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetStreamReportChannel)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetStreamReportChannelOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetStreamReportChannel)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNotificationRules(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNotificationRules)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetNotificationRulesOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetNotificationRules)(w, r)
+}
+
+func (*ServerInterfaceImpl) SendTestNotification(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SendTestNotification)(w, r)
+}
+
+func (*ServerInterfaceImpl) SendTestNotificationOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SendTestNotification)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetScheduleReminderMinutes(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetScheduleReminderMinutes)(w, r)
+}
+
+func (*ServerInterfaceImpl) SetScheduleReminderMinutesOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireAdminAuth(admin.SetScheduleReminderMinutes)(w, r)
+}
@@ -1,6 +1,6 @@
 // Package generated provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.3.0 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package generated
 
 import (
@@ -15,20 +15,144 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AlertRuleComparison.
+const (
+	Above AlertRuleComparison = "above"
+	Below AlertRuleComparison = "below"
+)
+
+// Defines values for AlertRuleMetric.
+const (
+	Cpu               AlertRuleMetric = "cpu"
+	Disk              AlertRuleMetric = "disk"
+	IngestBitrateDrop AlertRuleMetric = "ingestBitrateDrop"
+	Memory            AlertRuleMetric = "memory"
+	PlaybackErrors    AlertRuleMetric = "playbackErrors"
+	StreamHealth      AlertRuleMetric = "streamHealth"
+	TranscoderSpeed   AlertRuleMetric = "transcoderSpeed"
+)
+
+// Defines values for AlertRuleSeverity.
+const (
+	Critical AlertRuleSeverity = "critical"
+	Info     AlertRuleSeverity = "info"
+	Warning  AlertRuleSeverity = "warning"
+)
+
+// Defines values for ChatFilterRuleAction.
+const (
+	DROP    ChatFilterRuleAction = "DROP"
+	HIDE    ChatFilterRuleAction = "HIDE"
+	TIMEOUT ChatFilterRuleAction = "TIMEOUT"
+)
+
+// Defines values for ChatFilterRuleType.
+const (
+	ALLOWEDLINKS    ChatFilterRuleType = "ALLOWED_LINKS"
+	BLOCKEDLINKS    ChatFilterRuleType = "BLOCKED_LINKS"
+	BLOCKEDWORD     ChatFilterRuleType = "BLOCKED_WORD"
+	CAPSRATIO       ChatFilterRuleType = "CAPS_RATIO"
+	EMOJIRATIO      ChatFilterRuleType = "EMOJI_RATIO"
+	PATTERN         ChatFilterRuleType = "PATTERN"
+	REPEATEDMESSAGE ChatFilterRuleType = "REPEATED_MESSAGE"
+)
+
+// Defines values for ChatRetentionMode.
+const (
+	FOREVER  ChatRetentionMode = "FOREVER"
+	HOURS    ChatRetentionMode = "HOURS"
+	MESSAGES ChatRetentionMode = "MESSAGES"
+)
+
+// Defines values for ModerationActionAction.
+const (
+	ADDMODERATOR    ModerationActionAction = "ADD_MODERATOR"
+	APPROVEMESSAGE  ModerationActionAction = "APPROVE_MESSAGE"
+	BANIP           ModerationActionAction = "BAN_IP"
+	DISABLEUSER     ModerationActionAction = "DISABLE_USER"
+	ENABLEUSER      ModerationActionAction = "ENABLE_USER"
+	HIDEMESSAGE     ModerationActionAction = "HIDE_MESSAGE"
+	REJECTMESSAGE   ModerationActionAction = "REJECT_MESSAGE"
+	REMOVEMODERATOR ModerationActionAction = "REMOVE_MODERATOR"
+	REMOVETIMEOUT   ModerationActionAction = "REMOVE_TIMEOUT"
+	SHOWMESSAGE     ModerationActionAction = "SHOW_MESSAGE"
+	TIMEOUTUSER     ModerationActionAction = "TIMEOUT_USER"
+	UNBANIP         ModerationActionAction = "UNBAN_IP"
+)
+
+// Defines values for ModerationActionActorType.
+const (
+	ModerationActionActorTypeADMIN       ModerationActionActorType = "ADMIN"
+	ModerationActionActorTypeINTEGRATION ModerationActionActorType = "INTEGRATION"
+	ModerationActionActorTypeMODERATOR   ModerationActionActorType = "MODERATOR"
+)
+
+// Defines values for PollCreatedByType.
+const (
+	PollCreatedByTypeADMIN       PollCreatedByType = "ADMIN"
+	PollCreatedByTypeINTEGRATION PollCreatedByType = "INTEGRATION"
+	PollCreatedByTypeMODERATOR   PollCreatedByType = "MODERATOR"
+)
+
 // Defines values for WebhookEventType.
 const (
-	CHAT               WebhookEventType = "CHAT"
-	CHATACTION         WebhookEventType = "CHAT_ACTION"
-	NAMECHANGE         WebhookEventType = "NAME_CHANGE"
-	PING               WebhookEventType = "PING"
-	PONG               WebhookEventType = "PONG"
-	STREAMSTARTED      WebhookEventType = "STREAM_STARTED"
-	STREAMSTOPPED      WebhookEventType = "STREAM_STOPPED"
-	STREAMTITLEUPDATED WebhookEventType = "STREAM_TITLE_UPDATED"
-	SYSTEM             WebhookEventType = "SYSTEM"
-	USERJOINED         WebhookEventType = "USER_JOINED"
-	USERPARTED         WebhookEventType = "USER_PARTED"
-	VISIBILITYUPDATE   WebhookEventType = "VISIBILITY-UPDATE"
+	ALERTFIRED             WebhookEventType = "ALERT_FIRED"
+	ALERTRESOLVED          WebhookEventType = "ALERT_RESOLVED"
+	CHAT                   WebhookEventType = "CHAT"
+	CHATACTION             WebhookEventType = "CHAT_ACTION"
+	MESSAGEEDITED          WebhookEventType = "MESSAGE_EDITED"
+	MESSAGEREACTIONADDED   WebhookEventType = "MESSAGE_REACTION_ADDED"
+	MESSAGEREACTIONREMOVED WebhookEventType = "MESSAGE_REACTION_REMOVED"
+	NAMECHANGE             WebhookEventType = "NAME_CHANGE"
+	PING                   WebhookEventType = "PING"
+	POLLENDED              WebhookEventType = "POLL_ENDED"
+	POLLSTARTED            WebhookEventType = "POLL_STARTED"
+	PONG                   WebhookEventType = "PONG"
+	STREAMSTARTED          WebhookEventType = "STREAM_STARTED"
+	STREAMSTOPPED          WebhookEventType = "STREAM_STOPPED"
+	STREAMTITLEUPDATED     WebhookEventType = "STREAM_TITLE_UPDATED"
+	SYSTEM                 WebhookEventType = "SYSTEM"
+	USERJOINED             WebhookEventType = "USER_JOINED"
+	USERPARTED             WebhookEventType = "USER_PARTED"
+	VISIBILITYUPDATE       WebhookEventType = "VISIBILITY-UPDATE"
+)
+
+// Defines values for WebhookType.
+const (
+	CHATBOT  WebhookType = "CHAT_BOT"
+	STANDARD WebhookType = "STANDARD"
+)
+
+// Defines values for DownloadChatArchiveParamsFormat.
+const (
+	Html DownloadChatArchiveParamsFormat = "html"
+	Json DownloadChatArchiveParamsFormat = "json"
+	Txt  DownloadChatArchiveParamsFormat = "txt"
+)
+
+// Defines values for SearchChatMessagesAdminParamsVisibility.
+const (
+	SearchChatMessagesAdminParamsVisibilityAll     SearchChatMessagesAdminParamsVisibility = "all"
+	SearchChatMessagesAdminParamsVisibilityHidden  SearchChatMessagesAdminParamsVisibility = "hidden"
+	SearchChatMessagesAdminParamsVisibilityVisible SearchChatMessagesAdminParamsVisibility = "visible"
+)
+
+// Defines values for SendTestNotificationJSONBodyChannel.
+const (
+	DISCORD  SendTestNotificationJSONBodyChannel = "DISCORD"
+	EMAIL    SendTestNotificationJSONBodyChannel = "EMAIL"
+	GOTIFY   SendTestNotificationJSONBodyChannel = "GOTIFY"
+	MATRIX   SendTestNotificationJSONBodyChannel = "MATRIX"
+	NTFY     SendTestNotificationJSONBodyChannel = "NTFY"
+	SLACK    SendTestNotificationJSONBodyChannel = "SLACK"
+	TELEGRAM SendTestNotificationJSONBodyChannel = "TELEGRAM"
+)
+
+// Defines values for SearchChatMessagesParamsVisibility.
+const (
+	SearchChatMessagesParamsVisibilityAll     SearchChatMessagesParamsVisibility = "all"
+	SearchChatMessagesParamsVisibilityHidden  SearchChatMessagesParamsVisibility = "hidden"
+	SearchChatMessagesParamsVisibilityVisible SearchChatMessagesParamsVisibility = "visible"
 )
 
 // ActionMessage defines model for ActionMessage.
@@ -82,35 +206,53 @@ type AdminLog struct {
 type AdminNotificationsConfig struct {
 	Browser *BrowserNotificationConfiguration `json:"browser,omitempty"`
 	Discord *DiscordNotificationConfiguration `json:"discord,omitempty"`
+	Email   *EmailNotificationConfiguration   `json:"email,omitempty"`
+	Gotify  *GotifyNotificationConfiguration  `json:"gotify,omitempty"`
+	Matrix  *MatrixNotificationConfiguration  `json:"matrix,omitempty"`
+	Ntfy    *NtfyNotificationConfiguration    `json:"ntfy,omitempty"`
+
+	// ReportChannel The channel end of stream reports are sent to
+	ReportChannel *string                            `json:"reportChannel,omitempty"`
+	Rules         *map[string]NotificationRule       `json:"rules,omitempty"`
+	Slack         *SlackNotificationConfiguration    `json:"slack,omitempty"`
+	Telegram      *TelegramNotificationConfiguration `json:"telegram,omitempty"`
 }
 
 // AdminServerConfig defines model for AdminServerConfig.
 type AdminServerConfig struct {
-	AdminPassword           *string                   `json:"adminPassword,omitempty"`
-	ChatDisabled            *bool                     `json:"chatDisabled,omitempty"`
-	ChatEstablishedUserMode *bool                     `json:"chatEstablishedUserMode,omitempty"`
-	ChatJoinMessagesEnabled *bool                     `json:"chatJoinMessagesEnabled,omitempty"`
-	DisableSearchIndexing   *bool                     `json:"disableSearchIndexing,omitempty"`
-	ExternalActions         *[]ExternalAction         `json:"externalActions,omitempty"`
-	Federation              *AdminFederationConfig    `json:"federation,omitempty"`
-	FfmpegPath              *string                   `json:"ffmpegPath,omitempty"`
-	ForbiddenUsernames      *[]string                 `json:"forbiddenUsernames,omitempty"`
-	HideViewerCount         *bool                     `json:"hideViewerCount,omitempty"`
-	InstanceDetails         *AdminWebConfig           `json:"instanceDetails,omitempty"`
-	Notifications           *AdminNotificationsConfig `json:"notifications,omitempty"`
-	RtmpServerPort          *int                      `json:"rtmpServerPort,omitempty"`
-	S3                      *S3Info                   `json:"s3,omitempty"`
-	SocketHostOverride      *string                   `json:"socketHostOverride,omitempty"`
-	StreamKeyOverridden     *bool                     `json:"streamKeyOverridden,omitempty"`
-	StreamKeys              *[]StreamKey              `json:"streamKeys,omitempty"`
-	SuggestedUsernames      *[]string                 `json:"suggestedUsernames,omitempty"`
-	SupportedCodecs         *[]string                 `json:"supportedCodecs,omitempty"`
-	VideoCodec              *string                   `json:"videoCodec,omitempty"`
-	VideoServingEndpoint    *string                   `json:"videoServingEndpoint,omitempty"`
-	VideoSettings           *AdminVideoSettings       `json:"videoSettings,omitempty"`
-	WebServerIP             *string                   `json:"webServerIP,omitempty"`
-	WebServerPort           *int                      `json:"webServerPort,omitempty"`
-	Yp                      *AdminYPInfo              `json:"yp,omitempty"`
+	AdminPassword           *string        `json:"adminPassword,omitempty"`
+	AlertRules              *[]AlertRule   `json:"alertRules,omitempty"`
+	ChatCustomCommands      *[]ChatCommand `json:"chatCustomCommands,omitempty"`
+	ChatDisabled            *bool          `json:"chatDisabled,omitempty"`
+	ChatEstablishedUserMode *bool          `json:"chatEstablishedUserMode,omitempty"`
+	ChatJoinMessagesEnabled *bool          `json:"chatJoinMessagesEnabled,omitempty"`
+
+	// ChatRetention How long chat messages are kept and if a broadcast's chat is archived when it ends
+	ChatRetention         *ChatRetention            `json:"chatRetention,omitempty"`
+	DisableSearchIndexing *bool                     `json:"disableSearchIndexing,omitempty"`
+	ExternalActions       *[]ExternalAction         `json:"externalActions,omitempty"`
+	Federation            *AdminFederationConfig    `json:"federation,omitempty"`
+	FfmpegPath            *string                   `json:"ffmpegPath,omitempty"`
+	ForbiddenUsernames    *[]string                 `json:"forbiddenUsernames,omitempty"`
+	HideViewerCount       *bool                     `json:"hideViewerCount,omitempty"`
+	InstanceDetails       *AdminWebConfig           `json:"instanceDetails,omitempty"`
+	Notifications         *AdminNotificationsConfig `json:"notifications,omitempty"`
+	RtmpServerPort        *int                      `json:"rtmpServerPort,omitempty"`
+	S3                    *S3Info                   `json:"s3,omitempty"`
+
+	// ScheduleReminderMinutes How many minutes before a scheduled stream a reminder is sent.
+	ScheduleReminderMinutes *int                `json:"scheduleReminderMinutes,omitempty"`
+	SocketHostOverride      *string             `json:"socketHostOverride,omitempty"`
+	StreamKeyOverridden     *bool               `json:"streamKeyOverridden,omitempty"`
+	StreamKeys              *[]StreamKey        `json:"streamKeys,omitempty"`
+	SuggestedUsernames      *[]string           `json:"suggestedUsernames,omitempty"`
+	SupportedCodecs         *[]string           `json:"supportedCodecs,omitempty"`
+	VideoCodec              *string             `json:"videoCodec,omitempty"`
+	VideoServingEndpoint    *string             `json:"videoServingEndpoint,omitempty"`
+	VideoSettings           *AdminVideoSettings `json:"videoSettings,omitempty"`
+	WebServerIP             *string             `json:"webServerIP,omitempty"`
+	WebServerPort           *int                `json:"webServerPort,omitempty"`
+	Yp                      *AdminYPInfo        `json:"yp,omitempty"`
 }
 
 // AdminStatus defines model for AdminStatus.
@@ -156,6 +298,56 @@ type AdminYPInfo struct {
 	InstanceUrl *string `json:"instanceUrl,omitempty"`
 }
 
+// Alert A single time an alert rule fired.
+type Alert struct {
+	Comparison *string    `json:"comparison,omitempty"`
+	FiredAt    *time.Time `json:"firedAt,omitempty"`
+	Id         *string    `json:"id,omitempty"`
+	Metric     *string    `json:"metric,omitempty"`
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+	RuleId     *string    `json:"ruleId,omitempty"`
+	Severity   *string    `json:"severity,omitempty"`
+	Threshold  *float32   `json:"threshold,omitempty"`
+
+	// Value The metric's value when the alert fired.
+	Value *float32 `json:"value,omitempty"`
+}
+
+// AlertRule A condition on a collected metric that fires an alert once it has held for a duration.
+type AlertRule struct {
+	// Channel The notification channel to send the alert through, such as EMAIL or SLACK.
+	Channel    *string              `json:"channel,omitempty"`
+	Comparison *AlertRuleComparison `json:"comparison,omitempty"`
+
+	// DurationSeconds How long the condition must hold before the alert fires.
+	DurationSeconds *int    `json:"durationSeconds,omitempty"`
+	Enabled         *bool   `json:"enabled,omitempty"`
+	Id              *string `json:"id,omitempty"`
+
+	// Metric Utilization metrics are percentages, playbackErrors is the errors viewers reported in the last two minutes, streamHealth is the percentage of viewers with healthy playback, transcoderSpeed is relative to real time and ingestBitrateDrop is the percentage the inbound bitrate is below its average for the stream.
+	Metric    *AlertRuleMetric   `json:"metric,omitempty"`
+	Severity  *AlertRuleSeverity `json:"severity,omitempty"`
+	Threshold *float32           `json:"threshold,omitempty"`
+
+	// Webhooks Send ALERT_FIRED and ALERT_RESOLVED events to webhooks.
+	Webhooks *bool `json:"webhooks,omitempty"`
+}
+
+// AlertRuleComparison defines model for AlertRule.Comparison.
+type AlertRuleComparison string
+
+// AlertRuleMetric Utilization metrics are percentages, playbackErrors is the errors viewers reported in the last two minutes, streamHealth is the percentage of viewers with healthy playback, transcoderSpeed is relative to real time and ingestBitrateDrop is the percentage the inbound bitrate is below its average for the stream.
+type AlertRuleMetric string
+
+// AlertRuleSeverity defines model for AlertRule.Severity.
+type AlertRuleSeverity string
+
+// AnalyticsCount The number of viewers that share a value
+type AnalyticsCount struct {
+	Count *int    `json:"count,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
 // AnonymousUser defines model for AnonymousUser.
 type AnonymousUser struct {
 	AccessToken *string `json:"accessToken,omitempty"`
@@ -174,6 +366,26 @@ type BaseAPIResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// Broadcast The history of a single streaming session
+type Broadcast struct {
+	AverageViewerCount *float32     `json:"averageViewerCount,omitempty"`
+	Broadcaster        *Broadcaster `json:"broadcaster,omitempty"`
+	ChatMessageCount   *int         `json:"chatMessageCount,omitempty"`
+
+	// ChatterCount The number of different users who chatted
+	ChatterCount *int `json:"chatterCount,omitempty"`
+
+	// EndedAt Missing while the broadcast is live
+	EndedAt         *time.Time             `json:"endedAt,omitempty"`
+	Id              *string                `json:"id,omitempty"`
+	LatencyLevel    *int                   `json:"latencyLevel,omitempty"`
+	OutputSettings  *[]StreamOutputVariant `json:"outputSettings,omitempty"`
+	PeakViewerCount *int                   `json:"peakViewerCount,omitempty"`
+	StartedAt       *time.Time             `json:"startedAt,omitempty"`
+	Tags            *[]string              `json:"tags,omitempty"`
+	Title           *string                `json:"title,omitempty"`
+}
+
 // Broadcaster defines model for Broadcaster.
 type Broadcaster struct {
 	RemoteAddr    *string               `json:"remoteAddr,omitempty"`
@@ -193,6 +405,19 @@ type BrowserNotificationConfiguration struct {
 	GoLiveMessage *string `json:"goLiveMessage,omitempty"`
 }
 
+// ChatArchive The saved chat of a single broadcast
+type ChatArchive struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// EndedAt When the broadcast ended
+	EndedAt      *time.Time `json:"endedAt,omitempty"`
+	Id           *string    `json:"id,omitempty"`
+	MessageCount *int       `json:"messageCount,omitempty"`
+
+	// StartedAt When the broadcast started
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+}
+
 // ChatClient defines model for ChatClient.
 type ChatClient struct {
 	ConnectedAt  *time.Time  `json:"connectedAt,omitempty"`
@@ -205,6 +430,42 @@ type ChatClient struct {
 // ChatClients defines model for ChatClients.
 type ChatClients = []ChatClient
 
+// ChatCommand An admin defined chat command that replies to chat with a configured message
+type ChatCommand struct {
+	// ModeratorOnly Only allow moderators to run the command.
+	ModeratorOnly *bool `json:"moderatorOnly,omitempty"`
+
+	// Name What is typed after the slash to run the command. Up to 32 letters, numbers, dashes and underscores.
+	Name *string `json:"name,omitempty"`
+
+	// Response The markdown sent to chat when the command is run.
+	Response *string `json:"response,omitempty"`
+}
+
+// ChatFilterRule An admin managed rule applied to chat messages
+type ChatFilterRule struct {
+	Action    *ChatFilterRuleAction `json:"action,omitempty"`
+	CreatedAt *time.Time            `json:"createdAt,omitempty"`
+	Enabled   *bool                 `json:"enabled,omitempty"`
+	Id        *int                  `json:"id,omitempty"`
+
+	// Threshold The caps or emoji ratio between 0 and 1, or the number of repeated messages.
+	Threshold *float32 `json:"threshold,omitempty"`
+
+	// TimeoutSeconds How long to time out the sender for when the action is TIMEOUT, up to 7 days.
+	TimeoutSeconds *int                `json:"timeoutSeconds,omitempty"`
+	Type           *ChatFilterRuleType `json:"type,omitempty"`
+
+	// Value The blocked word, the regular expression, or a comma separated list of domains.
+	Value *string `json:"value,omitempty"`
+}
+
+// ChatFilterRuleAction defines model for ChatFilterRule.Action.
+type ChatFilterRuleAction string
+
+// ChatFilterRuleType defines model for ChatFilterRule.Type.
+type ChatFilterRuleType string
+
 // ChatMessages defines model for ChatMessages.
 type ChatMessages = []ChatMessages_Item
 
@@ -213,6 +474,48 @@ type ChatMessages_Item struct {
 	union json.RawMessage
 }
 
+// ChatModes Moderation modes applied to chat for the current broadcast
+type ChatModes struct {
+	// AuthenticatedOnly Only authenticated users can send messages.
+	AuthenticatedOnly *bool `json:"authenticatedOnly,omitempty"`
+
+	// EmoteOnly Only messages made up entirely of emoji are allowed.
+	EmoteOnly *bool `json:"emoteOnly,omitempty"`
+
+	// SlowModeSeconds Minimum number of seconds between messages from a single user. 0 disables slow mode.
+	SlowModeSeconds *int `json:"slowModeSeconds,omitempty"`
+}
+
+// ChatRetention How long chat messages are kept and if a broadcast's chat is archived when it ends
+type ChatRetention struct {
+	// ArchiveBroadcasts Save the chat of each broadcast when it ends.
+	ArchiveBroadcasts *bool `json:"archiveBroadcasts,omitempty"`
+
+	// Hours How many hours of messages are kept in the HOURS mode.
+	Hours *int `json:"hours,omitempty"`
+
+	// Messages How many messages are kept in the MESSAGES mode.
+	Messages *int `json:"messages,omitempty"`
+
+	// Mode Keep messages for a number of hours, keep a number of the most recent messages, or never remove messages.
+	Mode *ChatRetentionMode `json:"mode,omitempty"`
+}
+
+// ChatRetentionMode Keep messages for a number of hours, keep a number of the most recent messages, or never remove messages.
+type ChatRetentionMode string
+
+// ChatReviewQueue Which chat messages are held for a moderator to review before they are sent
+type ChatReviewQueue struct {
+	// HoldFilterMatches Hold messages matching a chat filter rule with the HIDE action.
+	HoldFilterMatches *bool `json:"holdFilterMatches,omitempty"`
+
+	// HoldLinks Hold messages that contain links.
+	HoldLinks *bool `json:"holdLinks,omitempty"`
+
+	// HoldNewUserMessages Hold the first message from a new user.
+	HoldNewUserMessages *bool `json:"holdNewUserMessages,omitempty"`
+}
+
 // CollectedMetrics defines model for CollectedMetrics.
 type CollectedMetrics struct {
 	Cpu    *[]TimestampedValue `json:"cpu,omitempty"`
@@ -222,10 +525,20 @@ type CollectedMetrics struct {
 
 // CurrentBroadcast defines model for CurrentBroadcast.
 type CurrentBroadcast struct {
+	// Id The broadcast's entry in the broadcast history
+	Id             *string                `json:"id,omitempty"`
 	LatencyLevel   *LatencyLevel          `json:"latencyLevel,omitempty"`
 	OutputSettings *[]StreamOutputVariant `json:"outputSettings,omitempty"`
 }
 
+// DirectMessageRequest defines model for DirectMessageRequest.
+type DirectMessageRequest struct {
+	Body string `json:"body"`
+
+	// UserId The ID of the chat user to send the message to.
+	UserId string `json:"userId"`
+}
+
 // DiscordNotificationConfiguration defines model for DiscordNotificationConfiguration.
 type DiscordNotificationConfiguration struct {
 	Enabled       *bool   `json:"enabled,omitempty"`
@@ -233,6 +546,22 @@ type DiscordNotificationConfiguration struct {
 	Webhook       *string `json:"webhook,omitempty"`
 }
 
+// EmailNotificationConfiguration defines model for EmailNotificationConfiguration.
+type EmailNotificationConfiguration struct {
+	// AllowSubscriptions Lets viewers subscribe their own address with a confirmation email.
+	AllowSubscriptions *bool     `json:"allowSubscriptions,omitempty"`
+	Enabled            *bool     `json:"enabled,omitempty"`
+	FromAddress        *string   `json:"fromAddress,omitempty"`
+	GoLiveMessage      *string   `json:"goLiveMessage,omitempty"`
+	Password           *string   `json:"password,omitempty"`
+	Recipients         *[]string `json:"recipients,omitempty"`
+	SmtpHost           *string   `json:"smtpHost,omitempty"`
+
+	// SmtpPort Port 465 uses implicit TLS, others use STARTTLS when offered. Defaults to 587.
+	SmtpPort *int    `json:"smtpPort,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
 // Emoji Name and url for an emoji
 type Emoji struct {
 	// Name The name of the emoji
@@ -245,6 +574,12 @@ type Emoji struct {
 // Emojis defines model for Emojis.
 type Emojis = []Emoji
 
+// EndPollRequest defines model for EndPollRequest.
+type EndPollRequest struct {
+	// Id The ID of the poll to end.
+	Id string `json:"id"`
+}
+
 // Error Structure for an error response
 type Error struct {
 	Error *string `json:"error,omitempty"`
@@ -338,6 +673,47 @@ type GeoDetails struct {
 	TimeZone    *string `json:"timeZone,omitempty"`
 }
 
+// GotifyNotificationConfiguration defines model for GotifyNotificationConfiguration.
+type GotifyNotificationConfiguration struct {
+	AppToken      *string `json:"appToken,omitempty"`
+	Enabled       *bool   `json:"enabled,omitempty"`
+	GoLiveMessage *string `json:"goLiveMessage,omitempty"`
+	ServerUrl     *string `json:"serverUrl,omitempty"`
+}
+
+// HeldMessage defines model for HeldMessage.
+type HeldMessage struct {
+	Body     *string `json:"body,omitempty"`
+	ClientId *int    `json:"clientId,omitempty"`
+
+	// DeletedAt When the message was deleted by its sender. Only returned to moderators.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
+	// EditHistory The previous versions of an edited message. Only returned to moderators.
+	EditHistory *[]MessageEdit `json:"editHistory,omitempty"`
+
+	// EditedAt When the message was last edited by its sender.
+	EditedAt  *time.Time          `json:"editedAt,omitempty"`
+	HiddenAt  *string             `json:"hiddenAt,omitempty"`
+	Id        *string             `json:"id,omitempty"`
+	Reactions *[]MessageReactions `json:"reactions,omitempty"`
+
+	// Reason Why the message was held for review.
+	Reason *string `json:"reason,omitempty"`
+
+	// ReplyTo The ID of the message this message is a reply to.
+	ReplyTo   *string `json:"replyTo,omitempty"`
+	Timestamp *string `json:"timestamp,omitempty"`
+	Type      *string `json:"type,omitempty"`
+	User      *User   `json:"user,omitempty"`
+}
+
+// HeldMessageReview defines model for HeldMessageReview.
+type HeldMessageReview struct {
+	Approved  *bool   `json:"approved,omitempty"`
+	MessageId *string `json:"messageId,omitempty"`
+}
+
 // IPAddress defines model for IPAddress.
 type IPAddress struct {
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
@@ -392,17 +768,68 @@ type LatencyLevel struct {
 	Level *int `json:"level,omitempty"`
 }
 
+// MatrixNotificationConfiguration defines model for MatrixNotificationConfiguration.
+type MatrixNotificationConfiguration struct {
+	AccessToken   *string `json:"accessToken,omitempty"`
+	Enabled       *bool   `json:"enabled,omitempty"`
+	GoLiveMessage *string `json:"goLiveMessage,omitempty"`
+
+	// Homeserver The homeserver URL, e.g. https://matrix.org
+	Homeserver *string `json:"homeserver,omitempty"`
+	RoomId     *string `json:"roomId,omitempty"`
+}
+
+// MessageEdit A previous version of an edited chat message
+type MessageEdit struct {
+	Body *string `json:"body,omitempty"`
+
+	// EditedAt When this version of the message was replaced.
+	EditedAt *time.Time `json:"editedAt,omitempty"`
+}
+
 // MessageEvent defines model for MessageEvent.
 type MessageEvent struct {
 	Body *string `json:"body,omitempty"`
 }
 
+// MessageReactions The users that reacted to a chat message with a single emoji
+type MessageReactions struct {
+	Count *int `json:"count,omitempty"`
+
+	// Emoji A unicode emoji or the name of a custom emoji.
+	Emoji   *string   `json:"emoji,omitempty"`
+	UserIds *[]string `json:"userIds,omitempty"`
+}
+
 // MessageVisibilityUpdate defines model for MessageVisibilityUpdate.
 type MessageVisibilityUpdate struct {
 	IdArray *[]string `json:"idArray,omitempty"`
-	Visible *bool     `json:"visible,omitempty"`
+
+	// Reason An optional reason recorded in the moderation log.
+	Reason  *string `json:"reason,omitempty"`
+	Visible *bool   `json:"visible,omitempty"`
 }
 
+// ModerationAction A single entry in the moderation log
+type ModerationAction struct {
+	Action    *ModerationActionAction    `json:"action,omitempty"`
+	ActorId   *string                    `json:"actorId,omitempty"`
+	ActorName *string                    `json:"actorName,omitempty"`
+	ActorType *ModerationActionActorType `json:"actorType,omitempty"`
+	Id        *int                       `json:"id,omitempty"`
+	Reason    *string                    `json:"reason,omitempty"`
+
+	// Target The message ID, user ID or IP address the action was taken against.
+	Target    *string    `json:"target,omitempty"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// ModerationActionAction defines model for ModerationAction.Action.
+type ModerationActionAction string
+
+// ModerationActionActorType defines model for ModerationAction.ActorType.
+type ModerationActionActorType string
+
 // ModerationConnectedClient defines model for ModerationConnectedClient.
 type ModerationConnectedClient struct {
 	ConnectedAt  *time.Time `json:"connectedAt,omitempty"`
@@ -422,6 +849,61 @@ type ModerationUserDetails struct {
 // NotificationConfig defines model for NotificationConfig.
 type NotificationConfig struct {
 	Browser *BrowserConfig `json:"browser,omitempty"`
+	Email   *struct {
+		// Enabled If viewers can subscribe to go-live emails.
+		Enabled *bool `json:"enabled,omitempty"`
+	} `json:"email,omitempty"`
+}
+
+// NotificationRule Controls when and how a channel announces that the stream has gone live.
+type NotificationRule struct {
+	// MessageTemplate Replaces the channel's go-live message. May use {{.StreamTitle}}, {{.ServerName}}, {{.ServerURL}} and {{.Summary}}.
+	MessageTemplate *string `json:"messageTemplate,omitempty"`
+
+	// MinIntervalMinutes The least time between two go-live notices.
+	MinIntervalMinutes *int `json:"minIntervalMinutes,omitempty"`
+
+	// MinUptimeMinutes How long the stream must stay up before the notice is sent.
+	MinUptimeMinutes *int `json:"minUptimeMinutes,omitempty"`
+
+	// QuietHoursEnd An HH:MM time when notices resume. The range may cross midnight.
+	QuietHoursEnd *string `json:"quietHoursEnd,omitempty"`
+
+	// QuietHoursStart An HH:MM time after which no notices are sent.
+	QuietHoursStart *string `json:"quietHoursStart,omitempty"`
+
+	// TimeZone The IANA time zone quiet hours are in. Defaults to the server's local time.
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// NtfyNotificationConfiguration defines model for NtfyNotificationConfiguration.
+type NtfyNotificationConfiguration struct {
+	// AccessToken Only needed for protected topics.
+	AccessToken   *string `json:"accessToken,omitempty"`
+	Enabled       *bool   `json:"enabled,omitempty"`
+	GoLiveMessage *string `json:"goLiveMessage,omitempty"`
+
+	// ServerUrl The ntfy server, e.g. https://ntfy.sh
+	ServerUrl *string `json:"serverUrl,omitempty"`
+	Topic     *string `json:"topic,omitempty"`
+}
+
+// PaginatedAlerts defines model for PaginatedAlerts.
+type PaginatedAlerts struct {
+	Results *[]Alert `json:"results,omitempty"`
+	Total   *int     `json:"total,omitempty"`
+}
+
+// PaginatedBroadcasts defines model for PaginatedBroadcasts.
+type PaginatedBroadcasts struct {
+	Results *[]Broadcast `json:"results,omitempty"`
+	Total   *int         `json:"total,omitempty"`
+}
+
+// PaginatedChatMessages defines model for PaginatedChatMessages.
+type PaginatedChatMessages struct {
+	Results *[]UserMessage `json:"results,omitempty"`
+	Total   *int           `json:"total,omitempty"`
 }
 
 // PaginatedFederatedActivity defines model for PaginatedFederatedActivity.
@@ -436,6 +918,24 @@ type PaginatedFollowers struct {
 	Total   *int       `json:"total,omitempty"`
 }
 
+// PaginatedModerationActions defines model for PaginatedModerationActions.
+type PaginatedModerationActions struct {
+	Results *[]ModerationAction `json:"results,omitempty"`
+	Total   *int                `json:"total,omitempty"`
+}
+
+// PaginatedPolls defines model for PaginatedPolls.
+type PaginatedPolls struct {
+	Results *[]Poll `json:"results,omitempty"`
+	Total   *int    `json:"total,omitempty"`
+}
+
+// PaginatedWebhookDeliveries defines model for PaginatedWebhookDeliveries.
+type PaginatedWebhookDeliveries struct {
+	Results *[]WebhookDelivery `json:"results,omitempty"`
+	Total   *int               `json:"total,omitempty"`
+}
+
 // PlaybackMetrics defines model for PlaybackMetrics.
 type PlaybackMetrics struct {
 	Bandwidth             *float64 `json:"bandwidth,omitempty"`
@@ -445,6 +945,41 @@ type PlaybackMetrics struct {
 	QualityVariantChanges *float64 `json:"qualityVariantChanges,omitempty"`
 }
 
+// Poll defines model for Poll.
+type Poll struct {
+	CreatedById   *string            `json:"createdById,omitempty"`
+	CreatedByName *string            `json:"createdByName,omitempty"`
+	CreatedByType *PollCreatedByType `json:"createdByType,omitempty"`
+
+	// EndedAt When the poll stopped accepting votes. Not set while the poll is running.
+	EndedAt    *time.Time    `json:"endedAt,omitempty"`
+	EndsAt     *time.Time    `json:"endsAt,omitempty"`
+	Id         *string       `json:"id,omitempty"`
+	Options    *[]PollOption `json:"options,omitempty"`
+	Question   *string       `json:"question,omitempty"`
+	StartedAt  *time.Time    `json:"startedAt,omitempty"`
+	TotalVotes *int          `json:"totalVotes,omitempty"`
+}
+
+// PollCreatedByType defines model for Poll.CreatedByType.
+type PollCreatedByType string
+
+// PollOption defines model for PollOption.
+type PollOption struct {
+	Text  *string `json:"text,omitempty"`
+	Votes *int    `json:"votes,omitempty"`
+}
+
+// PollRequest defines model for PollRequest.
+type PollRequest struct {
+	// DurationSeconds How long the poll accepts votes, between 10 seconds and 24 hours.
+	DurationSeconds int `json:"durationSeconds"`
+
+	// Options Between 2 and 10 options to vote for.
+	Options  []string `json:"options"`
+	Question string   `json:"question"`
+}
+
 // S3Info defines model for S3Info.
 type S3Info struct {
 	AccessKey      *string `json:"accessKey,omitempty"`
@@ -458,6 +993,38 @@ type S3Info struct {
 	Secret         *string `json:"secret,omitempty"`
 }
 
+// ScheduledStream defines model for ScheduledStream.
+type ScheduledStream struct {
+	CreatedAt      *time.Time `json:"createdAt,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	Id             *string    `json:"id,omitempty"`
+	ReminderSentAt *time.Time `json:"reminderSentAt,omitempty"`
+	StartTime      *time.Time `json:"startTime,omitempty"`
+
+	// Thumbnail An optional image URL.
+	Thumbnail *string `json:"thumbnail,omitempty"`
+	Title     *string `json:"title,omitempty"`
+}
+
+// ScheduledStreamRequest defines model for ScheduledStreamRequest.
+type ScheduledStreamRequest struct {
+	Description *string   `json:"description,omitempty"`
+	StartTime   time.Time `json:"startTime"`
+
+	// Thumbnail An optional http or https image URL.
+	Thumbnail *string `json:"thumbnail,omitempty"`
+	Title     string  `json:"title"`
+}
+
+// SlackNotificationConfiguration defines model for SlackNotificationConfiguration.
+type SlackNotificationConfiguration struct {
+	Enabled       *bool   `json:"enabled,omitempty"`
+	GoLiveMessage *string `json:"goLiveMessage,omitempty"`
+
+	// Webhook A Slack incoming webhook URL.
+	Webhook *string `json:"webhook,omitempty"`
+}
+
 // SocialHandle defines model for SocialHandle.
 type SocialHandle struct {
 	Icon     *string `json:"icon,omitempty"`
@@ -503,6 +1070,39 @@ type StreamOutputVariant struct {
 	VideoPassthrough *bool   `json:"videoPassthrough,omitempty"`
 }
 
+// StreamReport The summary of a single broadcast, generated when the stream ends
+type StreamReport struct {
+	AverageViewerCount *float32   `json:"averageViewerCount,omitempty"`
+	ChatMessageCount   *int       `json:"chatMessageCount,omitempty"`
+	CreatedAt          *time.Time `json:"createdAt,omitempty"`
+	DurationSeconds    *int       `json:"durationSeconds,omitempty"`
+
+	// Encoder How the broadcaster's encoder and the server held up during a broadcast
+	Encoder *StreamReportEncoder `json:"encoder,omitempty"`
+	EndedAt *time.Time           `json:"endedAt,omitempty"`
+	Id      *string              `json:"id,omitempty"`
+
+	// NewFollowerCount Fediverse followers gained during the broadcast
+	NewFollowerCount *int       `json:"newFollowerCount,omitempty"`
+	PeakViewerCount  *int       `json:"peakViewerCount,omitempty"`
+	StartedAt        *time.Time `json:"startedAt,omitempty"`
+	StreamTitle      *string    `json:"streamTitle,omitempty"`
+}
+
+// StreamReportEncoder How the broadcaster's encoder and the server held up during a broadcast
+type StreamReportEncoder struct {
+	AverageCpuUsage *float32 `json:"averageCpuUsage,omitempty"`
+	Framerate       *float32 `json:"framerate,omitempty"`
+	HealthMessage   *string  `json:"healthMessage,omitempty"`
+
+	// HealthyPercentage The percentage of viewers without playback problems when the stream ended
+	HealthyPercentage *int    `json:"healthyPercentage,omitempty"`
+	Height            *int    `json:"height,omitempty"`
+	Name              *string `json:"name,omitempty"`
+	VideoBitrate      *int    `json:"videoBitrate,omitempty"`
+	Width             *int    `json:"width,omitempty"`
+}
+
 // SystemMessage defines model for SystemMessage.
 type SystemMessage struct {
 	Body      *string `json:"body,omitempty"`
@@ -511,12 +1111,31 @@ type SystemMessage struct {
 	Type      *string `json:"type,omitempty"`
 }
 
+// TelegramNotificationConfiguration defines model for TelegramNotificationConfiguration.
+type TelegramNotificationConfiguration struct {
+	BotToken      *string `json:"botToken,omitempty"`
+	ChatId        *string `json:"chatId,omitempty"`
+	Enabled       *bool   `json:"enabled,omitempty"`
+	GoLiveMessage *string `json:"goLiveMessage,omitempty"`
+}
+
 // TimestampedValue defines model for TimestampedValue.
 type TimestampedValue struct {
 	Time  *time.Time `json:"time,omitempty"`
 	Value *float64   `json:"value,omitempty"`
 }
 
+// UpdateScheduledStreamRequest defines model for UpdateScheduledStreamRequest.
+type UpdateScheduledStreamRequest struct {
+	Description *string   `json:"description,omitempty"`
+	Id          string    `json:"id"`
+	StartTime   time.Time `json:"startTime"`
+
+	// Thumbnail An optional http or https image URL.
+	Thumbnail *string `json:"thumbnail,omitempty"`
+	Title     string  `json:"title"`
+}
+
 // User defines model for User.
 type User struct {
 	Authenticated *bool     `json:"authenticated,omitempty"`
@@ -540,15 +1159,44 @@ type UserEvent struct {
 
 // UserMessage defines model for UserMessage.
 type UserMessage struct {
-	Body      *string `json:"body,omitempty"`
-	ClientId  *int    `json:"clientId,omitempty"`
-	HiddenAt  *string `json:"hiddenAt,omitempty"`
-	Id        *string `json:"id,omitempty"`
+	Body     *string `json:"body,omitempty"`
+	ClientId *int    `json:"clientId,omitempty"`
+
+	// DeletedAt When the message was deleted by its sender. Only returned to moderators.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+
+	// EditHistory The previous versions of an edited message. Only returned to moderators.
+	EditHistory *[]MessageEdit `json:"editHistory,omitempty"`
+
+	// EditedAt When the message was last edited by its sender.
+	EditedAt  *time.Time          `json:"editedAt,omitempty"`
+	HiddenAt  *string             `json:"hiddenAt,omitempty"`
+	Id        *string             `json:"id,omitempty"`
+	Reactions *[]MessageReactions `json:"reactions,omitempty"`
+
+	// ReplyTo The ID of the message this message is a reply to.
+	ReplyTo   *string `json:"replyTo,omitempty"`
 	Timestamp *string `json:"timestamp,omitempty"`
 	Type      *string `json:"type,omitempty"`
 	User      *User   `json:"user,omitempty"`
 }
 
+// UserTimeout A user that is temporarily prevented from taking part in chat
+type UserTimeout struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Reason    *string    `json:"reason,omitempty"`
+	User      *User      `json:"user,omitempty"`
+}
+
+// UserTimeoutUpdate defines model for UserTimeoutUpdate.
+type UserTimeoutUpdate struct {
+	// DurationSeconds How long the user is timed out for. 0 removes an existing timeout.
+	DurationSeconds *int    `json:"durationSeconds,omitempty"`
+	Reason          *string `json:"reason,omitempty"`
+	UserId          *string `json:"userId,omitempty"`
+}
+
 // Users defines model for Users.
 type Users = []User
 
@@ -567,42 +1215,105 @@ type Viewer struct {
 	UserAgent *string     `json:"userAgent,omitempty"`
 }
 
+// ViewerAnalytics An anonymous breakdown of viewers
+type ViewerAnalytics struct {
+	AverageWatchSeconds *float32          `json:"averageWatchSeconds,omitempty"`
+	Browsers            *[]AnalyticsCount `json:"browsers,omitempty"`
+	Countries           *[]AnalyticsCount `json:"countries,omitempty"`
+	DeviceClasses       *[]AnalyticsCount `json:"deviceClasses,omitempty"`
+	OperatingSystems    *[]AnalyticsCount `json:"operatingSystems,omitempty"`
+	Referrers           *[]AnalyticsCount `json:"referrers,omitempty"`
+	Regions             *[]AnalyticsCount `json:"regions,omitempty"`
+	TotalWatchSeconds   *int              `json:"totalWatchSeconds,omitempty"`
+	UniqueViewers       *int              `json:"uniqueViewers,omitempty"`
+	WatchTime           *[]AnalyticsCount `json:"watchTime,omitempty"`
+}
+
 // WebConfig defines model for WebConfig.
 type WebConfig struct {
-	AppearanceVariables  *map[string]string    `json:"appearanceVariables,omitempty"`
-	Authentication       *AuthenticationConfig `json:"authentication,omitempty"`
-	ChatDisabled         *bool                 `json:"chatDisabled,omitempty"`
-	CustomStyles         *string               `json:"customStyles,omitempty"`
-	ExternalActions      *[]ExternalAction     `json:"externalActions,omitempty"`
-	ExtraPageContent     *string               `json:"extraPageContent,omitempty"`
-	Federation           *FederationConfig     `json:"federation,omitempty"`
-	HideViewerCount      *bool                 `json:"hideViewerCount,omitempty"`
-	Logo                 *string               `json:"logo,omitempty"`
-	MaxSocketPayloadSize *int                  `json:"maxSocketPayloadSize,omitempty"`
-	Name                 *string               `json:"name,omitempty"`
-	Notifications        *NotificationConfig   `json:"notifications,omitempty"`
-	Nsfw                 *bool                 `json:"nsfw,omitempty"`
-	OfflineMessage       *string               `json:"offlineMessage,omitempty"`
-	SocialHandles        *[]SocialHandle       `json:"socialHandles,omitempty"`
-	SocketHostOverride   *string               `json:"socketHostOverride,omitempty"`
-	StreamTitle          *string               `json:"streamTitle,omitempty"`
-	Summary              *string               `json:"summary,omitempty"`
-	Tags                 *[]string             `json:"tags,omitempty"`
-	Version              *string               `json:"version,omitempty"`
+	AppearanceVariables *map[string]string    `json:"appearanceVariables,omitempty"`
+	Authentication      *AuthenticationConfig `json:"authentication,omitempty"`
+	ChatDisabled        *bool                 `json:"chatDisabled,omitempty"`
+
+	// ChatModes Moderation modes applied to chat for the current broadcast
+	ChatModes                     *ChatModes          `json:"chatModes,omitempty"`
+	ChatUserDirectMessagesEnabled *bool               `json:"chatUserDirectMessagesEnabled,omitempty"`
+	CustomStyles                  *string             `json:"customStyles,omitempty"`
+	ExternalActions               *[]ExternalAction   `json:"externalActions,omitempty"`
+	ExtraPageContent              *string             `json:"extraPageContent,omitempty"`
+	Federation                    *FederationConfig   `json:"federation,omitempty"`
+	HideViewerCount               *bool               `json:"hideViewerCount,omitempty"`
+	Logo                          *string             `json:"logo,omitempty"`
+	MaxSocketPayloadSize          *int                `json:"maxSocketPayloadSize,omitempty"`
+	Name                          *string             `json:"name,omitempty"`
+	Notifications                 *NotificationConfig `json:"notifications,omitempty"`
+	Nsfw                          *bool               `json:"nsfw,omitempty"`
+	OfflineMessage                *string             `json:"offlineMessage,omitempty"`
+	SocialHandles                 *[]SocialHandle     `json:"socialHandles,omitempty"`
+	SocketHostOverride            *string             `json:"socketHostOverride,omitempty"`
+	StreamTitle                   *string             `json:"streamTitle,omitempty"`
+	Summary                       *string             `json:"summary,omitempty"`
+	Tags                          *[]string           `json:"tags,omitempty"`
+	Version                       *string             `json:"version,omitempty"`
 }
 
 // Webhook defines model for Webhook.
 type Webhook struct {
-	Events    *[]WebhookEventType `json:"events,omitempty"`
-	Id        *int                `json:"id,omitempty"`
-	LastUsed  *time.Time          `json:"lastUsed,omitempty"`
-	Timestamp *time.Time          `json:"timestamp,omitempty"`
-	Url       *string             `json:"url,omitempty"`
+	// AccessToken The access token of the integration a chat bot acts as. Only included when the webhook is created.
+	AccessToken *string `json:"accessToken,omitempty"`
+
+	// ContentType The content type of the rendered template.
+	ContentType *string             `json:"contentType,omitempty"`
+	Events      *[]WebhookEventType `json:"events,omitempty"`
+
+	// Filter A Go text/template that must render "true" for an event to be sent.
+	Filter   *string    `json:"filter,omitempty"`
+	Id       *int       `json:"id,omitempty"`
+	LastUsed *time.Time `json:"lastUsed,omitempty"`
+
+	// Secret The secret payloads are signed with. Each delivery has an X-Owncast-Signature header of "sha256=" followed by the hex HMAC-SHA256 of the body.
+	Secret *string `json:"secret,omitempty"`
+
+	// Template A Go text/template rendered against the event JSON in place of it.
+	Template  *string    `json:"template,omitempty"`
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+
+	// Type CHAT_BOT webhooks can respond with a ChatBotResponse to perform chat actions.
+	Type *WebhookType `json:"type,omitempty"`
+	Url  *string      `json:"url,omitempty"`
+}
+
+// WebhookDelivery The outcome of sending a single event to a webhook. Failed deliveries are retried with exponential backoff.
+type WebhookDelivery struct {
+	Attempts  *int              `json:"attempts,omitempty"`
+	CreatedAt *time.Time        `json:"createdAt,omitempty"`
+	Error     *string           `json:"error,omitempty"`
+	EventType *WebhookEventType `json:"eventType,omitempty"`
+	Id        *int              `json:"id,omitempty"`
+	LatencyMs *int64            `json:"latencyMs,omitempty"`
+
+	// StatusCode The status of the latest attempt, or 0 if there was no response.
+	StatusCode *int       `json:"statusCode,omitempty"`
+	Success    *bool      `json:"success,omitempty"`
+	UpdatedAt  *time.Time `json:"updatedAt,omitempty"`
+	WebhookId  *int       `json:"webhookId,omitempty"`
 }
 
 // WebhookEventType defines model for WebhookEventType.
 type WebhookEventType string
 
+// WebhookTestEventResult defines model for WebhookTestEventResult.
+type WebhookTestEventResult struct {
+	Body        *string `json:"body,omitempty"`
+	ContentType *string `json:"contentType,omitempty"`
+
+	// Sent False when the webhook filter did not want the event.
+	Sent *bool `json:"sent,omitempty"`
+}
+
+// WebhookType CHAT_BOT webhooks can respond with a ChatBotResponse to perform chat actions.
+type WebhookType string
+
 // YPDetails defines model for YPDetails.
 type YPDetails struct {
 	Description           *string         `json:"description,omitempty"`
@@ -663,16 +1374,128 @@ type DeleteExternalAPIUserJSONBody struct {
 	Token *string `json:"token,omitempty"`
 }
 
+// GetAlertsParams defines parameters for GetAlerts.
+type GetAlertsParams struct {
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetViewerAnalyticsParams defines parameters for GetViewerAnalytics.
+type GetViewerAnalyticsParams struct {
+	// BroadcastId Only include the viewers of this broadcast
+	BroadcastId *string `form:"broadcastId,omitempty" json:"broadcastId,omitempty"`
+
+	// Since Only include viewers who started watching at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only include viewers who started watching at or before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
+// GetBroadcastsParams defines parameters for GetBroadcasts.
+type GetBroadcastsParams struct {
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetBroadcastViewersOverTimeParams defines parameters for GetBroadcastViewersOverTime.
+type GetBroadcastViewersOverTimeParams struct {
+	// Id The ID of the broadcast
+	Id string `form:"id" json:"id"`
+}
+
+// DeleteChatArchiveJSONBody defines parameters for DeleteChatArchive.
+type DeleteChatArchiveJSONBody struct {
+	Id *string `json:"id,omitempty"`
+}
+
+// DownloadChatArchiveParams defines parameters for DownloadChatArchive.
+type DownloadChatArchiveParams struct {
+	// Id The ID of the chat archive
+	Id string `form:"id" json:"id"`
+
+	// Format The format to download the chat archive as
+	Format *DownloadChatArchiveParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// DownloadChatArchiveParamsFormat defines parameters for DownloadChatArchive.
+type DownloadChatArchiveParamsFormat string
+
+// DeleteChatFilterRuleJSONBody defines parameters for DeleteChatFilterRule.
+type DeleteChatFilterRuleJSONBody struct {
+	Id *int `json:"id,omitempty"`
+}
+
+// SearchChatMessagesAdminParams defines parameters for SearchChatMessagesAdmin.
+type SearchChatMessagesAdminParams struct {
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Text Only return messages containing these words
+	Text *string `form:"text,omitempty" json:"text,omitempty"`
+
+	// UserId Only return messages sent by this user
+	UserId *string `form:"userId,omitempty" json:"userId,omitempty"`
+
+	// DisplayName Only return messages sent by users with a current or previous display name containing this text
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty"`
+
+	// Since Only return messages sent at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return messages sent at or before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Visibility Only return visible or hidden messages
+	Visibility *SearchChatMessagesAdminParamsVisibility `form:"visibility,omitempty" json:"visibility,omitempty"`
+}
+
+// SearchChatMessagesAdminParamsVisibility defines parameters for SearchChatMessagesAdmin.
+type SearchChatMessagesAdminParamsVisibility string
+
+// GetModerationActionsParams defines parameters for GetModerationActions.
+type GetModerationActionsParams struct {
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Action Only return actions of this type
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// ActorId Only return actions taken by this user or integration
+	ActorId *string `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// Target Only return actions against this message, user or IP address
+	Target *string `form:"target,omitempty" json:"target,omitempty"`
+}
+
+// GetPollsAdminParams defines parameters for GetPollsAdmin.
+type GetPollsAdminParams struct {
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // UpdateUserEnabledAdminJSONBody defines parameters for UpdateUserEnabledAdmin.
 type UpdateUserEnabledAdminJSONBody struct {
-	Enabled *bool   `json:"enabled,omitempty"`
-	UserId  *string `json:"userId,omitempty"`
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Reason An optional reason recorded in the moderation log.
+	Reason *string `json:"reason,omitempty"`
+	UserId *string `json:"userId,omitempty"`
 }
 
 // UpdateUserModeratorJSONBody defines parameters for UpdateUserModerator.
 type UpdateUserModeratorJSONBody struct {
-	IsModerator *bool   `json:"isModerator,omitempty"`
-	UserId      *string `json:"userId,omitempty"`
+	IsModerator *bool `json:"isModerator,omitempty"`
+
+	// Reason An optional reason recorded in the moderation log.
+	Reason *string `json:"reason,omitempty"`
+	UserId *string `json:"userId,omitempty"`
+}
+
+// SetAlertRulesJSONBody defines parameters for SetAlertRules.
+type SetAlertRulesJSONBody struct {
+	// Value Every alert rule. Rules without an id are given one.
+	Value *[]AlertRule `json:"value,omitempty"`
 }
 
 // SetCustomColorVariableValuesJSONBody defines parameters for SetCustomColorVariableValues.
@@ -680,11 +1503,28 @@ type SetCustomColorVariableValuesJSONBody struct {
 	Value *map[string]string `json:"value,omitempty"`
 }
 
+// SetChatCustomCommandsJSONBody defines parameters for SetChatCustomCommands.
+type SetChatCustomCommandsJSONBody struct {
+	Value *[]ChatCommand `json:"value,omitempty"`
+}
+
 // SetForbiddenUsernameListJSONBody defines parameters for SetForbiddenUsernameList.
 type SetForbiddenUsernameListJSONBody struct {
 	Value *[]string `json:"value,omitempty"`
 }
 
+// SetChatRetentionJSONBody defines parameters for SetChatRetention.
+type SetChatRetentionJSONBody struct {
+	// Value How long chat messages are kept and if a broadcast's chat is archived when it ends
+	Value *ChatRetention `json:"value,omitempty"`
+}
+
+// SetChatReviewQueueJSONBody defines parameters for SetChatReviewQueue.
+type SetChatReviewQueueJSONBody struct {
+	// Value Which chat messages are held for a moderator to review before they are sent
+	Value *ChatReviewQueue `json:"value,omitempty"`
+}
+
 // SetSuggestedUsernameListJSONBody defines parameters for SetSuggestedUsernameList.
 type SetSuggestedUsernameListJSONBody struct {
 	Value *[]string `json:"value,omitempty"`
@@ -705,11 +1545,68 @@ type SetDiscordNotificationConfigurationJSONBody struct {
 	Value *DiscordNotificationConfiguration `json:"value,omitempty"`
 }
 
+// SetEmailNotificationConfigurationJSONBody defines parameters for SetEmailNotificationConfiguration.
+type SetEmailNotificationConfigurationJSONBody struct {
+	Value *EmailNotificationConfiguration `json:"value,omitempty"`
+}
+
+// SetGotifyNotificationConfigurationJSONBody defines parameters for SetGotifyNotificationConfiguration.
+type SetGotifyNotificationConfigurationJSONBody struct {
+	Value *GotifyNotificationConfiguration `json:"value,omitempty"`
+}
+
+// SetMatrixNotificationConfigurationJSONBody defines parameters for SetMatrixNotificationConfiguration.
+type SetMatrixNotificationConfigurationJSONBody struct {
+	Value *MatrixNotificationConfiguration `json:"value,omitempty"`
+}
+
+// SetNtfyNotificationConfigurationJSONBody defines parameters for SetNtfyNotificationConfiguration.
+type SetNtfyNotificationConfigurationJSONBody struct {
+	Value *NtfyNotificationConfiguration `json:"value,omitempty"`
+}
+
+// SetStreamReportChannelJSONBody defines parameters for SetStreamReportChannel.
+type SetStreamReportChannelJSONBody struct {
+	// Value The notification channel to send reports through, such as EMAIL or MATRIX. Empty stops sending reports.
+	Value *string `json:"value,omitempty"`
+}
+
+// SetNotificationRulesJSONBody defines parameters for SetNotificationRules.
+type SetNotificationRulesJSONBody struct {
+	// Value Rules keyed by channel name, such as DISCORD or FEDIVERSE. Channels without a rule wait 2 minutes after going live and 10 minutes between notices.
+	Value *map[string]NotificationRule `json:"value,omitempty"`
+}
+
+// SetSlackNotificationConfigurationJSONBody defines parameters for SetSlackNotificationConfiguration.
+type SetSlackNotificationConfigurationJSONBody struct {
+	Value *SlackNotificationConfiguration `json:"value,omitempty"`
+}
+
+// SetTelegramNotificationConfigurationJSONBody defines parameters for SetTelegramNotificationConfiguration.
+type SetTelegramNotificationConfigurationJSONBody struct {
+	Value *TelegramNotificationConfiguration `json:"value,omitempty"`
+}
+
+// SendTestNotificationJSONBody defines parameters for SendTestNotification.
+type SendTestNotificationJSONBody struct {
+	// Channel The channel to send through, using its saved configuration. Browser push can't be tested.
+	Channel SendTestNotificationJSONBodyChannel `json:"channel"`
+}
+
+// SendTestNotificationJSONBodyChannel defines parameters for SendTestNotification.
+type SendTestNotificationJSONBodyChannel string
+
 // SetS3ConfigurationJSONBody defines parameters for SetS3Configuration.
 type SetS3ConfigurationJSONBody struct {
 	Value *S3Info `json:"value,omitempty"`
 }
 
+// SetScheduleReminderMinutesJSONBody defines parameters for SetScheduleReminderMinutes.
+type SetScheduleReminderMinutesJSONBody struct {
+	// Value How many minutes before a scheduled stream a reminder is sent. Zero disables reminders.
+	Value int `json:"value"`
+}
+
 // SetSocialHandlesJSONBody defines parameters for SetSocialHandles.
 type SetSocialHandlesJSONBody struct {
 	Value *[]SocialHandle `json:"value,omitempty"`
@@ -755,6 +1652,16 @@ type ApproveFollowerJSONBody struct {
 	Approved *bool   `json:"approved,omitempty"`
 }
 
+// DeleteStreamReportJSONBody defines parameters for DeleteStreamReport.
+type DeleteStreamReportJSONBody struct {
+	Id string `json:"id"`
+}
+
+// DeleteScheduledStreamJSONBody defines parameters for DeleteScheduledStream.
+type DeleteScheduledStreamJSONBody struct {
+	Id string `json:"id"`
+}
+
 // GetViewersOverTimeParams defines parameters for GetViewersOverTime.
 type GetViewersOverTimeParams struct {
 	// WindowStart Start date in unix time
@@ -763,8 +1670,16 @@ type GetViewersOverTimeParams struct {
 
 // CreateWebhookJSONBody defines parameters for CreateWebhook.
 type CreateWebhookJSONBody struct {
-	Events *[]WebhookEventType `json:"events,omitempty"`
-	Url    *string             `json:"url,omitempty"`
+	// AccessToken Required for CHAT_BOT webhooks.
+	AccessToken *string             `json:"accessToken,omitempty"`
+	ContentType *string             `json:"contentType,omitempty"`
+	Events      *[]WebhookEventType `json:"events,omitempty"`
+	Filter      *string             `json:"filter,omitempty"`
+	Template    *string             `json:"template,omitempty"`
+
+	// Type CHAT_BOT webhooks can respond with a ChatBotResponse to perform chat actions.
+	Type *WebhookType `json:"type,omitempty"`
+	Url  *string      `json:"url,omitempty"`
 }
 
 // DeleteWebhookJSONBody defines parameters for DeleteWebhook.
@@ -772,6 +1687,38 @@ type DeleteWebhookJSONBody struct {
 	Id *int `json:"id,omitempty"`
 }
 
+// GetWebhookDeliveriesParams defines parameters for GetWebhookDeliveries.
+type GetWebhookDeliveriesParams struct {
+	// WebhookId The webhook to return deliveries for
+	WebhookId int     `form:"webhookId" json:"webhookId"`
+	Offset    *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit     *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ReplayWebhookDeliveryJSONBody defines parameters for ReplayWebhookDelivery.
+type ReplayWebhookDeliveryJSONBody struct {
+	Id *int `json:"id,omitempty"`
+}
+
+// UpdateWebhookPayloadJSONBody defines parameters for UpdateWebhookPayload.
+type UpdateWebhookPayloadJSONBody struct {
+	// ContentType The content type of the rendered template. Defaults to application/json.
+	ContentType *string `json:"contentType,omitempty"`
+
+	// Filter A Go text/template that must render "true" for an event to be sent, e.g. {{isModerator .eventData.user}}. Empty sends every event.
+	Filter *string `json:"filter,omitempty"`
+	Id     int     `json:"id"`
+
+	// Template A Go text/template rendered against the event JSON, e.g. {{.eventData.user.displayName}}. Empty sends the event JSON.
+	Template *string `json:"template,omitempty"`
+}
+
+// SendWebhookTestEventJSONBody defines parameters for SendWebhookTestEvent.
+type SendWebhookTestEventJSONBody struct {
+	EventType *WebhookEventType `json:"eventType,omitempty"`
+	Id        int               `json:"id"`
+}
+
 // RegisterFediverseOTPRequestJSONBody defines parameters for RegisterFediverseOTPRequest.
 type RegisterFediverseOTPRequestJSONBody struct {
 	Account *string `json:"account,omitempty"`
@@ -823,11 +1770,69 @@ type GetChatMessagesParams struct {
 	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
 }
 
+// GetHeldMessagesParams defines parameters for GetHeldMessages.
+type GetHeldMessagesParams struct {
+	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
+}
+
+// ReviewHeldMessageParams defines parameters for ReviewHeldMessage.
+type ReviewHeldMessageParams struct {
+	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
+}
+
+// SearchChatMessagesParams defines parameters for SearchChatMessages.
+type SearchChatMessagesParams struct {
+	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
+	Offset      *Offset     `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit       *Limit      `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Text Only return messages containing these words
+	Text *string `form:"text,omitempty" json:"text,omitempty"`
+
+	// UserId Only return messages sent by this user
+	UserId *string `form:"userId,omitempty" json:"userId,omitempty"`
+
+	// DisplayName Only return messages sent by users with a current or previous display name containing this text
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty"`
+
+	// Since Only return messages sent at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until Only return messages sent at or before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Visibility Only return visible or hidden messages
+	Visibility *SearchChatMessagesParamsVisibility `form:"visibility,omitempty" json:"visibility,omitempty"`
+}
+
+// SearchChatMessagesParamsVisibility defines parameters for SearchChatMessages.
+type SearchChatMessagesParamsVisibility string
+
 // UpdateMessageVisibilityParams defines parameters for UpdateMessageVisibility.
 type UpdateMessageVisibilityParams struct {
 	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
 }
 
+// UpdateChatModesParams defines parameters for UpdateChatModes.
+type UpdateChatModesParams struct {
+	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
+}
+
+// GetActivePollParams defines parameters for GetActivePoll.
+type GetActivePollParams struct {
+	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
+}
+
+// EndPollParams defines parameters for EndPoll.
+type EndPollParams struct {
+	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
+}
+
+// StartPollParams defines parameters for StartPoll.
+type StartPollParams struct {
+	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
+}
+
 // RegisterAnonymousChatUserJSONBody defines parameters for RegisterAnonymousChatUser.
 type RegisterAnonymousChatUserJSONBody struct {
 	DisplayName *string `json:"displayName,omitempty"`
@@ -840,8 +1845,11 @@ type RegisterAnonymousChatUserParams struct {
 
 // UpdateUserEnabledJSONBody defines parameters for UpdateUserEnabled.
 type UpdateUserEnabledJSONBody struct {
-	Enabled *bool   `json:"enabled,omitempty"`
-	UserId  *string `json:"userId,omitempty"`
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Reason An optional reason recorded in the moderation log.
+	Reason *string `json:"reason,omitempty"`
+	UserId *string `json:"userId,omitempty"`
 }
 
 // UpdateUserEnabledParams defines parameters for UpdateUserEnabled.
@@ -849,6 +1857,11 @@ type UpdateUserEnabledParams struct {
 	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
 }
 
+// UpdateUserTimeoutParams defines parameters for UpdateUserTimeout.
+type UpdateUserTimeoutParams struct {
+	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
+}
+
 // GetFollowersParams defines parameters for GetFollowers.
 type GetFollowersParams struct {
 	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
@@ -860,6 +1873,41 @@ type GetUserDetailsParams struct {
 	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
 }
 
+// ConfirmEmailNotificationSubscriptionParams defines parameters for ConfirmEmailNotificationSubscription.
+type ConfirmEmailNotificationSubscriptionParams struct {
+	// Token The signed token from the emailed link
+	Token string `form:"token" json:"token"`
+}
+
+// SubmitEmailNotificationConfirmationParams defines parameters for SubmitEmailNotificationConfirmation.
+type SubmitEmailNotificationConfirmationParams struct {
+	// Token The signed token from the emailed link
+	Token string `form:"token" json:"token"`
+}
+
+// SubscribeToEmailNotificationsJSONBody defines parameters for SubscribeToEmailNotifications.
+type SubscribeToEmailNotificationsJSONBody struct {
+	// Email The address to send go-live emails to
+	Email string `json:"email"`
+}
+
+// SubscribeToEmailNotificationsParams defines parameters for SubscribeToEmailNotifications.
+type SubscribeToEmailNotificationsParams struct {
+	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
+}
+
+// UnsubscribeFromEmailNotificationsParams defines parameters for UnsubscribeFromEmailNotifications.
+type UnsubscribeFromEmailNotificationsParams struct {
+	// Token The signed token from the emailed link
+	Token string `form:"token" json:"token"`
+}
+
+// UnsubscribeFromEmailNotificationsOneClickParams defines parameters for UnsubscribeFromEmailNotificationsOneClick.
+type UnsubscribeFromEmailNotificationsOneClickParams struct {
+	// Token The signed token from the emailed link
+	Token string `form:"token" json:"token"`
+}
+
 // RegisterForLiveNotificationsJSONBody defines parameters for RegisterForLiveNotifications.
 type RegisterForLiveNotificationsJSONBody struct {
 	// Channel Name of notification channel
@@ -874,6 +1922,12 @@ type RegisterForLiveNotificationsParams struct {
 	AccessToken AccessToken `form:"accessToken" json:"accessToken"`
 }
 
+// PingParams defines parameters for Ping.
+type PingParams struct {
+	// Referrer The page that referred the viewer, used for anonymous viewer analytics
+	Referrer *string `form:"referrer,omitempty" json:"referrer,omitempty"`
+}
+
 // RemoteFollowJSONBody defines parameters for RemoteFollow.
 type RemoteFollowJSONBody struct {
 	Account *string `json:"account,omitempty"`
@@ -885,9 +1939,33 @@ type CreateExternalAPIUserJSONRequestBody CreateExternalAPIUserJSONBody
 // DeleteExternalAPIUserJSONRequestBody defines body for DeleteExternalAPIUser for application/json ContentType.
 type DeleteExternalAPIUserJSONRequestBody DeleteExternalAPIUserJSONBody
 
+// DeleteChatArchiveJSONRequestBody defines body for DeleteChatArchive for application/json ContentType.
+type DeleteChatArchiveJSONRequestBody DeleteChatArchiveJSONBody
+
+// CreateChatFilterRuleJSONRequestBody defines body for CreateChatFilterRule for application/json ContentType.
+type CreateChatFilterRuleJSONRequestBody = ChatFilterRule
+
+// DeleteChatFilterRuleJSONRequestBody defines body for DeleteChatFilterRule for application/json ContentType.
+type DeleteChatFilterRuleJSONRequestBody DeleteChatFilterRuleJSONBody
+
+// UpdateChatFilterRuleJSONRequestBody defines body for UpdateChatFilterRule for application/json ContentType.
+type UpdateChatFilterRuleJSONRequestBody = ChatFilterRule
+
+// ReviewHeldMessageAdminJSONRequestBody defines body for ReviewHeldMessageAdmin for application/json ContentType.
+type ReviewHeldMessageAdminJSONRequestBody = HeldMessageReview
+
 // UpdateMessageVisibilityAdminJSONRequestBody defines body for UpdateMessageVisibilityAdmin for application/json ContentType.
 type UpdateMessageVisibilityAdminJSONRequestBody = MessageVisibilityUpdate
 
+// UpdateChatModesAdminJSONRequestBody defines body for UpdateChatModesAdmin for application/json ContentType.
+type UpdateChatModesAdminJSONRequestBody = ChatModes
+
+// EndPollAdminJSONRequestBody defines body for EndPollAdmin for application/json ContentType.
+type EndPollAdminJSONRequestBody = EndPollRequest
+
+// StartPollAdminJSONRequestBody defines body for StartPollAdmin for application/json ContentType.
+type StartPollAdminJSONRequestBody = PollRequest
+
 // BanIPAddressJSONRequestBody defines body for BanIPAddress for application/json ContentType.
 type BanIPAddressJSONRequestBody = AdminConfigValue
 
@@ -900,12 +1978,21 @@ type UpdateUserEnabledAdminJSONRequestBody UpdateUserEnabledAdminJSONBody
 // UpdateUserModeratorJSONRequestBody defines body for UpdateUserModerator for application/json ContentType.
 type UpdateUserModeratorJSONRequestBody UpdateUserModeratorJSONBody
 
+// UpdateUserTimeoutAdminJSONRequestBody defines body for UpdateUserTimeoutAdmin for application/json ContentType.
+type UpdateUserTimeoutAdminJSONRequestBody = UserTimeoutUpdate
+
 // SetAdminPasswordJSONRequestBody defines body for SetAdminPassword for application/json ContentType.
 type SetAdminPasswordJSONRequestBody = AdminConfigValue
 
+// SetAlertRulesJSONRequestBody defines body for SetAlertRules for application/json ContentType.
+type SetAlertRulesJSONRequestBody SetAlertRulesJSONBody
+
 // SetCustomColorVariableValuesJSONRequestBody defines body for SetCustomColorVariableValues for application/json ContentType.
 type SetCustomColorVariableValuesJSONRequestBody SetCustomColorVariableValuesJSONBody
 
+// SetChatCustomCommandsJSONRequestBody defines body for SetChatCustomCommands for application/json ContentType.
+type SetChatCustomCommandsJSONRequestBody SetChatCustomCommandsJSONBody
+
 // SetChatDisabledJSONRequestBody defines body for SetChatDisabled for application/json ContentType.
 type SetChatDisabledJSONRequestBody = AdminConfigValue
 
@@ -918,6 +2005,12 @@ type SetForbiddenUsernameListJSONRequestBody SetForbiddenUsernameListJSONBody
 // SetChatJoinMessagesEnabledJSONRequestBody defines body for SetChatJoinMessagesEnabled for application/json ContentType.
 type SetChatJoinMessagesEnabledJSONRequestBody = AdminConfigValue
 
+// SetChatRetentionJSONRequestBody defines body for SetChatRetention for application/json ContentType.
+type SetChatRetentionJSONRequestBody SetChatRetentionJSONBody
+
+// SetChatReviewQueueJSONRequestBody defines body for SetChatReviewQueue for application/json ContentType.
+type SetChatReviewQueueJSONRequestBody SetChatReviewQueueJSONBody
+
 // SetChatSlurFilterEnabledJSONRequestBody defines body for SetChatSlurFilterEnabled for application/json ContentType.
 type SetChatSlurFilterEnabledJSONRequestBody = AdminConfigValue
 
@@ -927,6 +2020,9 @@ type SetChatSpamProtectionEnabledJSONRequestBody = AdminConfigValue
 // SetSuggestedUsernameListJSONRequestBody defines body for SetSuggestedUsernameList for application/json ContentType.
 type SetSuggestedUsernameListJSONRequestBody SetSuggestedUsernameListJSONBody
 
+// SetChatUserDirectMessagesEnabledJSONRequestBody defines body for SetChatUserDirectMessagesEnabled for application/json ContentType.
+type SetChatUserDirectMessagesEnabledJSONRequestBody = AdminConfigValue
+
 // SetCustomJavascriptJSONRequestBody defines body for SetCustomJavascript for application/json ContentType.
 type SetCustomJavascriptJSONRequestBody = AdminConfigValue
 
@@ -978,6 +2074,33 @@ type SetBrowserNotificationConfigurationJSONRequestBody SetBrowserNotificationCo
 // SetDiscordNotificationConfigurationJSONRequestBody defines body for SetDiscordNotificationConfiguration for application/json ContentType.
 type SetDiscordNotificationConfigurationJSONRequestBody SetDiscordNotificationConfigurationJSONBody
 
+// SetEmailNotificationConfigurationJSONRequestBody defines body for SetEmailNotificationConfiguration for application/json ContentType.
+type SetEmailNotificationConfigurationJSONRequestBody SetEmailNotificationConfigurationJSONBody
+
+// SetGotifyNotificationConfigurationJSONRequestBody defines body for SetGotifyNotificationConfiguration for application/json ContentType.
+type SetGotifyNotificationConfigurationJSONRequestBody SetGotifyNotificationConfigurationJSONBody
+
+// SetMatrixNotificationConfigurationJSONRequestBody defines body for SetMatrixNotificationConfiguration for application/json ContentType.
+type SetMatrixNotificationConfigurationJSONRequestBody SetMatrixNotificationConfigurationJSONBody
+
+// SetNtfyNotificationConfigurationJSONRequestBody defines body for SetNtfyNotificationConfiguration for application/json ContentType.
+type SetNtfyNotificationConfigurationJSONRequestBody SetNtfyNotificationConfigurationJSONBody
+
+// SetStreamReportChannelJSONRequestBody defines body for SetStreamReportChannel for application/json ContentType.
+type SetStreamReportChannelJSONRequestBody SetStreamReportChannelJSONBody
+
+// SetNotificationRulesJSONRequestBody defines body for SetNotificationRules for application/json ContentType.
+type SetNotificationRulesJSONRequestBody SetNotificationRulesJSONBody
+
+// SetSlackNotificationConfigurationJSONRequestBody defines body for SetSlackNotificationConfiguration for application/json ContentType.
+type SetSlackNotificationConfigurationJSONRequestBody SetSlackNotificationConfigurationJSONBody
+
+// SetTelegramNotificationConfigurationJSONRequestBody defines body for SetTelegramNotificationConfiguration for application/json ContentType.
+type SetTelegramNotificationConfigurationJSONRequestBody SetTelegramNotificationConfigurationJSONBody
+
+// SendTestNotificationJSONRequestBody defines body for SendTestNotification for application/json ContentType.
+type SendTestNotificationJSONRequestBody SendTestNotificationJSONBody
+
 // SetNSFWJSONRequestBody defines body for SetNSFW for application/json ContentType.
 type SetNSFWJSONRequestBody = AdminConfigValue
 
@@ -993,6 +2116,9 @@ type SetRTMPServerPortJSONRequestBody = AdminConfigValue
 // SetS3ConfigurationJSONRequestBody defines body for SetS3Configuration for application/json ContentType.
 type SetS3ConfigurationJSONRequestBody SetS3ConfigurationJSONBody
 
+// SetScheduleReminderMinutesJSONRequestBody defines body for SetScheduleReminderMinutes for application/json ContentType.
+type SetScheduleReminderMinutesJSONRequestBody SetScheduleReminderMinutesJSONBody
+
 // SetServerSummaryJSONRequestBody defines body for SetServerSummary for application/json ContentType.
 type SetServerSummaryJSONRequestBody = AdminConfigValue
 
@@ -1047,12 +2173,33 @@ type SendFederatedMessageJSONRequestBody = AdminConfigValue
 // ApproveFollowerJSONRequestBody defines body for ApproveFollower for application/json ContentType.
 type ApproveFollowerJSONRequestBody ApproveFollowerJSONBody
 
+// DeleteStreamReportJSONRequestBody defines body for DeleteStreamReport for application/json ContentType.
+type DeleteStreamReportJSONRequestBody DeleteStreamReportJSONBody
+
+// CreateScheduledStreamJSONRequestBody defines body for CreateScheduledStream for application/json ContentType.
+type CreateScheduledStreamJSONRequestBody = ScheduledStreamRequest
+
+// DeleteScheduledStreamJSONRequestBody defines body for DeleteScheduledStream for application/json ContentType.
+type DeleteScheduledStreamJSONRequestBody DeleteScheduledStreamJSONBody
+
+// UpdateScheduledStreamJSONRequestBody defines body for UpdateScheduledStream for application/json ContentType.
+type UpdateScheduledStreamJSONRequestBody = UpdateScheduledStreamRequest
+
 // CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
 type CreateWebhookJSONRequestBody CreateWebhookJSONBody
 
 // DeleteWebhookJSONRequestBody defines body for DeleteWebhook for application/json ContentType.
 type DeleteWebhookJSONRequestBody DeleteWebhookJSONBody
 
+// ReplayWebhookDeliveryJSONRequestBody defines body for ReplayWebhookDelivery for application/json ContentType.
+type ReplayWebhookDeliveryJSONRequestBody ReplayWebhookDeliveryJSONBody
+
+// UpdateWebhookPayloadJSONRequestBody defines body for UpdateWebhookPayload for application/json ContentType.
+type UpdateWebhookPayloadJSONRequestBody UpdateWebhookPayloadJSONBody
+
+// SendWebhookTestEventJSONRequestBody defines body for SendWebhookTestEvent for application/json ContentType.
+type SendWebhookTestEventJSONRequestBody SendWebhookTestEventJSONBody
+
 // RegisterFediverseOTPRequestJSONRequestBody defines body for RegisterFediverseOTPRequest for application/json ContentType.
 type RegisterFediverseOTPRequestJSONRequestBody RegisterFediverseOTPRequestJSONBody
 
@@ -1065,21 +2212,45 @@ type StartIndieAuthFlowJSONRequestBody StartIndieAuthFlowJSONBody
 // HandleIndieAuthEndpointPostFormdataRequestBody defines body for HandleIndieAuthEndpointPost for application/x-www-form-urlencoded ContentType.
 type HandleIndieAuthEndpointPostFormdataRequestBody HandleIndieAuthEndpointPostFormdataBody
 
+// ReviewHeldMessageJSONRequestBody defines body for ReviewHeldMessage for application/json ContentType.
+type ReviewHeldMessageJSONRequestBody = HeldMessageReview
+
 // UpdateMessageVisibilityJSONRequestBody defines body for UpdateMessageVisibility for application/json ContentType.
 type UpdateMessageVisibilityJSONRequestBody = MessageVisibilityUpdate
 
+// UpdateChatModesJSONRequestBody defines body for UpdateChatModes for application/json ContentType.
+type UpdateChatModesJSONRequestBody = ChatModes
+
+// EndPollJSONRequestBody defines body for EndPoll for application/json ContentType.
+type EndPollJSONRequestBody = EndPollRequest
+
+// StartPollJSONRequestBody defines body for StartPoll for application/json ContentType.
+type StartPollJSONRequestBody = PollRequest
+
 // RegisterAnonymousChatUserJSONRequestBody defines body for RegisterAnonymousChatUser for application/json ContentType.
 type RegisterAnonymousChatUserJSONRequestBody RegisterAnonymousChatUserJSONBody
 
 // UpdateUserEnabledJSONRequestBody defines body for UpdateUserEnabled for application/json ContentType.
 type UpdateUserEnabledJSONRequestBody UpdateUserEnabledJSONBody
 
+// UpdateUserTimeoutJSONRequestBody defines body for UpdateUserTimeout for application/json ContentType.
+type UpdateUserTimeoutJSONRequestBody = UserTimeoutUpdate
+
 // SendChatActionJSONRequestBody defines body for SendChatAction for application/json ContentType.
 type SendChatActionJSONRequestBody = MessageEvent
 
+// SendIntegrationDirectMessageJSONRequestBody defines body for SendIntegrationDirectMessage for application/json ContentType.
+type SendIntegrationDirectMessageJSONRequestBody = DirectMessageRequest
+
 // ExternalUpdateMessageVisibilityJSONRequestBody defines body for ExternalUpdateMessageVisibility for application/json ContentType.
 type ExternalUpdateMessageVisibilityJSONRequestBody = MessageVisibilityUpdate
 
+// EndIntegrationPollJSONRequestBody defines body for EndIntegrationPoll for application/json ContentType.
+type EndIntegrationPollJSONRequestBody = EndPollRequest
+
+// StartIntegrationPollJSONRequestBody defines body for StartIntegrationPoll for application/json ContentType.
+type StartIntegrationPollJSONRequestBody = PollRequest
+
 // SendIntegrationChatMessageJSONRequestBody defines body for SendIntegrationChatMessage for application/json ContentType.
 type SendIntegrationChatMessageJSONRequestBody = MessageEvent
 
@@ -1095,6 +2266,9 @@ type ExternalSetStreamTitleJSONRequestBody = AdminConfigValue
 // ReportPlaybackMetricsJSONRequestBody defines body for ReportPlaybackMetrics for application/json ContentType.
 type ReportPlaybackMetricsJSONRequestBody = PlaybackMetrics
 
+// SubscribeToEmailNotificationsJSONRequestBody defines body for SubscribeToEmailNotifications for application/json ContentType.
+type SubscribeToEmailNotificationsJSONRequestBody SubscribeToEmailNotificationsJSONBody
+
 // RegisterForLiveNotificationsJSONRequestBody defines body for RegisterForLiveNotifications for application/json ContentType.
 type RegisterForLiveNotificationsJSONRequestBody RegisterForLiveNotificationsJSONBody
 
@@ -1,6 +1,6 @@
 // Package generated provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.3.0 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package generated
 
 import (
@@ -32,24 +32,138 @@ type ServerInterface interface {
 	// Delete a single external API user
 	// (POST /admin/accesstokens/delete)
 	DeleteExternalAPIUser(w http.ResponseWriter, r *http.Request)
+	// Get the alert history
+	// (GET /admin/alerts)
+	GetAlerts(w http.ResponseWriter, r *http.Request, params GetAlertsParams)
+
+	// (OPTIONS /admin/alerts)
+	GetAlertsOptions(w http.ResponseWriter, r *http.Request)
+	// Get a breakdown of viewers by location, device, watch time and referrer
+	// (GET /admin/analytics/viewers)
+	GetViewerAnalytics(w http.ResponseWriter, r *http.Request, params GetViewerAnalyticsParams)
+
+	// (OPTIONS /admin/analytics/viewers)
+	GetViewerAnalyticsOptions(w http.ResponseWriter, r *http.Request)
+	// Get the broadcast history
+	// (GET /admin/broadcasts)
+	GetBroadcasts(w http.ResponseWriter, r *http.Request, params GetBroadcastsParams)
+
+	// (OPTIONS /admin/broadcasts)
+	GetBroadcastsOptions(w http.ResponseWriter, r *http.Request)
+	// Get the viewer count over time of a single broadcast
+	// (GET /admin/broadcasts/viewersOverTime)
+	GetBroadcastViewersOverTime(w http.ResponseWriter, r *http.Request, params GetBroadcastViewersOverTimeParams)
+
+	// (OPTIONS /admin/broadcasts/viewersOverTime)
+	GetBroadcastViewersOverTimeOptions(w http.ResponseWriter, r *http.Request)
+	// Get the broadcast chat archives
+	// (GET /admin/chat/archives)
+	GetChatArchives(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/archives)
+	GetChatArchivesOptions(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/archives/delete)
+	DeleteChatArchiveOptions(w http.ResponseWriter, r *http.Request)
+	// Delete a broadcast chat archive
+	// (POST /admin/chat/archives/delete)
+	DeleteChatArchive(w http.ResponseWriter, r *http.Request)
+	// Download a broadcast chat archive
+	// (GET /admin/chat/archives/download)
+	DownloadChatArchive(w http.ResponseWriter, r *http.Request, params DownloadChatArchiveParams)
+
+	// (OPTIONS /admin/chat/archives/download)
+	DownloadChatArchiveOptions(w http.ResponseWriter, r *http.Request)
 	// Get a detailed list of currently connected chat clients
 	// (GET /admin/chat/clients)
 	GetConnectedChatClients(w http.ResponseWriter, r *http.Request)
 
 	// (OPTIONS /admin/chat/clients)
 	GetConnectedChatClientsOptions(w http.ResponseWriter, r *http.Request)
+	// Get all the chat filter rules
+	// (GET /admin/chat/filters)
+	GetChatFilterRules(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/filters)
+	GetChatFilterRulesOptions(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/filters/create)
+	CreateChatFilterRuleOptions(w http.ResponseWriter, r *http.Request)
+	// Create a chat filter rule
+	// (POST /admin/chat/filters/create)
+	CreateChatFilterRule(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/filters/delete)
+	DeleteChatFilterRuleOptions(w http.ResponseWriter, r *http.Request)
+	// Delete a chat filter rule
+	// (POST /admin/chat/filters/delete)
+	DeleteChatFilterRule(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/filters/update)
+	UpdateChatFilterRuleOptions(w http.ResponseWriter, r *http.Request)
+	// Update a chat filter rule
+	// (POST /admin/chat/filters/update)
+	UpdateChatFilterRule(w http.ResponseWriter, r *http.Request)
 	// Get all chat messages for the admin, unfiltered
 	// (GET /admin/chat/messages)
 	GetChatMessagesAdmin(w http.ResponseWriter, r *http.Request)
 
 	// (OPTIONS /admin/chat/messages)
 	GetChatMessagesAdminOptions(w http.ResponseWriter, r *http.Request)
+	// Get the chat messages held for review
+	// (GET /admin/chat/messages/held)
+	GetHeldMessagesAdmin(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/messages/held)
+	GetHeldMessagesAdminOptions(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/messages/review)
+	ReviewHeldMessageAdminOptions(w http.ResponseWriter, r *http.Request)
+	// Approve or reject a held chat message
+	// (POST /admin/chat/messages/review)
+	ReviewHeldMessageAdmin(w http.ResponseWriter, r *http.Request)
+	// Search the chat history
+	// (GET /admin/chat/messages/search)
+	SearchChatMessagesAdmin(w http.ResponseWriter, r *http.Request, params SearchChatMessagesAdminParams)
+
+	// (OPTIONS /admin/chat/messages/search)
+	SearchChatMessagesAdminOptions(w http.ResponseWriter, r *http.Request)
 
 	// (OPTIONS /admin/chat/messagevisibility)
 	UpdateMessageVisibilityAdminOptions(w http.ResponseWriter, r *http.Request)
 	// Update visibility of chat messages
 	// (POST /admin/chat/messagevisibility)
 	UpdateMessageVisibilityAdmin(w http.ResponseWriter, r *http.Request)
+	// Get a paginated list of moderation actions
+	// (GET /admin/chat/moderation/actions)
+	GetModerationActions(w http.ResponseWriter, r *http.Request, params GetModerationActionsParams)
+
+	// (OPTIONS /admin/chat/moderation/actions)
+	GetModerationActionsOptions(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/modes)
+	UpdateChatModesAdminOptions(w http.ResponseWriter, r *http.Request)
+	// Update the chat modes
+	// (POST /admin/chat/modes)
+	UpdateChatModesAdmin(w http.ResponseWriter, r *http.Request)
+	// Get the chat polls
+	// (GET /admin/chat/polls)
+	GetPollsAdmin(w http.ResponseWriter, r *http.Request, params GetPollsAdminParams)
+
+	// (OPTIONS /admin/chat/polls)
+	GetPollsAdminOptions(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/polls/end)
+	EndPollAdminOptions(w http.ResponseWriter, r *http.Request)
+	// End a chat poll
+	// (POST /admin/chat/polls/end)
+	EndPollAdmin(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/polls/start)
+	StartPollAdminOptions(w http.ResponseWriter, r *http.Request)
+	// Start a chat poll
+	// (POST /admin/chat/polls/start)
+	StartPollAdmin(w http.ResponseWriter, r *http.Request)
 	// Get a list of disabled users
 	// (GET /admin/chat/users/disabled)
 	GetDisabledUsers(w http.ResponseWriter, r *http.Request)
@@ -93,18 +207,42 @@ type ServerInterface interface {
 	// (POST /admin/chat/users/setmoderator)
 	UpdateUserModerator(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /admin/chat/users/timeout)
+	UpdateUserTimeoutAdminOptions(w http.ResponseWriter, r *http.Request)
+	// Time out a user
+	// (POST /admin/chat/users/timeout)
+	UpdateUserTimeoutAdmin(w http.ResponseWriter, r *http.Request)
+	// Get a list of users currently timed out
+	// (GET /admin/chat/users/timeouts)
+	GetUserTimeouts(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/chat/users/timeouts)
+	GetUserTimeoutsOptions(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /admin/config/adminpass)
 	SetAdminPasswordOptions(w http.ResponseWriter, r *http.Request)
 	// Change the current admin password
 	// (POST /admin/config/adminpass)
 	SetAdminPassword(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /admin/config/alerts/rules)
+	SetAlertRulesOptions(w http.ResponseWriter, r *http.Request)
+	// Set the alerting rules
+	// (POST /admin/config/alerts/rules)
+	SetAlertRules(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /admin/config/appearance)
 	SetCustomColorVariableValuesOptions(w http.ResponseWriter, r *http.Request)
 	// Set style/color/css values
 	// (POST /admin/config/appearance)
 	SetCustomColorVariableValues(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /admin/config/chat/commands)
+	SetChatCustomCommandsOptions(w http.ResponseWriter, r *http.Request)
+	// Set the custom chat commands
+	// (POST /admin/config/chat/commands)
+	SetChatCustomCommands(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /admin/config/chat/disable)
 	SetChatDisabledOptions(w http.ResponseWriter, r *http.Request)
 	// Disable chat
@@ -129,6 +267,18 @@ type ServerInterface interface {
 	// (POST /admin/config/chat/joinmessagesenabled)
 	SetChatJoinMessagesEnabled(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /admin/config/chat/retention)
+	SetChatRetentionOptions(w http.ResponseWriter, r *http.Request)
+	// Set how long chat messages are kept
+	// (POST /admin/config/chat/retention)
+	SetChatRetention(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/chat/reviewqueue)
+	SetChatReviewQueueOptions(w http.ResponseWriter, r *http.Request)
+	// Set which chat messages are held for review
+	// (POST /admin/config/chat/reviewqueue)
+	SetChatReviewQueue(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /admin/config/chat/slurfilterenabled)
 	SetChatSlurFilterEnabledOptions(w http.ResponseWriter, r *http.Request)
 	// Set slur filter enabled
@@ -147,6 +297,12 @@ type ServerInterface interface {
 	// (POST /admin/config/chat/suggestedusernames)
 	SetSuggestedUsernameList(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /admin/config/chat/userdirectmessages)
+	SetChatUserDirectMessagesEnabledOptions(w http.ResponseWriter, r *http.Request)
+	// Set if chat users can send direct messages to moderators
+	// (POST /admin/config/chat/userdirectmessages)
+	SetChatUserDirectMessagesEnabled(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /admin/config/customjavascript)
 	SetCustomJavascriptOptions(w http.ResponseWriter, r *http.Request)
 	// Update custom JavaScript
@@ -249,6 +405,60 @@ type ServerInterface interface {
 	// (POST /admin/config/notifications/discord)
 	SetDiscordNotificationConfiguration(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /admin/config/notifications/email)
+	SetEmailNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request)
+	// Configure email (SMTP) notifications
+	// (POST /admin/config/notifications/email)
+	SetEmailNotificationConfiguration(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/notifications/gotify)
+	SetGotifyNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request)
+	// Configure Gotify notifications
+	// (POST /admin/config/notifications/gotify)
+	SetGotifyNotificationConfiguration(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/notifications/matrix)
+	SetMatrixNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request)
+	// Configure Matrix notifications
+	// (POST /admin/config/notifications/matrix)
+	SetMatrixNotificationConfiguration(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/notifications/ntfy)
+	SetNtfyNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request)
+	// Configure ntfy notifications
+	// (POST /admin/config/notifications/ntfy)
+	SetNtfyNotificationConfiguration(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/notifications/reports)
+	SetStreamReportChannelOptions(w http.ResponseWriter, r *http.Request)
+	// Set the channel end of stream reports are sent to
+	// (POST /admin/config/notifications/reports)
+	SetStreamReportChannel(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/notifications/rules)
+	SetNotificationRulesOptions(w http.ResponseWriter, r *http.Request)
+	// Set the go-live notification rules
+	// (POST /admin/config/notifications/rules)
+	SetNotificationRules(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/notifications/slack)
+	SetSlackNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request)
+	// Configure Slack notifications
+	// (POST /admin/config/notifications/slack)
+	SetSlackNotificationConfiguration(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/notifications/telegram)
+	SetTelegramNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request)
+	// Configure Telegram notifications
+	// (POST /admin/config/notifications/telegram)
+	SetTelegramNotificationConfiguration(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/config/notifications/test)
+	SendTestNotificationOptions(w http.ResponseWriter, r *http.Request)
+	// Send a test notification
+	// (POST /admin/config/notifications/test)
+	SendTestNotification(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /admin/config/nsfw)
 	SetNSFWOptions(w http.ResponseWriter, r *http.Request)
 	// Update NSFW marking
@@ -279,6 +489,12 @@ type ServerInterface interface {
 	// (POST /admin/config/s3)
 	SetS3Configuration(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /admin/config/schedule/reminder)
+	SetScheduleReminderMinutesOptions(w http.ResponseWriter, r *http.Request)
+	// Set when scheduled stream reminders are sent
+	// (POST /admin/config/schedule/reminder)
+	SetScheduleReminderMinutes(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /admin/config/serversummary)
 	SetServerSummaryOptions(w http.ResponseWriter, r *http.Request)
 	// Change the server summary
@@ -455,6 +671,42 @@ type ServerInterface interface {
 	// Endpoint to interface with Prometheus
 	// (PUT /admin/prometheus)
 	PutPrometheusAPI(w http.ResponseWriter, r *http.Request)
+	// Get the end of stream reports
+	// (GET /admin/reports)
+	GetStreamReports(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/reports)
+	GetStreamReportsOptions(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/reports/delete)
+	DeleteStreamReportOptions(w http.ResponseWriter, r *http.Request)
+	// Delete an end of stream report
+	// (POST /admin/reports/delete)
+	DeleteStreamReport(w http.ResponseWriter, r *http.Request)
+	// Get all scheduled streams
+	// (GET /admin/schedule)
+	GetScheduledStreamsAdmin(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/schedule)
+	GetScheduledStreamsAdminOptions(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/schedule/create)
+	CreateScheduledStreamOptions(w http.ResponseWriter, r *http.Request)
+	// Schedule an upcoming stream
+	// (POST /admin/schedule/create)
+	CreateScheduledStream(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/schedule/delete)
+	DeleteScheduledStreamOptions(w http.ResponseWriter, r *http.Request)
+	// Delete a scheduled stream
+	// (POST /admin/schedule/delete)
+	DeleteScheduledStream(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/schedule/update)
+	UpdateScheduledStreamOptions(w http.ResponseWriter, r *http.Request)
+	// Update a scheduled stream
+	// (POST /admin/schedule/update)
+	UpdateScheduledStream(w http.ResponseWriter, r *http.Request)
 	// Get the current server config
 	// (GET /admin/serverconfig)
 	GetServerConfig(w http.ResponseWriter, r *http.Request)
@@ -515,6 +767,30 @@ type ServerInterface interface {
 	// Delete a single webhook
 	// (POST /admin/webhooks/delete)
 	DeleteWebhook(w http.ResponseWriter, r *http.Request)
+	// Get the delivery log of a webhook
+	// (GET /admin/webhooks/deliveries)
+	GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, params GetWebhookDeliveriesParams)
+
+	// (OPTIONS /admin/webhooks/deliveries)
+	GetWebhookDeliveriesOptions(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/webhooks/deliveries/replay)
+	ReplayWebhookDeliveryOptions(w http.ResponseWriter, r *http.Request)
+	// Replay a webhook delivery
+	// (POST /admin/webhooks/deliveries/replay)
+	ReplayWebhookDelivery(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/webhooks/payload)
+	UpdateWebhookPayloadOptions(w http.ResponseWriter, r *http.Request)
+	// Set the payload template and filter of a webhook
+	// (POST /admin/webhooks/payload)
+	UpdateWebhookPayload(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /admin/webhooks/test)
+	SendWebhookTestEventOptions(w http.ResponseWriter, r *http.Request)
+	// Send a webhook a test event
+	// (POST /admin/webhooks/test)
+	SendWebhookTestEvent(w http.ResponseWriter, r *http.Request)
 	// Reset YP configuration
 	// (GET /admin/yp/reset)
 	ResetYPRegistration(w http.ResponseWriter, r *http.Request)
@@ -542,9 +818,33 @@ type ServerInterface interface {
 	// Gets a list of chat messages
 	// (GET /chat)
 	GetChatMessages(w http.ResponseWriter, r *http.Request, params GetChatMessagesParams)
+	// Get the chat messages held for review
+	// (GET /chat/messages/held)
+	GetHeldMessages(w http.ResponseWriter, r *http.Request, params GetHeldMessagesParams)
+	// Approve or reject a held chat message
+	// (POST /chat/messages/review)
+	ReviewHeldMessage(w http.ResponseWriter, r *http.Request, params ReviewHeldMessageParams)
+	// Search the chat history
+	// (GET /chat/messages/search)
+	SearchChatMessages(w http.ResponseWriter, r *http.Request, params SearchChatMessagesParams)
+
+	// (OPTIONS /chat/messages/search)
+	SearchChatMessagesOptions(w http.ResponseWriter, r *http.Request)
 	// Update chat message visibility
 	// (POST /chat/messagevisibility)
 	UpdateMessageVisibility(w http.ResponseWriter, r *http.Request, params UpdateMessageVisibilityParams)
+	// Update the chat modes
+	// (POST /chat/modes)
+	UpdateChatModes(w http.ResponseWriter, r *http.Request, params UpdateChatModesParams)
+	// Get the running chat poll
+	// (GET /chat/polls/active)
+	GetActivePoll(w http.ResponseWriter, r *http.Request, params GetActivePollParams)
+	// End a chat poll
+	// (POST /chat/polls/end)
+	EndPoll(w http.ResponseWriter, r *http.Request, params EndPollParams)
+	// Start a chat poll
+	// (POST /chat/polls/start)
+	StartPoll(w http.ResponseWriter, r *http.Request, params StartPollParams)
 
 	// (OPTIONS /chat/register)
 	RegisterAnonymousChatUserOptions(w http.ResponseWriter, r *http.Request)
@@ -554,6 +854,9 @@ type ServerInterface interface {
 	// Enable/disable a user
 	// (POST /chat/users/setenabled)
 	UpdateUserEnabled(w http.ResponseWriter, r *http.Request, params UpdateUserEnabledParams)
+	// Time out a user
+	// (POST /chat/users/timeout)
+	UpdateUserTimeout(w http.ResponseWriter, r *http.Request, params UpdateUserTimeoutParams)
 	// Get the web config
 	// (GET /config)
 	GetWebConfig(w http.ResponseWriter, r *http.Request)
@@ -576,12 +879,30 @@ type ServerInterface interface {
 	// (POST /integrations/chat/action)
 	SendChatAction(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /integrations/chat/directmessage)
+	SendIntegrationDirectMessageOptions(w http.ResponseWriter, r *http.Request)
+	// Send a private message to a chat user as a specific 3rd party bot/integration based on its access token
+	// (POST /integrations/chat/directmessage)
+	SendIntegrationDirectMessage(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /integrations/chat/messagevisibility)
 	ExternalUpdateMessageVisibilityOptions(w http.ResponseWriter, r *http.Request)
 	// Hide chat message
 	// (POST /integrations/chat/messagevisibility)
 	ExternalUpdateMessageVisibility(w http.ResponseWriter, r *http.Request)
 
+	// (OPTIONS /integrations/chat/polls/end)
+	EndIntegrationPollOptions(w http.ResponseWriter, r *http.Request)
+	// End a chat poll as a 3rd party bot/integration
+	// (POST /integrations/chat/polls/end)
+	EndIntegrationPoll(w http.ResponseWriter, r *http.Request)
+
+	// (OPTIONS /integrations/chat/polls/start)
+	StartIntegrationPollOptions(w http.ResponseWriter, r *http.Request)
+	// Start a chat poll as a 3rd party bot/integration
+	// (POST /integrations/chat/polls/start)
+	StartIntegrationPoll(w http.ResponseWriter, r *http.Request)
+
 	// (OPTIONS /integrations/chat/send)
 	SendIntegrationChatMessageOptions(w http.ResponseWriter, r *http.Request)
 	// Send a message to chat as a specific 3rd party bot/integration based on its access token
@@ -629,15 +950,36 @@ type ServerInterface interface {
 	// Get a user's details
 	// (GET /moderation/chat/user/{userId})
 	GetUserDetails(w http.ResponseWriter, r *http.Request, userId string, params GetUserDetailsParams)
+	// Show the page to confirm an email subscription
+	// (GET /notifications/email/confirm)
+	ConfirmEmailNotificationSubscription(w http.ResponseWriter, r *http.Request, params ConfirmEmailNotificationSubscriptionParams)
+	// Confirm an email subscription
+	// (POST /notifications/email/confirm)
+	SubmitEmailNotificationConfirmation(w http.ResponseWriter, r *http.Request, params SubmitEmailNotificationConfirmationParams)
+	// Subscribe an email address to go-live emails
+	// (POST /notifications/email/subscribe)
+	SubscribeToEmailNotifications(w http.ResponseWriter, r *http.Request, params SubscribeToEmailNotificationsParams)
+	// Unsubscribe an email address from go-live emails
+	// (GET /notifications/email/unsubscribe)
+	UnsubscribeFromEmailNotifications(w http.ResponseWriter, r *http.Request, params UnsubscribeFromEmailNotificationsParams)
+	// Unsubscribe an email address with one click
+	// (POST /notifications/email/unsubscribe)
+	UnsubscribeFromEmailNotificationsOneClick(w http.ResponseWriter, r *http.Request, params UnsubscribeFromEmailNotificationsOneClickParams)
 	// Register for notifications
 	// (POST /notifications/register)
 	RegisterForLiveNotifications(w http.ResponseWriter, r *http.Request, params RegisterForLiveNotificationsParams)
 	// Tell the backend you're an active viewer
 	// (GET /ping)
-	Ping(w http.ResponseWriter, r *http.Request)
+	Ping(w http.ResponseWriter, r *http.Request, params PingParams)
 	// Request remote follow
 	// (POST /remotefollow)
 	RemoteFollow(w http.ResponseWriter, r *http.Request)
+	// Get the upcoming scheduled streams
+	// (GET /schedule)
+	GetSchedule(w http.ResponseWriter, r *http.Request)
+	// Get the scheduled streams as a calendar feed
+	// (GET /schedule.ics)
+	GetScheduleCalendar(w http.ResponseWriter, r *http.Request)
 	// Get all social platforms
 	// (GET /socialplatforms)
 	GetAllSocialPlatforms(w http.ResponseWriter, r *http.Request)
@@ -689,6 +1031,83 @@ func (_ Unimplemented) DeleteExternalAPIUser(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the alert history
+// (GET /admin/alerts)
+func (_ Unimplemented) GetAlerts(w http.ResponseWriter, r *http.Request, params GetAlertsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/alerts)
+func (_ Unimplemented) GetAlertsOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a breakdown of viewers by location, device, watch time and referrer
+// (GET /admin/analytics/viewers)
+func (_ Unimplemented) GetViewerAnalytics(w http.ResponseWriter, r *http.Request, params GetViewerAnalyticsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/analytics/viewers)
+func (_ Unimplemented) GetViewerAnalyticsOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the broadcast history
+// (GET /admin/broadcasts)
+func (_ Unimplemented) GetBroadcasts(w http.ResponseWriter, r *http.Request, params GetBroadcastsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/broadcasts)
+func (_ Unimplemented) GetBroadcastsOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the viewer count over time of a single broadcast
+// (GET /admin/broadcasts/viewersOverTime)
+func (_ Unimplemented) GetBroadcastViewersOverTime(w http.ResponseWriter, r *http.Request, params GetBroadcastViewersOverTimeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/broadcasts/viewersOverTime)
+func (_ Unimplemented) GetBroadcastViewersOverTimeOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the broadcast chat archives
+// (GET /admin/chat/archives)
+func (_ Unimplemented) GetChatArchives(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/archives)
+func (_ Unimplemented) GetChatArchivesOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/archives/delete)
+func (_ Unimplemented) DeleteChatArchiveOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a broadcast chat archive
+// (POST /admin/chat/archives/delete)
+func (_ Unimplemented) DeleteChatArchive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a broadcast chat archive
+// (GET /admin/chat/archives/download)
+func (_ Unimplemented) DownloadChatArchive(w http.ResponseWriter, r *http.Request, params DownloadChatArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/archives/download)
+func (_ Unimplemented) DownloadChatArchiveOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get a detailed list of currently connected chat clients
 // (GET /admin/chat/clients)
 func (_ Unimplemented) GetConnectedChatClients(w http.ResponseWriter, r *http.Request) {
@@ -700,6 +1119,50 @@ func (_ Unimplemented) GetConnectedChatClientsOptions(w http.ResponseWriter, r *
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get all the chat filter rules
+// (GET /admin/chat/filters)
+func (_ Unimplemented) GetChatFilterRules(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/filters)
+func (_ Unimplemented) GetChatFilterRulesOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/filters/create)
+func (_ Unimplemented) CreateChatFilterRuleOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a chat filter rule
+// (POST /admin/chat/filters/create)
+func (_ Unimplemented) CreateChatFilterRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/filters/delete)
+func (_ Unimplemented) DeleteChatFilterRuleOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a chat filter rule
+// (POST /admin/chat/filters/delete)
+func (_ Unimplemented) DeleteChatFilterRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/filters/update)
+func (_ Unimplemented) UpdateChatFilterRuleOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a chat filter rule
+// (POST /admin/chat/filters/update)
+func (_ Unimplemented) UpdateChatFilterRule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get all chat messages for the admin, unfiltered
 // (GET /admin/chat/messages)
 func (_ Unimplemented) GetChatMessagesAdmin(w http.ResponseWriter, r *http.Request) {
@@ -711,6 +1174,39 @@ func (_ Unimplemented) GetChatMessagesAdminOptions(w http.ResponseWriter, r *htt
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the chat messages held for review
+// (GET /admin/chat/messages/held)
+func (_ Unimplemented) GetHeldMessagesAdmin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/messages/held)
+func (_ Unimplemented) GetHeldMessagesAdminOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/messages/review)
+func (_ Unimplemented) ReviewHeldMessageAdminOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approve or reject a held chat message
+// (POST /admin/chat/messages/review)
+func (_ Unimplemented) ReviewHeldMessageAdmin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search the chat history
+// (GET /admin/chat/messages/search)
+func (_ Unimplemented) SearchChatMessagesAdmin(w http.ResponseWriter, r *http.Request, params SearchChatMessagesAdminParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/messages/search)
+func (_ Unimplemented) SearchChatMessagesAdminOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/chat/messagevisibility)
 func (_ Unimplemented) UpdateMessageVisibilityAdminOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -722,6 +1218,61 @@ func (_ Unimplemented) UpdateMessageVisibilityAdmin(w http.ResponseWriter, r *ht
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get a paginated list of moderation actions
+// (GET /admin/chat/moderation/actions)
+func (_ Unimplemented) GetModerationActions(w http.ResponseWriter, r *http.Request, params GetModerationActionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/moderation/actions)
+func (_ Unimplemented) GetModerationActionsOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/modes)
+func (_ Unimplemented) UpdateChatModesAdminOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update the chat modes
+// (POST /admin/chat/modes)
+func (_ Unimplemented) UpdateChatModesAdmin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the chat polls
+// (GET /admin/chat/polls)
+func (_ Unimplemented) GetPollsAdmin(w http.ResponseWriter, r *http.Request, params GetPollsAdminParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/polls)
+func (_ Unimplemented) GetPollsAdminOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/polls/end)
+func (_ Unimplemented) EndPollAdminOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// End a chat poll
+// (POST /admin/chat/polls/end)
+func (_ Unimplemented) EndPollAdmin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/polls/start)
+func (_ Unimplemented) StartPollAdminOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a chat poll
+// (POST /admin/chat/polls/start)
+func (_ Unimplemented) StartPollAdmin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get a list of disabled users
 // (GET /admin/chat/users/disabled)
 func (_ Unimplemented) GetDisabledUsers(w http.ResponseWriter, r *http.Request) {
@@ -799,6 +1350,28 @@ func (_ Unimplemented) UpdateUserModerator(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /admin/chat/users/timeout)
+func (_ Unimplemented) UpdateUserTimeoutAdminOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Time out a user
+// (POST /admin/chat/users/timeout)
+func (_ Unimplemented) UpdateUserTimeoutAdmin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a list of users currently timed out
+// (GET /admin/chat/users/timeouts)
+func (_ Unimplemented) GetUserTimeouts(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/chat/users/timeouts)
+func (_ Unimplemented) GetUserTimeoutsOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/config/adminpass)
 func (_ Unimplemented) SetAdminPasswordOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -810,6 +1383,17 @@ func (_ Unimplemented) SetAdminPassword(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /admin/config/alerts/rules)
+func (_ Unimplemented) SetAlertRulesOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the alerting rules
+// (POST /admin/config/alerts/rules)
+func (_ Unimplemented) SetAlertRules(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/config/appearance)
 func (_ Unimplemented) SetCustomColorVariableValuesOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -821,6 +1405,17 @@ func (_ Unimplemented) SetCustomColorVariableValues(w http.ResponseWriter, r *ht
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /admin/config/chat/commands)
+func (_ Unimplemented) SetChatCustomCommandsOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the custom chat commands
+// (POST /admin/config/chat/commands)
+func (_ Unimplemented) SetChatCustomCommands(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/config/chat/disable)
 func (_ Unimplemented) SetChatDisabledOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -865,6 +1460,28 @@ func (_ Unimplemented) SetChatJoinMessagesEnabled(w http.ResponseWriter, r *http
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /admin/config/chat/retention)
+func (_ Unimplemented) SetChatRetentionOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set how long chat messages are kept
+// (POST /admin/config/chat/retention)
+func (_ Unimplemented) SetChatRetention(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/chat/reviewqueue)
+func (_ Unimplemented) SetChatReviewQueueOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set which chat messages are held for review
+// (POST /admin/config/chat/reviewqueue)
+func (_ Unimplemented) SetChatReviewQueue(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/config/chat/slurfilterenabled)
 func (_ Unimplemented) SetChatSlurFilterEnabledOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -898,6 +1515,17 @@ func (_ Unimplemented) SetSuggestedUsernameList(w http.ResponseWriter, r *http.R
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /admin/config/chat/userdirectmessages)
+func (_ Unimplemented) SetChatUserDirectMessagesEnabledOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set if chat users can send direct messages to moderators
+// (POST /admin/config/chat/userdirectmessages)
+func (_ Unimplemented) SetChatUserDirectMessagesEnabled(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/config/customjavascript)
 func (_ Unimplemented) SetCustomJavascriptOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1085,6 +1713,105 @@ func (_ Unimplemented) SetDiscordNotificationConfiguration(w http.ResponseWriter
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /admin/config/notifications/email)
+func (_ Unimplemented) SetEmailNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Configure email (SMTP) notifications
+// (POST /admin/config/notifications/email)
+func (_ Unimplemented) SetEmailNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/notifications/gotify)
+func (_ Unimplemented) SetGotifyNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Configure Gotify notifications
+// (POST /admin/config/notifications/gotify)
+func (_ Unimplemented) SetGotifyNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/notifications/matrix)
+func (_ Unimplemented) SetMatrixNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Configure Matrix notifications
+// (POST /admin/config/notifications/matrix)
+func (_ Unimplemented) SetMatrixNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/notifications/ntfy)
+func (_ Unimplemented) SetNtfyNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Configure ntfy notifications
+// (POST /admin/config/notifications/ntfy)
+func (_ Unimplemented) SetNtfyNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/notifications/reports)
+func (_ Unimplemented) SetStreamReportChannelOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the channel end of stream reports are sent to
+// (POST /admin/config/notifications/reports)
+func (_ Unimplemented) SetStreamReportChannel(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/notifications/rules)
+func (_ Unimplemented) SetNotificationRulesOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the go-live notification rules
+// (POST /admin/config/notifications/rules)
+func (_ Unimplemented) SetNotificationRules(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/notifications/slack)
+func (_ Unimplemented) SetSlackNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Configure Slack notifications
+// (POST /admin/config/notifications/slack)
+func (_ Unimplemented) SetSlackNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/notifications/telegram)
+func (_ Unimplemented) SetTelegramNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Configure Telegram notifications
+// (POST /admin/config/notifications/telegram)
+func (_ Unimplemented) SetTelegramNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/config/notifications/test)
+func (_ Unimplemented) SendTestNotificationOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send a test notification
+// (POST /admin/config/notifications/test)
+func (_ Unimplemented) SendTestNotification(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/config/nsfw)
 func (_ Unimplemented) SetNSFWOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1140,6 +1867,17 @@ func (_ Unimplemented) SetS3Configuration(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /admin/config/schedule/reminder)
+func (_ Unimplemented) SetScheduleReminderMinutesOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set when scheduled stream reminders are sent
+// (POST /admin/config/schedule/reminder)
+func (_ Unimplemented) SetScheduleReminderMinutes(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /admin/config/serversummary)
 func (_ Unimplemented) SetServerSummaryOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1467,58 +2205,124 @@ func (_ Unimplemented) PutPrometheusAPI(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get the current server config
-// (GET /admin/serverconfig)
-func (_ Unimplemented) GetServerConfig(w http.ResponseWriter, r *http.Request) {
+// Get the end of stream reports
+// (GET /admin/reports)
+func (_ Unimplemented) GetStreamReports(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (OPTIONS /admin/serverconfig)
-func (_ Unimplemented) GetServerConfigOptions(w http.ResponseWriter, r *http.Request) {
+// (OPTIONS /admin/reports)
+func (_ Unimplemented) GetStreamReportsOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get current inboard broadcaster
-// (GET /admin/status)
-func (_ Unimplemented) StatusAdmin(w http.ResponseWriter, r *http.Request) {
+// (OPTIONS /admin/reports/delete)
+func (_ Unimplemented) DeleteStreamReportOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (OPTIONS /admin/status)
-func (_ Unimplemented) StatusAdminOptions(w http.ResponseWriter, r *http.Request) {
+// Delete an end of stream report
+// (POST /admin/reports/delete)
+func (_ Unimplemented) DeleteStreamReport(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Force quit the server and restart it
-// (GET /admin/update/forcequit)
-func (_ Unimplemented) AutoUpdateForceQuit(w http.ResponseWriter, r *http.Request) {
+// Get all scheduled streams
+// (GET /admin/schedule)
+func (_ Unimplemented) GetScheduledStreamsAdmin(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (OPTIONS /admin/update/forcequit)
-func (_ Unimplemented) AutoUpdateForceQuitOptions(w http.ResponseWriter, r *http.Request) {
+// (OPTIONS /admin/schedule)
+func (_ Unimplemented) GetScheduledStreamsAdminOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Return the auto-update features that are supported for this instance
-// (GET /admin/update/options)
-func (_ Unimplemented) AutoUpdateOptions(w http.ResponseWriter, r *http.Request) {
+// (OPTIONS /admin/schedule/create)
+func (_ Unimplemented) CreateScheduledStreamOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (OPTIONS /admin/update/options)
-func (_ Unimplemented) AutoUpdateOptionsOptions(w http.ResponseWriter, r *http.Request) {
+// Schedule an upcoming stream
+// (POST /admin/schedule/create)
+func (_ Unimplemented) CreateScheduledStream(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Begin the auto-update
-// (GET /admin/update/start)
-func (_ Unimplemented) AutoUpdateStart(w http.ResponseWriter, r *http.Request) {
+// (OPTIONS /admin/schedule/delete)
+func (_ Unimplemented) DeleteScheduledStreamOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// (OPTIONS /admin/update/start)
-func (_ Unimplemented) AutoUpdateStartOptions(w http.ResponseWriter, r *http.Request) {
+// Delete a scheduled stream
+// (POST /admin/schedule/delete)
+func (_ Unimplemented) DeleteScheduledStream(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/schedule/update)
+func (_ Unimplemented) UpdateScheduledStreamOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a scheduled stream
+// (POST /admin/schedule/update)
+func (_ Unimplemented) UpdateScheduledStream(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the current server config
+// (GET /admin/serverconfig)
+func (_ Unimplemented) GetServerConfig(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/serverconfig)
+func (_ Unimplemented) GetServerConfigOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get current inboard broadcaster
+// (GET /admin/status)
+func (_ Unimplemented) StatusAdmin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/status)
+func (_ Unimplemented) StatusAdminOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Force quit the server and restart it
+// (GET /admin/update/forcequit)
+func (_ Unimplemented) AutoUpdateForceQuit(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/update/forcequit)
+func (_ Unimplemented) AutoUpdateForceQuitOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Return the auto-update features that are supported for this instance
+// (GET /admin/update/options)
+func (_ Unimplemented) AutoUpdateOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/update/options)
+func (_ Unimplemented) AutoUpdateOptionsOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Begin the auto-update
+// (GET /admin/update/start)
+func (_ Unimplemented) AutoUpdateStart(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/update/start)
+func (_ Unimplemented) AutoUpdateStartOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -1577,6 +2381,50 @@ func (_ Unimplemented) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the delivery log of a webhook
+// (GET /admin/webhooks/deliveries)
+func (_ Unimplemented) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, params GetWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/webhooks/deliveries)
+func (_ Unimplemented) GetWebhookDeliveriesOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/webhooks/deliveries/replay)
+func (_ Unimplemented) ReplayWebhookDeliveryOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replay a webhook delivery
+// (POST /admin/webhooks/deliveries/replay)
+func (_ Unimplemented) ReplayWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/webhooks/payload)
+func (_ Unimplemented) UpdateWebhookPayloadOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the payload template and filter of a webhook
+// (POST /admin/webhooks/payload)
+func (_ Unimplemented) UpdateWebhookPayload(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /admin/webhooks/test)
+func (_ Unimplemented) SendWebhookTestEventOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send a webhook a test event
+// (POST /admin/webhooks/test)
+func (_ Unimplemented) SendWebhookTestEvent(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Reset YP configuration
 // (GET /admin/yp/reset)
 func (_ Unimplemented) ResetYPRegistration(w http.ResponseWriter, r *http.Request) {
@@ -1630,12 +2478,59 @@ func (_ Unimplemented) GetChatMessages(w http.ResponseWriter, r *http.Request, p
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the chat messages held for review
+// (GET /chat/messages/held)
+func (_ Unimplemented) GetHeldMessages(w http.ResponseWriter, r *http.Request, params GetHeldMessagesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approve or reject a held chat message
+// (POST /chat/messages/review)
+func (_ Unimplemented) ReviewHeldMessage(w http.ResponseWriter, r *http.Request, params ReviewHeldMessageParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search the chat history
+// (GET /chat/messages/search)
+func (_ Unimplemented) SearchChatMessages(w http.ResponseWriter, r *http.Request, params SearchChatMessagesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /chat/messages/search)
+func (_ Unimplemented) SearchChatMessagesOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Update chat message visibility
 // (POST /chat/messagevisibility)
 func (_ Unimplemented) UpdateMessageVisibility(w http.ResponseWriter, r *http.Request, params UpdateMessageVisibilityParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Update the chat modes
+// (POST /chat/modes)
+func (_ Unimplemented) UpdateChatModes(w http.ResponseWriter, r *http.Request, params UpdateChatModesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the running chat poll
+// (GET /chat/polls/active)
+func (_ Unimplemented) GetActivePoll(w http.ResponseWriter, r *http.Request, params GetActivePollParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// End a chat poll
+// (POST /chat/polls/end)
+func (_ Unimplemented) EndPoll(w http.ResponseWriter, r *http.Request, params EndPollParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a chat poll
+// (POST /chat/polls/start)
+func (_ Unimplemented) StartPoll(w http.ResponseWriter, r *http.Request, params StartPollParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /chat/register)
 func (_ Unimplemented) RegisterAnonymousChatUserOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1653,6 +2548,12 @@ func (_ Unimplemented) UpdateUserEnabled(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Time out a user
+// (POST /chat/users/timeout)
+func (_ Unimplemented) UpdateUserTimeout(w http.ResponseWriter, r *http.Request, params UpdateUserTimeoutParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get the web config
 // (GET /config)
 func (_ Unimplemented) GetWebConfig(w http.ResponseWriter, r *http.Request) {
@@ -1693,6 +2594,17 @@ func (_ Unimplemented) SendChatAction(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /integrations/chat/directmessage)
+func (_ Unimplemented) SendIntegrationDirectMessageOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send a private message to a chat user as a specific 3rd party bot/integration based on its access token
+// (POST /integrations/chat/directmessage)
+func (_ Unimplemented) SendIntegrationDirectMessage(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /integrations/chat/messagevisibility)
 func (_ Unimplemented) ExternalUpdateMessageVisibilityOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1704,6 +2616,28 @@ func (_ Unimplemented) ExternalUpdateMessageVisibility(w http.ResponseWriter, r
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (OPTIONS /integrations/chat/polls/end)
+func (_ Unimplemented) EndIntegrationPollOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// End a chat poll as a 3rd party bot/integration
+// (POST /integrations/chat/polls/end)
+func (_ Unimplemented) EndIntegrationPoll(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (OPTIONS /integrations/chat/polls/start)
+func (_ Unimplemented) StartIntegrationPollOptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a chat poll as a 3rd party bot/integration
+// (POST /integrations/chat/polls/start)
+func (_ Unimplemented) StartIntegrationPoll(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (OPTIONS /integrations/chat/send)
 func (_ Unimplemented) SendIntegrationChatMessageOptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1794,6 +2728,36 @@ func (_ Unimplemented) GetUserDetails(w http.ResponseWriter, r *http.Request, us
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Show the page to confirm an email subscription
+// (GET /notifications/email/confirm)
+func (_ Unimplemented) ConfirmEmailNotificationSubscription(w http.ResponseWriter, r *http.Request, params ConfirmEmailNotificationSubscriptionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Confirm an email subscription
+// (POST /notifications/email/confirm)
+func (_ Unimplemented) SubmitEmailNotificationConfirmation(w http.ResponseWriter, r *http.Request, params SubmitEmailNotificationConfirmationParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Subscribe an email address to go-live emails
+// (POST /notifications/email/subscribe)
+func (_ Unimplemented) SubscribeToEmailNotifications(w http.ResponseWriter, r *http.Request, params SubscribeToEmailNotificationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unsubscribe an email address from go-live emails
+// (GET /notifications/email/unsubscribe)
+func (_ Unimplemented) UnsubscribeFromEmailNotifications(w http.ResponseWriter, r *http.Request, params UnsubscribeFromEmailNotificationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unsubscribe an email address with one click
+// (POST /notifications/email/unsubscribe)
+func (_ Unimplemented) UnsubscribeFromEmailNotificationsOneClick(w http.ResponseWriter, r *http.Request, params UnsubscribeFromEmailNotificationsOneClickParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Register for notifications
 // (POST /notifications/register)
 func (_ Unimplemented) RegisterForLiveNotifications(w http.ResponseWriter, r *http.Request, params RegisterForLiveNotificationsParams) {
@@ -1802,7 +2766,7 @@ func (_ Unimplemented) RegisterForLiveNotifications(w http.ResponseWriter, r *ht
 
 // Tell the backend you're an active viewer
 // (GET /ping)
-func (_ Unimplemented) Ping(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) Ping(w http.ResponseWriter, r *http.Request, params PingParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -1812,6 +2776,18 @@ func (_ Unimplemented) RemoteFollow(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the upcoming scheduled streams
+// (GET /schedule)
+func (_ Unimplemented) GetSchedule(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the scheduled streams as a calendar feed
+// (GET /schedule.ics)
+func (_ Unimplemented) GetScheduleCalendar(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get all social platforms
 // (GET /socialplatforms)
 func (_ Unimplemented) GetAllSocialPlatforms(w http.ResponseWriter, r *http.Request) {
@@ -1847,10 +2823,13 @@ type MiddlewareFunc func(http.Handler) http.Handler
 
 // GetExternalAPIUsers operation middleware
 func (siw *ServerInterfaceWrapper) GetExternalAPIUsers(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetExternalAPIUsers(w, r)
 	}))
@@ -1859,12 +2838,11 @@ func (siw *ServerInterfaceWrapper) GetExternalAPIUsers(w http.ResponseWriter, r
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetExternalAPIUsersOptions operation middleware
 func (siw *ServerInterfaceWrapper) GetExternalAPIUsersOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetExternalAPIUsersOptions(w, r)
@@ -1874,12 +2852,11 @@ func (siw *ServerInterfaceWrapper) GetExternalAPIUsersOptions(w http.ResponseWri
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // CreateExternalAPIUserOptions operation middleware
 func (siw *ServerInterfaceWrapper) CreateExternalAPIUserOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.CreateExternalAPIUserOptions(w, r)
@@ -1889,15 +2866,18 @@ func (siw *ServerInterfaceWrapper) CreateExternalAPIUserOptions(w http.ResponseW
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // CreateExternalAPIUser operation middleware
 func (siw *ServerInterfaceWrapper) CreateExternalAPIUser(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.CreateExternalAPIUser(w, r)
 	}))
@@ -1906,12 +2886,11 @@ func (siw *ServerInterfaceWrapper) CreateExternalAPIUser(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // DeleteExternalAPIUserOptions operation middleware
 func (siw *ServerInterfaceWrapper) DeleteExternalAPIUserOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteExternalAPIUserOptions(w, r)
@@ -1921,15 +2900,18 @@ func (siw *ServerInterfaceWrapper) DeleteExternalAPIUserOptions(w http.ResponseW
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // DeleteExternalAPIUser operation middleware
 func (siw *ServerInterfaceWrapper) DeleteExternalAPIUser(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.DeleteExternalAPIUser(w, r)
 	}))
@@ -1938,2892 +2920,5212 @@ func (siw *ServerInterfaceWrapper) DeleteExternalAPIUser(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetConnectedChatClients operation middleware
-func (siw *ServerInterfaceWrapper) GetConnectedChatClients(w http.ResponseWriter, r *http.Request) {
+// GetAlerts operation middleware
+func (siw *ServerInterfaceWrapper) GetAlerts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAlertsParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetConnectedChatClients(w, r)
+		siw.Handler.GetAlerts(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetConnectedChatClientsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetConnectedChatClientsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetAlertsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetAlertsOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetConnectedChatClientsOptions(w, r)
+		siw.Handler.GetAlertsOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetChatMessagesAdmin operation middleware
-func (siw *ServerInterfaceWrapper) GetChatMessagesAdmin(w http.ResponseWriter, r *http.Request) {
+// GetViewerAnalytics operation middleware
+func (siw *ServerInterfaceWrapper) GetViewerAnalytics(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetChatMessagesAdmin(w, r)
-	}))
+	r = r.WithContext(ctx)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetViewerAnalyticsParams
+
+	// ------------- Optional query parameter "broadcastId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "broadcastId", r.URL.Query(), &params.BroadcastId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "broadcastId", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "since" -------------
 
-// GetChatMessagesAdminOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetChatMessagesAdminOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetChatMessagesAdminOptions(w, r)
+		siw.Handler.GetViewerAnalytics(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// UpdateMessageVisibilityAdminOptions operation middleware
-func (siw *ServerInterfaceWrapper) UpdateMessageVisibilityAdminOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetViewerAnalyticsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetViewerAnalyticsOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateMessageVisibilityAdminOptions(w, r)
+		siw.Handler.GetViewerAnalyticsOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// UpdateMessageVisibilityAdmin operation middleware
-func (siw *ServerInterfaceWrapper) UpdateMessageVisibilityAdmin(w http.ResponseWriter, r *http.Request) {
+// GetBroadcasts operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcasts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateMessageVisibilityAdmin(w, r)
-	}))
+	r = r.WithContext(ctx)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBroadcastsParams
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "offset" -------------
 
-// GetDisabledUsers operation middleware
-func (siw *ServerInterfaceWrapper) GetDisabledUsers(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDisabledUsers(w, r)
+		siw.Handler.GetBroadcasts(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetDisabledUsersOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetDisabledUsersOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetBroadcastsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcastsOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDisabledUsersOptions(w, r)
+		siw.Handler.GetBroadcastsOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetIPAddressBans operation middleware
-func (siw *ServerInterfaceWrapper) GetIPAddressBans(w http.ResponseWriter, r *http.Request) {
+// GetBroadcastViewersOverTime operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcastViewersOverTime(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBroadcastViewersOverTimeParams
+
+	// ------------- Required query parameter "id" -------------
+
+	if paramValue := r.URL.Query().Get("id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetIPAddressBans(w, r)
+		siw.Handler.GetBroadcastViewersOverTime(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetIPAddressBansOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetIPAddressBansOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetBroadcastViewersOverTimeOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetBroadcastViewersOverTimeOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetIPAddressBansOptions(w, r)
+		siw.Handler.GetBroadcastViewersOverTimeOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// BanIPAddressOptions operation middleware
-func (siw *ServerInterfaceWrapper) BanIPAddressOptions(w http.ResponseWriter, r *http.Request) {
+// GetChatArchives operation middleware
+func (siw *ServerInterfaceWrapper) GetChatArchives(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.BanIPAddressOptions(w, r)
+		siw.Handler.GetChatArchives(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// BanIPAddress operation middleware
-func (siw *ServerInterfaceWrapper) BanIPAddress(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// GetChatArchivesOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetChatArchivesOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.BanIPAddress(w, r)
+		siw.Handler.GetChatArchivesOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// UnbanIPAddressOptions operation middleware
-func (siw *ServerInterfaceWrapper) UnbanIPAddressOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// DeleteChatArchiveOptions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteChatArchiveOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UnbanIPAddressOptions(w, r)
+		siw.Handler.DeleteChatArchiveOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// UnbanIPAddress operation middleware
-func (siw *ServerInterfaceWrapper) UnbanIPAddress(w http.ResponseWriter, r *http.Request) {
+// DeleteChatArchive operation middleware
+func (siw *ServerInterfaceWrapper) DeleteChatArchive(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UnbanIPAddress(w, r)
+		siw.Handler.DeleteChatArchive(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetModerators operation middleware
-func (siw *ServerInterfaceWrapper) GetModerators(w http.ResponseWriter, r *http.Request) {
+// DownloadChatArchive operation middleware
+func (siw *ServerInterfaceWrapper) DownloadChatArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetModerators(w, r)
-	}))
+	r = r.WithContext(ctx)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadChatArchiveParams
+
+	// ------------- Required query parameter "id" -------------
+
+	if paramValue := r.URL.Query().Get("id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	err = runtime.BindQueryParameter("form", true, true, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-// GetModeratorsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetModeratorsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetModeratorsOptions(w, r)
+		siw.Handler.DownloadChatArchive(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// UpdateUserEnabledAdminOptions operation middleware
-func (siw *ServerInterfaceWrapper) UpdateUserEnabledAdminOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// DownloadChatArchiveOptions operation middleware
+func (siw *ServerInterfaceWrapper) DownloadChatArchiveOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateUserEnabledAdminOptions(w, r)
+		siw.Handler.DownloadChatArchiveOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// UpdateUserEnabledAdmin operation middleware
-func (siw *ServerInterfaceWrapper) UpdateUserEnabledAdmin(w http.ResponseWriter, r *http.Request) {
+// GetConnectedChatClients operation middleware
+func (siw *ServerInterfaceWrapper) GetConnectedChatClients(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateUserEnabledAdmin(w, r)
+		siw.Handler.GetConnectedChatClients(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// UpdateUserModeratorOptions operation middleware
-func (siw *ServerInterfaceWrapper) UpdateUserModeratorOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetConnectedChatClientsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetConnectedChatClientsOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateUserModeratorOptions(w, r)
+		siw.Handler.GetConnectedChatClientsOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// UpdateUserModerator operation middleware
-func (siw *ServerInterfaceWrapper) UpdateUserModerator(w http.ResponseWriter, r *http.Request) {
+// GetChatFilterRules operation middleware
+func (siw *ServerInterfaceWrapper) GetChatFilterRules(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateUserModerator(w, r)
+		siw.Handler.GetChatFilterRules(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetAdminPasswordOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetAdminPasswordOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetChatFilterRulesOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetChatFilterRulesOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetAdminPasswordOptions(w, r)
+		siw.Handler.GetChatFilterRulesOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetAdminPassword operation middleware
-func (siw *ServerInterfaceWrapper) SetAdminPassword(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// CreateChatFilterRuleOptions operation middleware
+func (siw *ServerInterfaceWrapper) CreateChatFilterRuleOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetAdminPassword(w, r)
+		siw.Handler.CreateChatFilterRuleOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetCustomColorVariableValuesOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetCustomColorVariableValuesOptions(w http.ResponseWriter, r *http.Request) {
+// CreateChatFilterRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateChatFilterRule(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetCustomColorVariableValuesOptions(w, r)
+		siw.Handler.CreateChatFilterRule(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetCustomColorVariableValues operation middleware
-func (siw *ServerInterfaceWrapper) SetCustomColorVariableValues(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// DeleteChatFilterRuleOptions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteChatFilterRuleOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetCustomColorVariableValues(w, r)
+		siw.Handler.DeleteChatFilterRuleOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetChatDisabledOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetChatDisabledOptions(w http.ResponseWriter, r *http.Request) {
+// DeleteChatFilterRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteChatFilterRule(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetChatDisabledOptions(w, r)
+		siw.Handler.DeleteChatFilterRule(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetChatDisabled operation middleware
-func (siw *ServerInterfaceWrapper) SetChatDisabled(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// UpdateChatFilterRuleOptions operation middleware
+func (siw *ServerInterfaceWrapper) UpdateChatFilterRuleOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetChatDisabled(w, r)
+		siw.Handler.UpdateChatFilterRuleOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetEnableEstablishedChatUserModeOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetEnableEstablishedChatUserModeOptions(w http.ResponseWriter, r *http.Request) {
+// UpdateChatFilterRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateChatFilterRule(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetEnableEstablishedChatUserModeOptions(w, r)
+		siw.Handler.UpdateChatFilterRule(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetEnableEstablishedChatUserMode operation middleware
-func (siw *ServerInterfaceWrapper) SetEnableEstablishedChatUserMode(w http.ResponseWriter, r *http.Request) {
+// GetChatMessagesAdmin operation middleware
+func (siw *ServerInterfaceWrapper) GetChatMessagesAdmin(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetEnableEstablishedChatUserMode(w, r)
+		siw.Handler.GetChatMessagesAdmin(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetForbiddenUsernameListOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetForbiddenUsernameListOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetChatMessagesAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetChatMessagesAdminOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetForbiddenUsernameListOptions(w, r)
+		siw.Handler.GetChatMessagesAdminOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetForbiddenUsernameList operation middleware
-func (siw *ServerInterfaceWrapper) SetForbiddenUsernameList(w http.ResponseWriter, r *http.Request) {
+// GetHeldMessagesAdmin operation middleware
+func (siw *ServerInterfaceWrapper) GetHeldMessagesAdmin(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetForbiddenUsernameList(w, r)
+		siw.Handler.GetHeldMessagesAdmin(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetChatJoinMessagesEnabledOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetChatJoinMessagesEnabledOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetHeldMessagesAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetHeldMessagesAdminOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetChatJoinMessagesEnabledOptions(w, r)
+		siw.Handler.GetHeldMessagesAdminOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetChatJoinMessagesEnabled operation middleware
-func (siw *ServerInterfaceWrapper) SetChatJoinMessagesEnabled(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// ReviewHeldMessageAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) ReviewHeldMessageAdminOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetChatJoinMessagesEnabled(w, r)
+		siw.Handler.ReviewHeldMessageAdminOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetChatSlurFilterEnabledOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetChatSlurFilterEnabledOptions(w http.ResponseWriter, r *http.Request) {
+// ReviewHeldMessageAdmin operation middleware
+func (siw *ServerInterfaceWrapper) ReviewHeldMessageAdmin(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetChatSlurFilterEnabledOptions(w, r)
+		siw.Handler.ReviewHeldMessageAdmin(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetChatSlurFilterEnabled operation middleware
-func (siw *ServerInterfaceWrapper) SetChatSlurFilterEnabled(w http.ResponseWriter, r *http.Request) {
+// SearchChatMessagesAdmin operation middleware
+func (siw *ServerInterfaceWrapper) SearchChatMessagesAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetChatSlurFilterEnabled(w, r)
-	}))
+	r = r.WithContext(ctx)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchChatMessagesAdminParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "limit" -------------
 
-// SetChatSpamProtectionEnabledOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetChatSpamProtectionEnabledOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetChatSpamProtectionEnabledOptions(w, r)
-	}))
+	// ------------- Optional query parameter "text" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "text", r.URL.Query(), &params.Text)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "text", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "userId" -------------
 
-// SetChatSpamProtectionEnabled operation middleware
-func (siw *ServerInterfaceWrapper) SetChatSpamProtectionEnabled(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "userId", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+	// ------------- Optional query parameter "displayName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "displayName", r.URL.Query(), &params.DisplayName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "displayName", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "visibility" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "visibility", r.URL.Query(), &params.Visibility)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "visibility", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetChatSpamProtectionEnabled(w, r)
+		siw.Handler.SearchChatMessagesAdmin(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetSuggestedUsernameListOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetSuggestedUsernameListOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SearchChatMessagesAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) SearchChatMessagesAdminOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetSuggestedUsernameListOptions(w, r)
+		siw.Handler.SearchChatMessagesAdminOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetSuggestedUsernameList operation middleware
-func (siw *ServerInterfaceWrapper) SetSuggestedUsernameList(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// UpdateMessageVisibilityAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMessageVisibilityAdminOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetSuggestedUsernameList(w, r)
+		siw.Handler.UpdateMessageVisibilityAdminOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetCustomJavascriptOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetCustomJavascriptOptions(w http.ResponseWriter, r *http.Request) {
+// UpdateMessageVisibilityAdmin operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMessageVisibilityAdmin(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetCustomJavascriptOptions(w, r)
+		siw.Handler.UpdateMessageVisibilityAdmin(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetCustomJavascript operation middleware
-func (siw *ServerInterfaceWrapper) SetCustomJavascript(w http.ResponseWriter, r *http.Request) {
+// GetModerationActions operation middleware
+func (siw *ServerInterfaceWrapper) GetModerationActions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetCustomJavascript(w, r)
-	}))
+	r = r.WithContext(ctx)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetModerationActionsParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "limit" -------------
 
-// SetCustomStylesOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetCustomStylesOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetCustomStylesOptions(w, r)
-	}))
+	// ------------- Optional query parameter "action" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "actorId" -------------
 
-// SetCustomStyles operation middleware
-func (siw *ServerInterfaceWrapper) SetCustomStyles(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "actorId", r.URL.Query(), &params.ActorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actorId", Err: err})
+		return
+	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+	// ------------- Optional query parameter "target" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "target", r.URL.Query(), &params.Target)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "target", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetCustomStyles(w, r)
+		siw.Handler.GetModerationActions(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetDirectoryEnabledOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetDirectoryEnabledOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetModerationActionsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetModerationActionsOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetDirectoryEnabledOptions(w, r)
+		siw.Handler.GetModerationActionsOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetDirectoryEnabled operation middleware
-func (siw *ServerInterfaceWrapper) SetDirectoryEnabled(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// UpdateChatModesAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) UpdateChatModesAdminOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetDirectoryEnabled(w, r)
+		siw.Handler.UpdateChatModesAdminOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetDisableSearchIndexingOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetDisableSearchIndexingOptions(w http.ResponseWriter, r *http.Request) {
+// UpdateChatModesAdmin operation middleware
+func (siw *ServerInterfaceWrapper) UpdateChatModesAdmin(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetDisableSearchIndexingOptions(w, r)
+		siw.Handler.UpdateChatModesAdmin(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetDisableSearchIndexing operation middleware
-func (siw *ServerInterfaceWrapper) SetDisableSearchIndexing(w http.ResponseWriter, r *http.Request) {
+// GetPollsAdmin operation middleware
+func (siw *ServerInterfaceWrapper) GetPollsAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetDisableSearchIndexing(w, r)
-	}))
+	r = r.WithContext(ctx)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPollsAdminParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "limit" -------------
 
-// SetExternalActionsOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetExternalActionsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetExternalActionsOptions(w, r)
+		siw.Handler.GetPollsAdmin(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetExternalActions operation middleware
-func (siw *ServerInterfaceWrapper) SetExternalActions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// GetPollsAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetPollsAdminOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetExternalActions(w, r)
+		siw.Handler.GetPollsAdminOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetFederationBlockDomainsOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetFederationBlockDomainsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// EndPollAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) EndPollAdminOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFederationBlockDomainsOptions(w, r)
+		siw.Handler.EndPollAdminOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetFederationBlockDomains operation middleware
-func (siw *ServerInterfaceWrapper) SetFederationBlockDomains(w http.ResponseWriter, r *http.Request) {
+// EndPollAdmin operation middleware
+func (siw *ServerInterfaceWrapper) EndPollAdmin(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFederationBlockDomains(w, r)
+		siw.Handler.EndPollAdmin(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetFederationEnabledOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetFederationEnabledOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// StartPollAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) StartPollAdminOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFederationEnabledOptions(w, r)
+		siw.Handler.StartPollAdminOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetFederationEnabled operation middleware
-func (siw *ServerInterfaceWrapper) SetFederationEnabled(w http.ResponseWriter, r *http.Request) {
+// StartPollAdmin operation middleware
+func (siw *ServerInterfaceWrapper) StartPollAdmin(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFederationEnabled(w, r)
+		siw.Handler.StartPollAdmin(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetFederationGoLiveMessageOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetFederationGoLiveMessageOptions(w http.ResponseWriter, r *http.Request) {
+// GetDisabledUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetDisabledUsers(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFederationGoLiveMessageOptions(w, r)
+		siw.Handler.GetDisabledUsers(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetFederationGoLiveMessage operation middleware
-func (siw *ServerInterfaceWrapper) SetFederationGoLiveMessage(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// GetDisabledUsersOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetDisabledUsersOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFederationGoLiveMessage(w, r)
+		siw.Handler.GetDisabledUsersOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetFederationActivityPrivateOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetFederationActivityPrivateOptions(w http.ResponseWriter, r *http.Request) {
+// GetIPAddressBans operation middleware
+func (siw *ServerInterfaceWrapper) GetIPAddressBans(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFederationActivityPrivateOptions(w, r)
+		siw.Handler.GetIPAddressBans(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetFederationActivityPrivate operation middleware
-func (siw *ServerInterfaceWrapper) SetFederationActivityPrivate(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// GetIPAddressBansOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetIPAddressBansOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFederationActivityPrivate(w, r)
+		siw.Handler.GetIPAddressBansOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetFederationShowEngagementOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetFederationShowEngagementOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// BanIPAddressOptions operation middleware
+func (siw *ServerInterfaceWrapper) BanIPAddressOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFederationShowEngagementOptions(w, r)
+		siw.Handler.BanIPAddressOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetFederationShowEngagement operation middleware
-func (siw *ServerInterfaceWrapper) SetFederationShowEngagement(w http.ResponseWriter, r *http.Request) {
+// BanIPAddress operation middleware
+func (siw *ServerInterfaceWrapper) BanIPAddress(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFederationShowEngagement(w, r)
+		siw.Handler.BanIPAddress(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetFederationUsernameOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetFederationUsernameOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// UnbanIPAddressOptions operation middleware
+func (siw *ServerInterfaceWrapper) UnbanIPAddressOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFederationUsernameOptions(w, r)
+		siw.Handler.UnbanIPAddressOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetFederationUsername operation middleware
-func (siw *ServerInterfaceWrapper) SetFederationUsername(w http.ResponseWriter, r *http.Request) {
+// UnbanIPAddress operation middleware
+func (siw *ServerInterfaceWrapper) UnbanIPAddress(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFederationUsername(w, r)
+		siw.Handler.UnbanIPAddress(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetFfmpegPathOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetFfmpegPathOptions(w http.ResponseWriter, r *http.Request) {
+// GetModerators operation middleware
+func (siw *ServerInterfaceWrapper) GetModerators(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFfmpegPathOptions(w, r)
+		siw.Handler.GetModerators(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetFfmpegPath operation middleware
-func (siw *ServerInterfaceWrapper) SetFfmpegPath(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// GetModeratorsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetModeratorsOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetFfmpegPath(w, r)
+		siw.Handler.GetModeratorsOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetHideViewerCountOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetHideViewerCountOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// UpdateUserEnabledAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUserEnabledAdminOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetHideViewerCountOptions(w, r)
+		siw.Handler.UpdateUserEnabledAdminOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetHideViewerCount operation middleware
-func (siw *ServerInterfaceWrapper) SetHideViewerCount(w http.ResponseWriter, r *http.Request) {
+// UpdateUserEnabledAdmin operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUserEnabledAdmin(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetHideViewerCount(w, r)
+		siw.Handler.UpdateUserEnabledAdmin(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetLogoOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetLogoOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// UpdateUserModeratorOptions operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUserModeratorOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetLogoOptions(w, r)
+		siw.Handler.UpdateUserModeratorOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetLogo operation middleware
-func (siw *ServerInterfaceWrapper) SetLogo(w http.ResponseWriter, r *http.Request) {
+// UpdateUserModerator operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUserModerator(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetLogo(w, r)
+		siw.Handler.UpdateUserModerator(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetServerNameOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetServerNameOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// UpdateUserTimeoutAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUserTimeoutAdminOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetServerNameOptions(w, r)
+		siw.Handler.UpdateUserTimeoutAdminOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetServerName operation middleware
-func (siw *ServerInterfaceWrapper) SetServerName(w http.ResponseWriter, r *http.Request) {
+// UpdateUserTimeoutAdmin operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUserTimeoutAdmin(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetServerName(w, r)
+		siw.Handler.UpdateUserTimeoutAdmin(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetBrowserNotificationConfigurationOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetBrowserNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
+// GetUserTimeouts operation middleware
+func (siw *ServerInterfaceWrapper) GetUserTimeouts(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetBrowserNotificationConfigurationOptions(w, r)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserTimeouts(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetBrowserNotificationConfiguration operation middleware
-func (siw *ServerInterfaceWrapper) SetBrowserNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// GetUserTimeoutsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetUserTimeoutsOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetBrowserNotificationConfiguration(w, r)
+		siw.Handler.GetUserTimeoutsOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetDiscordNotificationConfigurationOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetDiscordNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetAdminPasswordOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetAdminPasswordOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetDiscordNotificationConfigurationOptions(w, r)
+		siw.Handler.SetAdminPasswordOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetDiscordNotificationConfiguration operation middleware
-func (siw *ServerInterfaceWrapper) SetDiscordNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+// SetAdminPassword operation middleware
+func (siw *ServerInterfaceWrapper) SetAdminPassword(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetDiscordNotificationConfiguration(w, r)
+		siw.Handler.SetAdminPassword(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetNSFWOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetNSFWOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetAlertRulesOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetAlertRulesOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetNSFWOptions(w, r)
+		siw.Handler.SetAlertRulesOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetNSFW operation middleware
-func (siw *ServerInterfaceWrapper) SetNSFW(w http.ResponseWriter, r *http.Request) {
+// SetAlertRules operation middleware
+func (siw *ServerInterfaceWrapper) SetAlertRules(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetNSFW(w, r)
+		siw.Handler.SetAlertRules(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetCustomOfflineMessageOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetCustomOfflineMessageOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetCustomColorVariableValuesOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetCustomColorVariableValuesOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetCustomOfflineMessageOptions(w, r)
+		siw.Handler.SetCustomColorVariableValuesOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetCustomOfflineMessage operation middleware
-func (siw *ServerInterfaceWrapper) SetCustomOfflineMessage(w http.ResponseWriter, r *http.Request) {
+// SetCustomColorVariableValues operation middleware
+func (siw *ServerInterfaceWrapper) SetCustomColorVariableValues(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetCustomOfflineMessage(w, r)
+		siw.Handler.SetCustomColorVariableValues(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetExtraPageContentOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetExtraPageContentOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetChatCustomCommandsOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetChatCustomCommandsOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetExtraPageContentOptions(w, r)
+		siw.Handler.SetChatCustomCommandsOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetExtraPageContent operation middleware
-func (siw *ServerInterfaceWrapper) SetExtraPageContent(w http.ResponseWriter, r *http.Request) {
+// SetChatCustomCommands operation middleware
+func (siw *ServerInterfaceWrapper) SetChatCustomCommands(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetExtraPageContent(w, r)
+		siw.Handler.SetChatCustomCommands(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetRTMPServerPortOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetRTMPServerPortOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetChatDisabledOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetChatDisabledOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetRTMPServerPortOptions(w, r)
+		siw.Handler.SetChatDisabledOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetRTMPServerPort operation middleware
-func (siw *ServerInterfaceWrapper) SetRTMPServerPort(w http.ResponseWriter, r *http.Request) {
+// SetChatDisabled operation middleware
+func (siw *ServerInterfaceWrapper) SetChatDisabled(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetRTMPServerPort(w, r)
+		siw.Handler.SetChatDisabled(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetS3ConfigurationOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetS3ConfigurationOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetEnableEstablishedChatUserModeOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetEnableEstablishedChatUserModeOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetS3ConfigurationOptions(w, r)
+		siw.Handler.SetEnableEstablishedChatUserModeOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetS3Configuration operation middleware
-func (siw *ServerInterfaceWrapper) SetS3Configuration(w http.ResponseWriter, r *http.Request) {
+// SetEnableEstablishedChatUserMode operation middleware
+func (siw *ServerInterfaceWrapper) SetEnableEstablishedChatUserMode(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetS3Configuration(w, r)
+		siw.Handler.SetEnableEstablishedChatUserMode(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetServerSummaryOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetServerSummaryOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetForbiddenUsernameListOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetForbiddenUsernameListOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetServerSummaryOptions(w, r)
+		siw.Handler.SetForbiddenUsernameListOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetServerSummary operation middleware
-func (siw *ServerInterfaceWrapper) SetServerSummary(w http.ResponseWriter, r *http.Request) {
+// SetForbiddenUsernameList operation middleware
+func (siw *ServerInterfaceWrapper) SetForbiddenUsernameList(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetServerSummary(w, r)
+		siw.Handler.SetForbiddenUsernameList(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetServerURLOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetServerURLOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetChatJoinMessagesEnabledOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetChatJoinMessagesEnabledOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetServerURLOptions(w, r)
+		siw.Handler.SetChatJoinMessagesEnabledOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetServerURL operation middleware
-func (siw *ServerInterfaceWrapper) SetServerURL(w http.ResponseWriter, r *http.Request) {
+// SetChatJoinMessagesEnabled operation middleware
+func (siw *ServerInterfaceWrapper) SetChatJoinMessagesEnabled(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetServerURL(w, r)
+		siw.Handler.SetChatJoinMessagesEnabled(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetSocialHandlesOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetSocialHandlesOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetChatRetentionOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetChatRetentionOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetSocialHandlesOptions(w, r)
+		siw.Handler.SetChatRetentionOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetSocialHandles operation middleware
-func (siw *ServerInterfaceWrapper) SetSocialHandles(w http.ResponseWriter, r *http.Request) {
+// SetChatRetention operation middleware
+func (siw *ServerInterfaceWrapper) SetChatRetention(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetSocialHandles(w, r)
+		siw.Handler.SetChatRetention(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetSocketHostOverrideOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetSocketHostOverrideOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetChatReviewQueueOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetChatReviewQueueOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetSocketHostOverrideOptions(w, r)
+		siw.Handler.SetChatReviewQueueOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetSocketHostOverride operation middleware
-func (siw *ServerInterfaceWrapper) SetSocketHostOverride(w http.ResponseWriter, r *http.Request) {
+// SetChatReviewQueue operation middleware
+func (siw *ServerInterfaceWrapper) SetChatReviewQueue(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetSocketHostOverride(w, r)
+		siw.Handler.SetChatReviewQueue(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamKeysOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamKeysOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetChatSlurFilterEnabledOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetChatSlurFilterEnabledOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamKeysOptions(w, r)
+		siw.Handler.SetChatSlurFilterEnabledOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamKeys operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamKeys(w http.ResponseWriter, r *http.Request) {
+// SetChatSlurFilterEnabled operation middleware
+func (siw *ServerInterfaceWrapper) SetChatSlurFilterEnabled(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamKeys(w, r)
+		siw.Handler.SetChatSlurFilterEnabled(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamTitleOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamTitleOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetChatSpamProtectionEnabledOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetChatSpamProtectionEnabledOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamTitleOptions(w, r)
+		siw.Handler.SetChatSpamProtectionEnabledOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamTitle operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamTitle(w http.ResponseWriter, r *http.Request) {
+// SetChatSpamProtectionEnabled operation middleware
+func (siw *ServerInterfaceWrapper) SetChatSpamProtectionEnabled(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamTitle(w, r)
+		siw.Handler.SetChatSpamProtectionEnabled(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetTagsOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetTagsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetSuggestedUsernameListOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetSuggestedUsernameListOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetTagsOptions(w, r)
+		siw.Handler.SetSuggestedUsernameListOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetTags operation middleware
-func (siw *ServerInterfaceWrapper) SetTags(w http.ResponseWriter, r *http.Request) {
+// SetSuggestedUsernameList operation middleware
+func (siw *ServerInterfaceWrapper) SetSuggestedUsernameList(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetTags(w, r)
+		siw.Handler.SetSuggestedUsernameList(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetVideoCodecOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetVideoCodecOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetChatUserDirectMessagesEnabledOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetChatUserDirectMessagesEnabledOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetVideoCodecOptions(w, r)
+		siw.Handler.SetChatUserDirectMessagesEnabledOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetVideoCodec operation middleware
-func (siw *ServerInterfaceWrapper) SetVideoCodec(w http.ResponseWriter, r *http.Request) {
+// SetChatUserDirectMessagesEnabled operation middleware
+func (siw *ServerInterfaceWrapper) SetChatUserDirectMessagesEnabled(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetVideoCodec(w, r)
+		siw.Handler.SetChatUserDirectMessagesEnabled(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamLatencyLevelOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamLatencyLevelOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetCustomJavascriptOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetCustomJavascriptOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamLatencyLevelOptions(w, r)
+		siw.Handler.SetCustomJavascriptOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamLatencyLevel operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamLatencyLevel(w http.ResponseWriter, r *http.Request) {
+// SetCustomJavascript operation middleware
+func (siw *ServerInterfaceWrapper) SetCustomJavascript(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamLatencyLevel(w, r)
+		siw.Handler.SetCustomJavascript(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamOutputVariantsOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamOutputVariantsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetCustomStylesOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetCustomStylesOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamOutputVariantsOptions(w, r)
+		siw.Handler.SetCustomStylesOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetStreamOutputVariants operation middleware
-func (siw *ServerInterfaceWrapper) SetStreamOutputVariants(w http.ResponseWriter, r *http.Request) {
+// SetCustomStyles operation middleware
+func (siw *ServerInterfaceWrapper) SetCustomStyles(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetStreamOutputVariants(w, r)
+		siw.Handler.SetCustomStyles(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetVideoServingEndpointOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetVideoServingEndpointOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetDirectoryEnabledOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetDirectoryEnabledOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetVideoServingEndpointOptions(w, r)
+		siw.Handler.SetDirectoryEnabledOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetVideoServingEndpoint operation middleware
-func (siw *ServerInterfaceWrapper) SetVideoServingEndpoint(w http.ResponseWriter, r *http.Request) {
+// SetDirectoryEnabled operation middleware
+func (siw *ServerInterfaceWrapper) SetDirectoryEnabled(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetVideoServingEndpoint(w, r)
+		siw.Handler.SetDirectoryEnabled(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetWebServerIPOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetWebServerIPOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetDisableSearchIndexingOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetDisableSearchIndexingOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetWebServerIPOptions(w, r)
+		siw.Handler.SetDisableSearchIndexingOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetWebServerIP operation middleware
-func (siw *ServerInterfaceWrapper) SetWebServerIP(w http.ResponseWriter, r *http.Request) {
+// SetDisableSearchIndexing operation middleware
+func (siw *ServerInterfaceWrapper) SetDisableSearchIndexing(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetWebServerIP(w, r)
+		siw.Handler.SetDisableSearchIndexing(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetWebServerPortOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetWebServerPortOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetExternalActionsOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetExternalActionsOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetWebServerPortOptions(w, r)
+		siw.Handler.SetExternalActionsOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetWebServerPort operation middleware
-func (siw *ServerInterfaceWrapper) SetWebServerPort(w http.ResponseWriter, r *http.Request) {
+// SetExternalActions operation middleware
+func (siw *ServerInterfaceWrapper) SetExternalActions(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetWebServerPort(w, r)
+		siw.Handler.SetExternalActions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetServerWelcomeMessageOptions operation middleware
-func (siw *ServerInterfaceWrapper) SetServerWelcomeMessageOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetFederationBlockDomainsOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetFederationBlockDomainsOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetServerWelcomeMessageOptions(w, r)
+		siw.Handler.SetFederationBlockDomainsOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SetServerWelcomeMessage operation middleware
-func (siw *ServerInterfaceWrapper) SetServerWelcomeMessage(w http.ResponseWriter, r *http.Request) {
+// SetFederationBlockDomains operation middleware
+func (siw *ServerInterfaceWrapper) SetFederationBlockDomains(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetServerWelcomeMessage(w, r)
+		siw.Handler.SetFederationBlockDomains(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// DisconnectInboundConnection operation middleware
-func (siw *ServerInterfaceWrapper) DisconnectInboundConnection(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// SetFederationEnabledOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetFederationEnabledOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DisconnectInboundConnection(w, r)
+		siw.Handler.SetFederationEnabledOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// DisconnectInboundConnectionOptions operation middleware
-func (siw *ServerInterfaceWrapper) DisconnectInboundConnectionOptions(w http.ResponseWriter, r *http.Request) {
+// SetFederationEnabled operation middleware
+func (siw *ServerInterfaceWrapper) SetFederationEnabled(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DisconnectInboundConnectionOptions(w, r)
+		siw.Handler.SetFederationEnabled(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// DeleteCustomEmojiOptions operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCustomEmojiOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetFederationGoLiveMessageOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetFederationGoLiveMessageOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCustomEmojiOptions(w, r)
+		siw.Handler.SetFederationGoLiveMessageOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// DeleteCustomEmoji operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCustomEmoji(w http.ResponseWriter, r *http.Request) {
+// SetFederationGoLiveMessage operation middleware
+func (siw *ServerInterfaceWrapper) SetFederationGoLiveMessage(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCustomEmoji(w, r)
+		siw.Handler.SetFederationGoLiveMessage(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// UploadCustomEmojiOptions operation middleware
-func (siw *ServerInterfaceWrapper) UploadCustomEmojiOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetFederationActivityPrivateOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetFederationActivityPrivateOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadCustomEmojiOptions(w, r)
+		siw.Handler.SetFederationActivityPrivateOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// UploadCustomEmoji operation middleware
-func (siw *ServerInterfaceWrapper) UploadCustomEmoji(w http.ResponseWriter, r *http.Request) {
+// SetFederationActivityPrivate operation middleware
+func (siw *ServerInterfaceWrapper) SetFederationActivityPrivate(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadCustomEmoji(w, r)
+		siw.Handler.SetFederationActivityPrivate(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetFederatedActions operation middleware
-func (siw *ServerInterfaceWrapper) GetFederatedActions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetFederationShowEngagementOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetFederationShowEngagementOptions(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetFederationShowEngagementOptions(w, r)
+	}))
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetFederatedActionsParams
+	handler.ServeHTTP(w, r)
+}
 
-	// ------------- Optional query parameter "offset" -------------
+// SetFederationShowEngagement operation middleware
+func (siw *ServerInterfaceWrapper) SetFederationShowEngagement(w http.ResponseWriter, r *http.Request) {
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
+	ctx := r.Context()
 
-	// ------------- Optional query parameter "limit" -------------
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFederatedActions(w, r, params)
+		siw.Handler.SetFederationShowEngagement(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetFederatedActionsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetFederatedActionsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetFederationUsernameOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetFederationUsernameOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFederatedActionsOptions(w, r)
+		siw.Handler.SetFederationUsernameOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SendFederatedMessageOptions operation middleware
-func (siw *ServerInterfaceWrapper) SendFederatedMessageOptions(w http.ResponseWriter, r *http.Request) {
+// SetFederationUsername operation middleware
+func (siw *ServerInterfaceWrapper) SetFederationUsername(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SendFederatedMessageOptions(w, r)
+		siw.Handler.SetFederationUsername(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SendFederatedMessage operation middleware
-func (siw *ServerInterfaceWrapper) SendFederatedMessage(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// SetFfmpegPathOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetFfmpegPathOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SendFederatedMessage(w, r)
+		siw.Handler.SetFfmpegPathOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetFollowersAdmin operation middleware
-func (siw *ServerInterfaceWrapper) GetFollowersAdmin(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetFfmpegPath operation middleware
+func (siw *ServerInterfaceWrapper) SetFfmpegPath(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetFollowersAdminParams
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFollowersAdmin(w, r, params)
+		siw.Handler.SetFfmpegPath(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetFollowersAdminOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetFollowersAdminOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetHideViewerCountOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetHideViewerCountOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFollowersAdminOptions(w, r)
+		siw.Handler.SetHideViewerCountOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// ApproveFollowerOptions operation middleware
-func (siw *ServerInterfaceWrapper) ApproveFollowerOptions(w http.ResponseWriter, r *http.Request) {
+// SetHideViewerCount operation middleware
+func (siw *ServerInterfaceWrapper) SetHideViewerCount(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ApproveFollowerOptions(w, r)
+		siw.Handler.SetHideViewerCount(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// ApproveFollower operation middleware
-func (siw *ServerInterfaceWrapper) ApproveFollower(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// SetLogoOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetLogoOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ApproveFollower(w, r)
+		siw.Handler.SetLogoOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetBlockedAndRejectedFollowers operation middleware
-func (siw *ServerInterfaceWrapper) GetBlockedAndRejectedFollowers(w http.ResponseWriter, r *http.Request) {
+// SetLogo operation middleware
+func (siw *ServerInterfaceWrapper) SetLogo(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBlockedAndRejectedFollowers(w, r)
+		siw.Handler.SetLogo(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetBlockedAndRejectedFollowersOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetBlockedAndRejectedFollowersOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetServerNameOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetServerNameOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBlockedAndRejectedFollowersOptions(w, r)
+		siw.Handler.SetServerNameOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetPendingFollowRequests operation middleware
-func (siw *ServerInterfaceWrapper) GetPendingFollowRequests(w http.ResponseWriter, r *http.Request) {
+// SetServerName operation middleware
+func (siw *ServerInterfaceWrapper) SetServerName(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPendingFollowRequests(w, r)
+		siw.Handler.SetServerName(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetPendingFollowRequestsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetPendingFollowRequestsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetBrowserNotificationConfigurationOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetBrowserNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPendingFollowRequestsOptions(w, r)
+		siw.Handler.SetBrowserNotificationConfigurationOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetHardwareStats operation middleware
-func (siw *ServerInterfaceWrapper) GetHardwareStats(w http.ResponseWriter, r *http.Request) {
+// SetBrowserNotificationConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) SetBrowserNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHardwareStats(w, r)
+		siw.Handler.SetBrowserNotificationConfiguration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetHardwareStatsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetHardwareStatsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetDiscordNotificationConfigurationOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetDiscordNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHardwareStatsOptions(w, r)
+		siw.Handler.SetDiscordNotificationConfigurationOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetLogs operation middleware
-func (siw *ServerInterfaceWrapper) GetLogs(w http.ResponseWriter, r *http.Request) {
+// SetDiscordNotificationConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) SetDiscordNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLogs(w, r)
+		siw.Handler.SetDiscordNotificationConfiguration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetLogsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetLogsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetEmailNotificationConfigurationOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetEmailNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLogsOptions(w, r)
+		siw.Handler.SetEmailNotificationConfigurationOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetWarnings operation middleware
-func (siw *ServerInterfaceWrapper) GetWarnings(w http.ResponseWriter, r *http.Request) {
+// SetEmailNotificationConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) SetEmailNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWarnings(w, r)
+		siw.Handler.SetEmailNotificationConfiguration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetWarningsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetWarningsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetGotifyNotificationConfigurationOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetGotifyNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWarningsOptions(w, r)
+		siw.Handler.SetGotifyNotificationConfigurationOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetVideoPlaybackMetrics operation middleware
-func (siw *ServerInterfaceWrapper) GetVideoPlaybackMetrics(w http.ResponseWriter, r *http.Request) {
+// SetGotifyNotificationConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) SetGotifyNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVideoPlaybackMetrics(w, r)
+		siw.Handler.SetGotifyNotificationConfiguration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetVideoPlaybackMetricsOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetVideoPlaybackMetricsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetMatrixNotificationConfigurationOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetMatrixNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVideoPlaybackMetricsOptions(w, r)
+		siw.Handler.SetMatrixNotificationConfigurationOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// DeletePrometheusAPI operation middleware
-func (siw *ServerInterfaceWrapper) DeletePrometheusAPI(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeletePrometheusAPI(w, r)
-	}))
+// SetMatrixNotificationConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) SetMatrixNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	ctx := r.Context()
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-// GetPrometheusAPI operation middleware
-func (siw *ServerInterfaceWrapper) GetPrometheusAPI(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPrometheusAPI(w, r)
+		siw.Handler.SetMatrixNotificationConfiguration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// OptionsPrometheusAPI operation middleware
-func (siw *ServerInterfaceWrapper) OptionsPrometheusAPI(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetNtfyNotificationConfigurationOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetNtfyNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.OptionsPrometheusAPI(w, r)
+		siw.Handler.SetNtfyNotificationConfigurationOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// PostPrometheusAPI operation middleware
-func (siw *ServerInterfaceWrapper) PostPrometheusAPI(w http.ResponseWriter, r *http.Request) {
+// SetNtfyNotificationConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) SetNtfyNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPrometheusAPI(w, r)
+		siw.Handler.SetNtfyNotificationConfiguration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// PutPrometheusAPI operation middleware
-func (siw *ServerInterfaceWrapper) PutPrometheusAPI(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetStreamReportChannelOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamReportChannelOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutPrometheusAPI(w, r)
+		siw.Handler.SetStreamReportChannelOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetServerConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetServerConfig(w http.ResponseWriter, r *http.Request) {
+// SetStreamReportChannel operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamReportChannel(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetServerConfig(w, r)
+		siw.Handler.SetStreamReportChannel(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetServerConfigOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetServerConfigOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetNotificationRulesOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetNotificationRulesOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetServerConfigOptions(w, r)
+		siw.Handler.SetNotificationRulesOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// StatusAdmin operation middleware
-func (siw *ServerInterfaceWrapper) StatusAdmin(w http.ResponseWriter, r *http.Request) {
+// SetNotificationRules operation middleware
+func (siw *ServerInterfaceWrapper) SetNotificationRules(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StatusAdmin(w, r)
+		siw.Handler.SetNotificationRules(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// StatusAdminOptions operation middleware
-func (siw *ServerInterfaceWrapper) StatusAdminOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetSlackNotificationConfigurationOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetSlackNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StatusAdminOptions(w, r)
+		siw.Handler.SetSlackNotificationConfigurationOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// AutoUpdateForceQuit operation middleware
-func (siw *ServerInterfaceWrapper) AutoUpdateForceQuit(w http.ResponseWriter, r *http.Request) {
+// SetSlackNotificationConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) SetSlackNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AutoUpdateForceQuit(w, r)
+		siw.Handler.SetSlackNotificationConfiguration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// AutoUpdateForceQuitOptions operation middleware
-func (siw *ServerInterfaceWrapper) AutoUpdateForceQuitOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetTelegramNotificationConfigurationOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetTelegramNotificationConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AutoUpdateForceQuitOptions(w, r)
+		siw.Handler.SetTelegramNotificationConfigurationOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// AutoUpdateOptions operation middleware
-func (siw *ServerInterfaceWrapper) AutoUpdateOptions(w http.ResponseWriter, r *http.Request) {
+// SetTelegramNotificationConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) SetTelegramNotificationConfiguration(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AutoUpdateOptions(w, r)
+		siw.Handler.SetTelegramNotificationConfiguration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// AutoUpdateOptionsOptions operation middleware
-func (siw *ServerInterfaceWrapper) AutoUpdateOptionsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SendTestNotificationOptions operation middleware
+func (siw *ServerInterfaceWrapper) SendTestNotificationOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AutoUpdateOptionsOptions(w, r)
+		siw.Handler.SendTestNotificationOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// AutoUpdateStart operation middleware
-func (siw *ServerInterfaceWrapper) AutoUpdateStart(w http.ResponseWriter, r *http.Request) {
+// SendTestNotification operation middleware
+func (siw *ServerInterfaceWrapper) SendTestNotification(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AutoUpdateStart(w, r)
+		siw.Handler.SendTestNotification(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// AutoUpdateStartOptions operation middleware
-func (siw *ServerInterfaceWrapper) AutoUpdateStartOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetNSFWOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetNSFWOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AutoUpdateStartOptions(w, r)
+		siw.Handler.SetNSFWOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetActiveViewers operation middleware
-func (siw *ServerInterfaceWrapper) GetActiveViewers(w http.ResponseWriter, r *http.Request) {
+// SetNSFW operation middleware
+func (siw *ServerInterfaceWrapper) SetNSFW(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetActiveViewers(w, r)
+		siw.Handler.SetNSFW(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetActiveViewersOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetActiveViewersOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetCustomOfflineMessageOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetCustomOfflineMessageOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetActiveViewersOptions(w, r)
+		siw.Handler.SetCustomOfflineMessageOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetViewersOverTime operation middleware
-func (siw *ServerInterfaceWrapper) GetViewersOverTime(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetCustomOfflineMessage operation middleware
+func (siw *ServerInterfaceWrapper) SetCustomOfflineMessage(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetViewersOverTimeParams
-
-	// ------------- Optional query parameter "windowStart" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "windowStart", r.URL.Query(), &params.WindowStart)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "windowStart", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetViewersOverTime(w, r, params)
+		siw.Handler.SetCustomOfflineMessage(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetViewersOverTimeOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetViewersOverTimeOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetExtraPageContentOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetExtraPageContentOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetViewersOverTimeOptions(w, r)
+		siw.Handler.SetExtraPageContentOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetWebhooks operation middleware
-func (siw *ServerInterfaceWrapper) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+// SetExtraPageContent operation middleware
+func (siw *ServerInterfaceWrapper) SetExtraPageContent(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWebhooks(w, r)
+		siw.Handler.SetExtraPageContent(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetWebhooksOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetWebhooksOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetRTMPServerPortOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetRTMPServerPortOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWebhooksOptions(w, r)
+		siw.Handler.SetRTMPServerPortOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// CreateWebhookOptions operation middleware
-func (siw *ServerInterfaceWrapper) CreateWebhookOptions(w http.ResponseWriter, r *http.Request) {
+// SetRTMPServerPort operation middleware
+func (siw *ServerInterfaceWrapper) SetRTMPServerPort(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateWebhookOptions(w, r)
+		siw.Handler.SetRTMPServerPort(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// CreateWebhook operation middleware
-func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// SetS3ConfigurationOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetS3ConfigurationOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateWebhook(w, r)
+		siw.Handler.SetS3ConfigurationOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// DeleteWebhookOptions operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWebhookOptions(w http.ResponseWriter, r *http.Request) {
+// SetS3Configuration operation middleware
+func (siw *ServerInterfaceWrapper) SetS3Configuration(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteWebhookOptions(w, r)
+		siw.Handler.SetS3Configuration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// DeleteWebhook operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+// SetScheduleReminderMinutesOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetScheduleReminderMinutesOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteWebhook(w, r)
+		siw.Handler.SetScheduleReminderMinutesOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// ResetYPRegistration operation middleware
-func (siw *ServerInterfaceWrapper) ResetYPRegistration(w http.ResponseWriter, r *http.Request) {
+// SetScheduleReminderMinutes operation middleware
+func (siw *ServerInterfaceWrapper) SetScheduleReminderMinutes(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ResetYPRegistration(w, r)
+		siw.Handler.SetScheduleReminderMinutes(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// ResetYPRegistrationOptions operation middleware
-func (siw *ServerInterfaceWrapper) ResetYPRegistrationOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetServerSummaryOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetServerSummaryOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ResetYPRegistrationOptions(w, r)
+		siw.Handler.SetServerSummaryOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// RegisterFediverseOTPRequest operation middleware
-func (siw *ServerInterfaceWrapper) RegisterFediverseOTPRequest(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params RegisterFediverseOTPRequestParams
-
-	// ------------- Required query parameter "accessToken" -------------
+// SetServerSummary operation middleware
+func (siw *ServerInterfaceWrapper) SetServerSummary(w http.ResponseWriter, r *http.Request) {
 
-	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
+	ctx := r.Context()
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
-		return
-	}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RegisterFediverseOTPRequest(w, r, params)
+		siw.Handler.SetServerSummary(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// VerifyFediverseOTPRequest operation middleware
-func (siw *ServerInterfaceWrapper) VerifyFediverseOTPRequest(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetServerURLOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetServerURLOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.VerifyFediverseOTPRequest(w, r)
+		siw.Handler.SetServerURLOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// StartIndieAuthFlow operation middleware
-func (siw *ServerInterfaceWrapper) StartIndieAuthFlow(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetServerURL operation middleware
+func (siw *ServerInterfaceWrapper) SetServerURL(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params StartIndieAuthFlowParams
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	// ------------- Required query parameter "accessToken" -------------
+	r = r.WithContext(ctx)
 
-	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetServerURL(w, r)
+	}))
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
-		return
-	}
+	handler.ServeHTTP(w, r)
+}
+
+// SetSocialHandlesOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetSocialHandlesOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StartIndieAuthFlow(w, r, params)
+		siw.Handler.SetSocialHandlesOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// HandleIndieAuthRedirect operation middleware
-func (siw *ServerInterfaceWrapper) HandleIndieAuthRedirect(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SetSocialHandles operation middleware
+func (siw *ServerInterfaceWrapper) SetSocialHandles(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSocialHandles(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetSocketHostOverrideOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetSocketHostOverrideOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSocketHostOverrideOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetSocketHostOverride operation middleware
+func (siw *ServerInterfaceWrapper) SetSocketHostOverride(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSocketHostOverride(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamKeysOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamKeysOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamKeysOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamKeys operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamKeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamTitleOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamTitleOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamTitleOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamTitle operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamTitle(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamTitle(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTagsOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetTagsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTagsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetTags operation middleware
+func (siw *ServerInterfaceWrapper) SetTags(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetTags(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetVideoCodecOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetVideoCodecOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetVideoCodecOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetVideoCodec operation middleware
+func (siw *ServerInterfaceWrapper) SetVideoCodec(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetVideoCodec(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamLatencyLevelOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamLatencyLevelOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamLatencyLevelOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamLatencyLevel operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamLatencyLevel(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamLatencyLevel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamOutputVariantsOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamOutputVariantsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamOutputVariantsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetStreamOutputVariants operation middleware
+func (siw *ServerInterfaceWrapper) SetStreamOutputVariants(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetStreamOutputVariants(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetVideoServingEndpointOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetVideoServingEndpointOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetVideoServingEndpointOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetVideoServingEndpoint operation middleware
+func (siw *ServerInterfaceWrapper) SetVideoServingEndpoint(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetVideoServingEndpoint(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetWebServerIPOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetWebServerIPOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetWebServerIPOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetWebServerIP operation middleware
+func (siw *ServerInterfaceWrapper) SetWebServerIP(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetWebServerIP(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetWebServerPortOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetWebServerPortOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetWebServerPortOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetWebServerPort operation middleware
+func (siw *ServerInterfaceWrapper) SetWebServerPort(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetWebServerPort(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetServerWelcomeMessageOptions operation middleware
+func (siw *ServerInterfaceWrapper) SetServerWelcomeMessageOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetServerWelcomeMessageOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetServerWelcomeMessage operation middleware
+func (siw *ServerInterfaceWrapper) SetServerWelcomeMessage(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetServerWelcomeMessage(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DisconnectInboundConnection operation middleware
+func (siw *ServerInterfaceWrapper) DisconnectInboundConnection(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DisconnectInboundConnection(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DisconnectInboundConnectionOptions operation middleware
+func (siw *ServerInterfaceWrapper) DisconnectInboundConnectionOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DisconnectInboundConnectionOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCustomEmojiOptions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCustomEmojiOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCustomEmojiOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCustomEmoji operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCustomEmoji(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCustomEmoji(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadCustomEmojiOptions operation middleware
+func (siw *ServerInterfaceWrapper) UploadCustomEmojiOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadCustomEmojiOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadCustomEmoji operation middleware
+func (siw *ServerInterfaceWrapper) UploadCustomEmoji(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadCustomEmoji(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFederatedActions operation middleware
+func (siw *ServerInterfaceWrapper) GetFederatedActions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFederatedActionsParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFederatedActions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFederatedActionsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetFederatedActionsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFederatedActionsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SendFederatedMessageOptions operation middleware
+func (siw *ServerInterfaceWrapper) SendFederatedMessageOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SendFederatedMessageOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SendFederatedMessage operation middleware
+func (siw *ServerInterfaceWrapper) SendFederatedMessage(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SendFederatedMessage(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFollowersAdmin operation middleware
+func (siw *ServerInterfaceWrapper) GetFollowersAdmin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFollowersAdminParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFollowersAdmin(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFollowersAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetFollowersAdminOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFollowersAdminOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApproveFollowerOptions operation middleware
+func (siw *ServerInterfaceWrapper) ApproveFollowerOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveFollowerOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApproveFollower operation middleware
+func (siw *ServerInterfaceWrapper) ApproveFollower(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveFollower(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBlockedAndRejectedFollowers operation middleware
+func (siw *ServerInterfaceWrapper) GetBlockedAndRejectedFollowers(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBlockedAndRejectedFollowers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBlockedAndRejectedFollowersOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetBlockedAndRejectedFollowersOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBlockedAndRejectedFollowersOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPendingFollowRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetPendingFollowRequests(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPendingFollowRequests(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPendingFollowRequestsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetPendingFollowRequestsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPendingFollowRequestsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHardwareStats operation middleware
+func (siw *ServerInterfaceWrapper) GetHardwareStats(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHardwareStats(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHardwareStatsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetHardwareStatsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHardwareStatsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetLogs(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLogs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLogsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetLogsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLogsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWarnings operation middleware
+func (siw *ServerInterfaceWrapper) GetWarnings(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWarnings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWarningsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetWarningsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWarningsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVideoPlaybackMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetVideoPlaybackMetrics(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVideoPlaybackMetrics(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVideoPlaybackMetricsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetVideoPlaybackMetricsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVideoPlaybackMetricsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePrometheusAPI operation middleware
+func (siw *ServerInterfaceWrapper) DeletePrometheusAPI(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePrometheusAPI(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPrometheusAPI operation middleware
+func (siw *ServerInterfaceWrapper) GetPrometheusAPI(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPrometheusAPI(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// OptionsPrometheusAPI operation middleware
+func (siw *ServerInterfaceWrapper) OptionsPrometheusAPI(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OptionsPrometheusAPI(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPrometheusAPI operation middleware
+func (siw *ServerInterfaceWrapper) PostPrometheusAPI(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPrometheusAPI(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutPrometheusAPI operation middleware
+func (siw *ServerInterfaceWrapper) PutPrometheusAPI(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutPrometheusAPI(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStreamReports operation middleware
+func (siw *ServerInterfaceWrapper) GetStreamReports(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStreamReports(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStreamReportsOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetStreamReportsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStreamReportsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteStreamReportOptions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStreamReportOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteStreamReportOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteStreamReport operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStreamReport(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteStreamReport(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScheduledStreamsAdmin operation middleware
+func (siw *ServerInterfaceWrapper) GetScheduledStreamsAdmin(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScheduledStreamsAdmin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScheduledStreamsAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetScheduledStreamsAdminOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScheduledStreamsAdminOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateScheduledStreamOptions operation middleware
+func (siw *ServerInterfaceWrapper) CreateScheduledStreamOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateScheduledStreamOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateScheduledStream operation middleware
+func (siw *ServerInterfaceWrapper) CreateScheduledStream(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateScheduledStream(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteScheduledStreamOptions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteScheduledStreamOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteScheduledStreamOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteScheduledStream operation middleware
+func (siw *ServerInterfaceWrapper) DeleteScheduledStream(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteScheduledStream(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateScheduledStreamOptions operation middleware
+func (siw *ServerInterfaceWrapper) UpdateScheduledStreamOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateScheduledStreamOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateScheduledStream operation middleware
+func (siw *ServerInterfaceWrapper) UpdateScheduledStream(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateScheduledStream(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServerConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetServerConfig(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServerConfig(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServerConfigOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetServerConfigOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServerConfigOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StatusAdmin operation middleware
+func (siw *ServerInterfaceWrapper) StatusAdmin(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StatusAdmin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StatusAdminOptions operation middleware
+func (siw *ServerInterfaceWrapper) StatusAdminOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StatusAdminOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AutoUpdateForceQuit operation middleware
+func (siw *ServerInterfaceWrapper) AutoUpdateForceQuit(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AutoUpdateForceQuit(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AutoUpdateForceQuitOptions operation middleware
+func (siw *ServerInterfaceWrapper) AutoUpdateForceQuitOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AutoUpdateForceQuitOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AutoUpdateOptions operation middleware
+func (siw *ServerInterfaceWrapper) AutoUpdateOptions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AutoUpdateOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AutoUpdateOptionsOptions operation middleware
+func (siw *ServerInterfaceWrapper) AutoUpdateOptionsOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AutoUpdateOptionsOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AutoUpdateStart operation middleware
+func (siw *ServerInterfaceWrapper) AutoUpdateStart(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AutoUpdateStart(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AutoUpdateStartOptions operation middleware
+func (siw *ServerInterfaceWrapper) AutoUpdateStartOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AutoUpdateStartOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetActiveViewers operation middleware
+func (siw *ServerInterfaceWrapper) GetActiveViewers(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetActiveViewers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetActiveViewersOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetActiveViewersOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetActiveViewersOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetViewersOverTime operation middleware
+func (siw *ServerInterfaceWrapper) GetViewersOverTime(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetViewersOverTimeParams
+
+	// ------------- Optional query parameter "windowStart" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "windowStart", r.URL.Query(), &params.WindowStart)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "windowStart", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetViewersOverTime(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetViewersOverTimeOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetViewersOverTimeOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetViewersOverTimeOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooksOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooksOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWebhookOptions operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhookOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhookOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhookOptions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhookOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhookOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhookDeliveriesParams
+
+	// ------------- Required query parameter "webhookId" -------------
+
+	if paramValue := r.URL.Query().Get("webhookId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "webhookId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "webhookId", r.URL.Query(), &params.WebhookId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookDeliveries(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhookDeliveriesOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookDeliveriesOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookDeliveriesOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplayWebhookDeliveryOptions operation middleware
+func (siw *ServerInterfaceWrapper) ReplayWebhookDeliveryOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplayWebhookDeliveryOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplayWebhookDelivery operation middleware
+func (siw *ServerInterfaceWrapper) ReplayWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplayWebhookDelivery(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateWebhookPayloadOptions operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhookPayloadOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWebhookPayloadOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateWebhookPayload operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhookPayload(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWebhookPayload(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SendWebhookTestEventOptions operation middleware
+func (siw *ServerInterfaceWrapper) SendWebhookTestEventOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SendWebhookTestEventOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SendWebhookTestEvent operation middleware
+func (siw *ServerInterfaceWrapper) SendWebhookTestEvent(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SendWebhookTestEvent(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResetYPRegistration operation middleware
+func (siw *ServerInterfaceWrapper) ResetYPRegistration(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResetYPRegistration(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResetYPRegistrationOptions operation middleware
+func (siw *ServerInterfaceWrapper) ResetYPRegistrationOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResetYPRegistrationOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RegisterFediverseOTPRequest operation middleware
+func (siw *ServerInterfaceWrapper) RegisterFediverseOTPRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RegisterFediverseOTPRequestParams
+
+	// ------------- Required query parameter "accessToken" -------------
+
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegisterFediverseOTPRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyFediverseOTPRequest operation middleware
+func (siw *ServerInterfaceWrapper) VerifyFediverseOTPRequest(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyFediverseOTPRequest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartIndieAuthFlow operation middleware
+func (siw *ServerInterfaceWrapper) StartIndieAuthFlow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartIndieAuthFlowParams
+
+	// ------------- Required query parameter "accessToken" -------------
+
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartIndieAuthFlow(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// HandleIndieAuthRedirect operation middleware
+func (siw *ServerInterfaceWrapper) HandleIndieAuthRedirect(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HandleIndieAuthRedirectParams
+
+	// ------------- Required query parameter "state" -------------
+
+	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HandleIndieAuthRedirect(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// HandleIndieAuthEndpointGet operation middleware
+func (siw *ServerInterfaceWrapper) HandleIndieAuthEndpointGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HandleIndieAuthEndpointGetParams
+
+	// ------------- Required query parameter "client_id" -------------
+
+	if paramValue := r.URL.Query().Get("client_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "client_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "client_id", r.URL.Query(), &params.ClientId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "client_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "redirect_uri" -------------
+
+	if paramValue := r.URL.Query().Get("redirect_uri"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "redirect_uri"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "redirect_uri", r.URL.Query(), &params.RedirectUri)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "redirect_uri", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "code_challenge" -------------
+
+	if paramValue := r.URL.Query().Get("code_challenge"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "code_challenge"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "code_challenge", r.URL.Query(), &params.CodeChallenge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code_challenge", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "state" -------------
+
+	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HandleIndieAuthEndpointGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// HandleIndieAuthEndpointPost operation middleware
+func (siw *ServerInterfaceWrapper) HandleIndieAuthEndpointPost(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HandleIndieAuthEndpointPost(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetChatMessages operation middleware
+func (siw *ServerInterfaceWrapper) GetChatMessages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChatMessagesParams
+
+	// ------------- Required query parameter "accessToken" -------------
+
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChatMessages(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHeldMessages operation middleware
+func (siw *ServerInterfaceWrapper) GetHeldMessages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHeldMessagesParams
+
+	// ------------- Required query parameter "accessToken" -------------
+
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHeldMessages(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReviewHeldMessage operation middleware
+func (siw *ServerInterfaceWrapper) ReviewHeldMessage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReviewHeldMessageParams
+
+	// ------------- Required query parameter "accessToken" -------------
+
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReviewHeldMessage(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchChatMessages operation middleware
+func (siw *ServerInterfaceWrapper) SearchChatMessages(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params HandleIndieAuthRedirectParams
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchChatMessagesParams
+
+	// ------------- Required query parameter "accessToken" -------------
+
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "text" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "text", r.URL.Query(), &params.Text)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "text", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "userId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userId", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "displayName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "displayName", r.URL.Query(), &params.DisplayName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "displayName", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
 
-	// ------------- Required query parameter "state" -------------
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
 
-	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+	// ------------- Optional query parameter "until" -------------
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
+	// ------------- Optional query parameter "visibility" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "visibility", r.URL.Query(), &params.Visibility)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "visibility", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HandleIndieAuthRedirect(w, r, params)
+		siw.Handler.SearchChatMessages(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// HandleIndieAuthEndpointGet operation middleware
-func (siw *ServerInterfaceWrapper) HandleIndieAuthEndpointGet(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SearchChatMessagesOptions operation middleware
+func (siw *ServerInterfaceWrapper) SearchChatMessagesOptions(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchChatMessagesOptions(w, r)
+	}))
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMessageVisibility operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMessageVisibility(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params HandleIndieAuthEndpointGetParams
+	var params UpdateMessageVisibilityParams
 
-	// ------------- Required query parameter "client_id" -------------
+	// ------------- Required query parameter "accessToken" -------------
 
-	if paramValue := r.URL.Query().Get("client_id"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "client_id"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "client_id", r.URL.Query(), &params.ClientId)
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "client_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "redirect_uri" -------------
-
-	if paramValue := r.URL.Query().Get("redirect_uri"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "redirect_uri"})
-		return
-	}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMessageVisibility(w, r, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, true, "redirect_uri", r.URL.Query(), &params.RedirectUri)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "redirect_uri", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Required query parameter "code_challenge" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	if paramValue := r.URL.Query().Get("code_challenge"); paramValue != "" {
+// UpdateChatModes operation middleware
+func (siw *ServerInterfaceWrapper) UpdateChatModes(w http.ResponseWriter, r *http.Request) {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "code_challenge"})
-		return
-	}
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, true, "code_challenge", r.URL.Query(), &params.CodeChallenge)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code_challenge", Err: err})
-		return
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateChatModesParams
 
-	// ------------- Required query parameter "state" -------------
+	// ------------- Required query parameter "accessToken" -------------
 
-	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HandleIndieAuthEndpointGet(w, r, params)
+		siw.Handler.UpdateChatModes(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// HandleIndieAuthEndpointPost operation middleware
-func (siw *ServerInterfaceWrapper) HandleIndieAuthEndpointPost(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetActivePoll operation middleware
+func (siw *ServerInterfaceWrapper) GetActivePoll(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetActivePollParams
+
+	// ------------- Required query parameter "accessToken" -------------
+
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HandleIndieAuthEndpointPost(w, r)
+		siw.Handler.GetActivePoll(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetChatMessages operation middleware
-func (siw *ServerInterfaceWrapper) GetChatMessages(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// EndPoll operation middleware
+func (siw *ServerInterfaceWrapper) EndPoll(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetChatMessagesParams
+	var params EndPollParams
 
 	// ------------- Required query parameter "accessToken" -------------
 
@@ -4841,24 +8143,23 @@ func (siw *ServerInterfaceWrapper) GetChatMessages(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetChatMessages(w, r, params)
+		siw.Handler.EndPoll(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// UpdateMessageVisibility operation middleware
-func (siw *ServerInterfaceWrapper) UpdateMessageVisibility(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// StartPoll operation middleware
+func (siw *ServerInterfaceWrapper) StartPoll(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params UpdateMessageVisibilityParams
+	var params StartPollParams
 
 	// ------------- Required query parameter "accessToken" -------------
 
@@ -4876,19 +8177,18 @@ func (siw *ServerInterfaceWrapper) UpdateMessageVisibility(w http.ResponseWriter
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateMessageVisibility(w, r, params)
+		siw.Handler.StartPoll(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // RegisterAnonymousChatUserOptions operation middleware
 func (siw *ServerInterfaceWrapper) RegisterAnonymousChatUserOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.RegisterAnonymousChatUserOptions(w, r)
@@ -4898,12 +8198,11 @@ func (siw *ServerInterfaceWrapper) RegisterAnonymousChatUserOptions(w http.Respo
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // RegisterAnonymousChatUser operation middleware
 func (siw *ServerInterfaceWrapper) RegisterAnonymousChatUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -4939,12 +8238,11 @@ func (siw *ServerInterfaceWrapper) RegisterAnonymousChatUser(w http.ResponseWrit
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // UpdateUserEnabled operation middleware
 func (siw *ServerInterfaceWrapper) UpdateUserEnabled(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -4967,181 +8265,319 @@ func (siw *ServerInterfaceWrapper) UpdateUserEnabled(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateUserEnabled(w, r, params)
+		siw.Handler.UpdateUserEnabled(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateUserTimeout operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUserTimeout(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateUserTimeoutParams
+
+	// ------------- Required query parameter "accessToken" -------------
+
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateUserTimeout(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetWebConfig(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebConfig(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCustomEmojiList operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomEmojiList(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCustomEmojiList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFollowers operation middleware
+func (siw *ServerInterfaceWrapper) GetFollowers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFollowersParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFollowers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExternalGetChatMessages operation middleware
+func (siw *ServerInterfaceWrapper) ExternalGetChatMessages(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExternalGetChatMessages(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExternalGetChatMessagesOptions operation middleware
+func (siw *ServerInterfaceWrapper) ExternalGetChatMessagesOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExternalGetChatMessagesOptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SendChatActionOptions operation middleware
+func (siw *ServerInterfaceWrapper) SendChatActionOptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SendChatActionOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetWebConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetWebConfig(w http.ResponseWriter, r *http.Request) {
+// SendChatAction operation middleware
+func (siw *ServerInterfaceWrapper) SendChatAction(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWebConfig(w, r)
+		siw.Handler.SendChatAction(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetCustomEmojiList operation middleware
-func (siw *ServerInterfaceWrapper) GetCustomEmojiList(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// SendIntegrationDirectMessageOptions operation middleware
+func (siw *ServerInterfaceWrapper) SendIntegrationDirectMessageOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCustomEmojiList(w, r)
+		siw.Handler.SendIntegrationDirectMessageOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetFollowers operation middleware
-func (siw *ServerInterfaceWrapper) GetFollowers(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetFollowersParams
+// SendIntegrationDirectMessage operation middleware
+func (siw *ServerInterfaceWrapper) SendIntegrationDirectMessage(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
+	ctx := r.Context()
 
-	// ------------- Optional query parameter "limit" -------------
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFollowers(w, r, params)
+		siw.Handler.SendIntegrationDirectMessage(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// ExternalGetChatMessages operation middleware
-func (siw *ServerInterfaceWrapper) ExternalGetChatMessages(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// ExternalUpdateMessageVisibilityOptions operation middleware
+func (siw *ServerInterfaceWrapper) ExternalUpdateMessageVisibilityOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExternalGetChatMessages(w, r)
+		siw.Handler.ExternalUpdateMessageVisibilityOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// ExternalGetChatMessagesOptions operation middleware
-func (siw *ServerInterfaceWrapper) ExternalGetChatMessagesOptions(w http.ResponseWriter, r *http.Request) {
+// ExternalUpdateMessageVisibility operation middleware
+func (siw *ServerInterfaceWrapper) ExternalUpdateMessageVisibility(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExternalGetChatMessagesOptions(w, r)
+		siw.Handler.ExternalUpdateMessageVisibility(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SendChatActionOptions operation middleware
-func (siw *ServerInterfaceWrapper) SendChatActionOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// EndIntegrationPollOptions operation middleware
+func (siw *ServerInterfaceWrapper) EndIntegrationPollOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SendChatActionOptions(w, r)
+		siw.Handler.EndIntegrationPollOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// SendChatAction operation middleware
-func (siw *ServerInterfaceWrapper) SendChatAction(w http.ResponseWriter, r *http.Request) {
+// EndIntegrationPoll operation middleware
+func (siw *ServerInterfaceWrapper) EndIntegrationPoll(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SendChatAction(w, r)
+		siw.Handler.EndIntegrationPoll(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// ExternalUpdateMessageVisibilityOptions operation middleware
-func (siw *ServerInterfaceWrapper) ExternalUpdateMessageVisibilityOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// StartIntegrationPollOptions operation middleware
+func (siw *ServerInterfaceWrapper) StartIntegrationPollOptions(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExternalUpdateMessageVisibilityOptions(w, r)
+		siw.Handler.StartIntegrationPollOptions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// ExternalUpdateMessageVisibility operation middleware
-func (siw *ServerInterfaceWrapper) ExternalUpdateMessageVisibility(w http.ResponseWriter, r *http.Request) {
+// StartIntegrationPoll operation middleware
+func (siw *ServerInterfaceWrapper) StartIntegrationPoll(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExternalUpdateMessageVisibility(w, r)
+		siw.Handler.StartIntegrationPoll(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendIntegrationChatMessageOptions operation middleware
 func (siw *ServerInterfaceWrapper) SendIntegrationChatMessageOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendIntegrationChatMessageOptions(w, r)
@@ -5151,13 +8587,18 @@ func (siw *ServerInterfaceWrapper) SendIntegrationChatMessageOptions(w http.Resp
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendIntegrationChatMessage operation middleware
 func (siw *ServerInterfaceWrapper) SendIntegrationChatMessage(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendIntegrationChatMessage(w, r)
 	}))
@@ -5166,12 +8607,11 @@ func (siw *ServerInterfaceWrapper) SendIntegrationChatMessage(w http.ResponseWri
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendSystemMessageOptions operation middleware
 func (siw *ServerInterfaceWrapper) SendSystemMessageOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendSystemMessageOptions(w, r)
@@ -5181,15 +8621,18 @@ func (siw *ServerInterfaceWrapper) SendSystemMessageOptions(w http.ResponseWrite
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendSystemMessage operation middleware
 func (siw *ServerInterfaceWrapper) SendSystemMessage(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendSystemMessage(w, r)
 	}))
@@ -5198,12 +8641,11 @@ func (siw *ServerInterfaceWrapper) SendSystemMessage(w http.ResponseWriter, r *h
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendSystemMessageToConnectedClientOptions operation middleware
 func (siw *ServerInterfaceWrapper) SendSystemMessageToConnectedClientOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -5224,12 +8666,11 @@ func (siw *ServerInterfaceWrapper) SendSystemMessageToConnectedClientOptions(w h
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendSystemMessageToConnectedClient operation middleware
 func (siw *ServerInterfaceWrapper) SendSystemMessageToConnectedClient(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -5242,8 +8683,12 @@ func (siw *ServerInterfaceWrapper) SendSystemMessageToConnectedClient(w http.Res
 		return
 	}
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendSystemMessageToConnectedClient(w, r, clientId)
 	}))
@@ -5252,12 +8697,11 @@ func (siw *ServerInterfaceWrapper) SendSystemMessageToConnectedClient(w http.Res
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendUserMessageOptions operation middleware
 func (siw *ServerInterfaceWrapper) SendUserMessageOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendUserMessageOptions(w, r)
@@ -5267,15 +8711,18 @@ func (siw *ServerInterfaceWrapper) SendUserMessageOptions(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // SendUserMessage operation middleware
 func (siw *ServerInterfaceWrapper) SendUserMessage(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.SendUserMessage(w, r)
 	}))
@@ -5284,15 +8731,18 @@ func (siw *ServerInterfaceWrapper) SendUserMessage(w http.ResponseWriter, r *htt
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalGetConnectedChatClients operation middleware
 func (siw *ServerInterfaceWrapper) ExternalGetConnectedChatClients(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalGetConnectedChatClients(w, r)
 	}))
@@ -5301,12 +8751,11 @@ func (siw *ServerInterfaceWrapper) ExternalGetConnectedChatClients(w http.Respon
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalGetConnectedChatClientsOptions operation middleware
 func (siw *ServerInterfaceWrapper) ExternalGetConnectedChatClientsOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalGetConnectedChatClientsOptions(w, r)
@@ -5316,12 +8765,11 @@ func (siw *ServerInterfaceWrapper) ExternalGetConnectedChatClientsOptions(w http
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalGetUserDetails operation middleware
 func (siw *ServerInterfaceWrapper) ExternalGetUserDetails(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -5334,8 +8782,12 @@ func (siw *ServerInterfaceWrapper) ExternalGetUserDetails(w http.ResponseWriter,
 		return
 	}
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalGetUserDetails(w, r, userId)
 	}))
@@ -5344,15 +8796,18 @@ func (siw *ServerInterfaceWrapper) ExternalGetUserDetails(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalGetStatus operation middleware
 func (siw *ServerInterfaceWrapper) ExternalGetStatus(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalGetStatus(w, r)
 	}))
@@ -5361,12 +8816,11 @@ func (siw *ServerInterfaceWrapper) ExternalGetStatus(w http.ResponseWriter, r *h
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalSetStreamTitleOptions operation middleware
 func (siw *ServerInterfaceWrapper) ExternalSetStreamTitleOptions(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalSetStreamTitleOptions(w, r)
@@ -5376,15 +8830,18 @@ func (siw *ServerInterfaceWrapper) ExternalSetStreamTitleOptions(w http.Response
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ExternalSetStreamTitle operation middleware
 func (siw *ServerInterfaceWrapper) ExternalSetStreamTitle(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ExternalSetStreamTitle(w, r)
 	}))
@@ -5393,12 +8850,11 @@ func (siw *ServerInterfaceWrapper) ExternalSetStreamTitle(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // ReportPlaybackMetrics operation middleware
 func (siw *ServerInterfaceWrapper) ReportPlaybackMetrics(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ReportPlaybackMetrics(w, r)
@@ -5408,12 +8864,11 @@ func (siw *ServerInterfaceWrapper) ReportPlaybackMetrics(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetUserDetails operation middleware
 func (siw *ServerInterfaceWrapper) GetUserDetails(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -5452,12 +8907,181 @@ func (siw *ServerInterfaceWrapper) GetUserDetails(w http.ResponseWriter, r *http
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
+}
+
+// ConfirmEmailNotificationSubscription operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmEmailNotificationSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConfirmEmailNotificationSubscriptionParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfirmEmailNotificationSubscription(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitEmailNotificationConfirmation operation middleware
+func (siw *ServerInterfaceWrapper) SubmitEmailNotificationConfirmation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitEmailNotificationConfirmationParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitEmailNotificationConfirmation(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubscribeToEmailNotifications operation middleware
+func (siw *ServerInterfaceWrapper) SubscribeToEmailNotifications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubscribeToEmailNotificationsParams
+
+	// ------------- Required query parameter "accessToken" -------------
+
+	if paramValue := r.URL.Query().Get("accessToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "accessToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubscribeToEmailNotifications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnsubscribeFromEmailNotifications operation middleware
+func (siw *ServerInterfaceWrapper) UnsubscribeFromEmailNotifications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UnsubscribeFromEmailNotificationsParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnsubscribeFromEmailNotifications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnsubscribeFromEmailNotificationsOneClick operation middleware
+func (siw *ServerInterfaceWrapper) UnsubscribeFromEmailNotificationsOneClick(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UnsubscribeFromEmailNotificationsOneClickParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnsubscribeFromEmailNotificationsOneClick(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
 // RegisterForLiveNotifications operation middleware
 func (siw *ServerInterfaceWrapper) RegisterForLiveNotifications(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	var err error
 
@@ -5473,56 +9097,94 @@ func (siw *ServerInterfaceWrapper) RegisterForLiveNotifications(w http.ResponseW
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
+	err = runtime.BindQueryParameter("form", true, true, "accessToken", r.URL.Query(), &params.AccessToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegisterForLiveNotifications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Ping operation middleware
+func (siw *ServerInterfaceWrapper) Ping(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PingParams
+
+	// ------------- Optional query parameter "referrer" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "referrer", r.URL.Query(), &params.Referrer)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accessToken", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "referrer", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RegisterForLiveNotifications(w, r, params)
+		siw.Handler.Ping(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// Ping operation middleware
-func (siw *ServerInterfaceWrapper) Ping(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// RemoteFollow operation middleware
+func (siw *ServerInterfaceWrapper) RemoteFollow(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Ping(w, r)
+		siw.Handler.RemoteFollow(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// RemoteFollow operation middleware
-func (siw *ServerInterfaceWrapper) RemoteFollow(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetSchedule(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RemoteFollow(w, r)
+		siw.Handler.GetSchedule(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetScheduleCalendar operation middleware
+func (siw *ServerInterfaceWrapper) GetScheduleCalendar(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetScheduleCalendar(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetAllSocialPlatforms operation middleware
 func (siw *ServerInterfaceWrapper) GetAllSocialPlatforms(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetAllSocialPlatforms(w, r)
@@ -5532,12 +9194,11 @@ func (siw *ServerInterfaceWrapper) GetAllSocialPlatforms(w http.ResponseWriter,
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetStatus operation middleware
 func (siw *ServerInterfaceWrapper) GetStatus(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetStatus(w, r)
@@ -5547,12 +9208,11 @@ func (siw *ServerInterfaceWrapper) GetStatus(w http.ResponseWriter, r *http.Requ
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetVideoStreamOutputVariants operation middleware
 func (siw *ServerInterfaceWrapper) GetVideoStreamOutputVariants(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetVideoStreamOutputVariants(w, r)
@@ -5562,12 +9222,11 @@ func (siw *ServerInterfaceWrapper) GetVideoStreamOutputVariants(w http.ResponseW
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 // GetYPResponse operation middleware
 func (siw *ServerInterfaceWrapper) GetYPResponse(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetYPResponse(w, r)
@@ -5577,7 +9236,7 @@ func (siw *ServerInterfaceWrapper) GetYPResponse(w http.ResponseWriter, r *http.
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
 type UnescapedCookieParamError struct {
@@ -5711,24 +9370,138 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/accesstokens/delete", wrapper.DeleteExternalAPIUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/alerts", wrapper.GetAlerts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/alerts", wrapper.GetAlertsOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/analytics/viewers", wrapper.GetViewerAnalytics)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/analytics/viewers", wrapper.GetViewerAnalyticsOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/broadcasts", wrapper.GetBroadcasts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/broadcasts", wrapper.GetBroadcastsOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/broadcasts/viewersOverTime", wrapper.GetBroadcastViewersOverTime)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/broadcasts/viewersOverTime", wrapper.GetBroadcastViewersOverTimeOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/chat/archives", wrapper.GetChatArchives)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/archives", wrapper.GetChatArchivesOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/archives/delete", wrapper.DeleteChatArchiveOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/chat/archives/delete", wrapper.DeleteChatArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/chat/archives/download", wrapper.DownloadChatArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/archives/download", wrapper.DownloadChatArchiveOptions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/admin/chat/clients", wrapper.GetConnectedChatClients)
 	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/chat/clients", wrapper.GetConnectedChatClientsOptions)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/chat/filters", wrapper.GetChatFilterRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/filters", wrapper.GetChatFilterRulesOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/filters/create", wrapper.CreateChatFilterRuleOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/chat/filters/create", wrapper.CreateChatFilterRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/filters/delete", wrapper.DeleteChatFilterRuleOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/chat/filters/delete", wrapper.DeleteChatFilterRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/filters/update", wrapper.UpdateChatFilterRuleOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/chat/filters/update", wrapper.UpdateChatFilterRule)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/admin/chat/messages", wrapper.GetChatMessagesAdmin)
 	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/chat/messages", wrapper.GetChatMessagesAdminOptions)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/chat/messages/held", wrapper.GetHeldMessagesAdmin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/messages/held", wrapper.GetHeldMessagesAdminOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/messages/review", wrapper.ReviewHeldMessageAdminOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/chat/messages/review", wrapper.ReviewHeldMessageAdmin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/chat/messages/search", wrapper.SearchChatMessagesAdmin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/messages/search", wrapper.SearchChatMessagesAdminOptions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/chat/messagevisibility", wrapper.UpdateMessageVisibilityAdminOptions)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/chat/messagevisibility", wrapper.UpdateMessageVisibilityAdmin)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/chat/moderation/actions", wrapper.GetModerationActions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/moderation/actions", wrapper.GetModerationActionsOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/modes", wrapper.UpdateChatModesAdminOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/chat/modes", wrapper.UpdateChatModesAdmin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/chat/polls", wrapper.GetPollsAdmin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/polls", wrapper.GetPollsAdminOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/polls/end", wrapper.EndPollAdminOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/chat/polls/end", wrapper.EndPollAdmin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/polls/start", wrapper.StartPollAdminOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/chat/polls/start", wrapper.StartPollAdmin)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/admin/chat/users/disabled", wrapper.GetDisabledUsers)
 	})
@@ -5771,18 +9544,42 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/chat/users/setmoderator", wrapper.UpdateUserModerator)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/users/timeout", wrapper.UpdateUserTimeoutAdminOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/chat/users/timeout", wrapper.UpdateUserTimeoutAdmin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/chat/users/timeouts", wrapper.GetUserTimeouts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/chat/users/timeouts", wrapper.GetUserTimeoutsOptions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/config/adminpass", wrapper.SetAdminPasswordOptions)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/config/adminpass", wrapper.SetAdminPassword)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/alerts/rules", wrapper.SetAlertRulesOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/alerts/rules", wrapper.SetAlertRules)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/config/appearance", wrapper.SetCustomColorVariableValuesOptions)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/config/appearance", wrapper.SetCustomColorVariableValues)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/chat/commands", wrapper.SetChatCustomCommandsOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/chat/commands", wrapper.SetChatCustomCommands)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/config/chat/disable", wrapper.SetChatDisabledOptions)
 	})
@@ -5807,6 +9604,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/config/chat/joinmessagesenabled", wrapper.SetChatJoinMessagesEnabled)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/chat/retention", wrapper.SetChatRetentionOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/chat/retention", wrapper.SetChatRetention)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/chat/reviewqueue", wrapper.SetChatReviewQueueOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/chat/reviewqueue", wrapper.SetChatReviewQueue)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/config/chat/slurfilterenabled", wrapper.SetChatSlurFilterEnabledOptions)
 	})
@@ -5825,6 +9634,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/config/chat/suggestedusernames", wrapper.SetSuggestedUsernameList)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/chat/userdirectmessages", wrapper.SetChatUserDirectMessagesEnabledOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/chat/userdirectmessages", wrapper.SetChatUserDirectMessagesEnabled)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/config/customjavascript", wrapper.SetCustomJavascriptOptions)
 	})
@@ -5927,6 +9742,60 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/config/notifications/discord", wrapper.SetDiscordNotificationConfiguration)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/notifications/email", wrapper.SetEmailNotificationConfigurationOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/notifications/email", wrapper.SetEmailNotificationConfiguration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/notifications/gotify", wrapper.SetGotifyNotificationConfigurationOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/notifications/gotify", wrapper.SetGotifyNotificationConfiguration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/notifications/matrix", wrapper.SetMatrixNotificationConfigurationOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/notifications/matrix", wrapper.SetMatrixNotificationConfiguration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/notifications/ntfy", wrapper.SetNtfyNotificationConfigurationOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/notifications/ntfy", wrapper.SetNtfyNotificationConfiguration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/notifications/reports", wrapper.SetStreamReportChannelOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/notifications/reports", wrapper.SetStreamReportChannel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/notifications/rules", wrapper.SetNotificationRulesOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/notifications/rules", wrapper.SetNotificationRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/notifications/slack", wrapper.SetSlackNotificationConfigurationOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/notifications/slack", wrapper.SetSlackNotificationConfiguration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/notifications/telegram", wrapper.SetTelegramNotificationConfigurationOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/notifications/telegram", wrapper.SetTelegramNotificationConfiguration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/notifications/test", wrapper.SendTestNotificationOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/notifications/test", wrapper.SendTestNotification)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/config/nsfw", wrapper.SetNSFWOptions)
 	})
@@ -5957,6 +9826,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/config/s3", wrapper.SetS3Configuration)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/config/schedule/reminder", wrapper.SetScheduleReminderMinutesOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/schedule/reminder", wrapper.SetScheduleReminderMinutes)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/admin/config/serversummary", wrapper.SetServerSummaryOptions)
 	})
@@ -6134,6 +10009,42 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/admin/prometheus", wrapper.PutPrometheusAPI)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/reports", wrapper.GetStreamReports)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/reports", wrapper.GetStreamReportsOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/reports/delete", wrapper.DeleteStreamReportOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/reports/delete", wrapper.DeleteStreamReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/schedule", wrapper.GetScheduledStreamsAdmin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/schedule", wrapper.GetScheduledStreamsAdminOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/schedule/create", wrapper.CreateScheduledStreamOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/schedule/create", wrapper.CreateScheduledStream)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/schedule/delete", wrapper.DeleteScheduledStreamOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/schedule/delete", wrapper.DeleteScheduledStream)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/schedule/update", wrapper.UpdateScheduledStreamOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/schedule/update", wrapper.UpdateScheduledStream)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/admin/serverconfig", wrapper.GetServerConfig)
 	})
@@ -6194,6 +10105,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/admin/webhooks/delete", wrapper.DeleteWebhook)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/webhooks/deliveries", wrapper.GetWebhookDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/webhooks/deliveries", wrapper.GetWebhookDeliveriesOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/webhooks/deliveries/replay", wrapper.ReplayWebhookDeliveryOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/webhooks/deliveries/replay", wrapper.ReplayWebhookDelivery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/webhooks/payload", wrapper.UpdateWebhookPayloadOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/webhooks/payload", wrapper.UpdateWebhookPayload)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/admin/webhooks/test", wrapper.SendWebhookTestEventOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/webhooks/test", wrapper.SendWebhookTestEvent)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/admin/yp/reset", wrapper.ResetYPRegistration)
 	})
@@ -6221,9 +10156,33 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/chat", wrapper.GetChatMessages)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/chat/messages/held", wrapper.GetHeldMessages)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/chat/messages/review", wrapper.ReviewHeldMessage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/chat/messages/search", wrapper.SearchChatMessages)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/chat/messages/search", wrapper.SearchChatMessagesOptions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/chat/messagevisibility", wrapper.UpdateMessageVisibility)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/chat/modes", wrapper.UpdateChatModes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/chat/polls/active", wrapper.GetActivePoll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/chat/polls/end", wrapper.EndPoll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/chat/polls/start", wrapper.StartPoll)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/chat/register", wrapper.RegisterAnonymousChatUserOptions)
 	})
@@ -6233,6 +10192,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/chat/users/setenabled", wrapper.UpdateUserEnabled)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/chat/users/timeout", wrapper.UpdateUserTimeout)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/config", wrapper.GetWebConfig)
 	})
@@ -6254,12 +10216,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/integrations/chat/action", wrapper.SendChatAction)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/integrations/chat/directmessage", wrapper.SendIntegrationDirectMessageOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/integrations/chat/directmessage", wrapper.SendIntegrationDirectMessage)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/integrations/chat/messagevisibility", wrapper.ExternalUpdateMessageVisibilityOptions)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/integrations/chat/messagevisibility", wrapper.ExternalUpdateMessageVisibility)
 	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/integrations/chat/polls/end", wrapper.EndIntegrationPollOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/integrations/chat/polls/end", wrapper.EndIntegrationPoll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Options(options.BaseURL+"/integrations/chat/polls/start", wrapper.StartIntegrationPollOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/integrations/chat/polls/start", wrapper.StartIntegrationPoll)
+	})
 	r.Group(func(r chi.Router) {
 		r.Options(options.BaseURL+"/integrations/chat/send", wrapper.SendIntegrationChatMessageOptions)
 	})
@@ -6308,6 +10288,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/moderation/chat/user/{userId}", wrapper.GetUserDetails)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notifications/email/confirm", wrapper.ConfirmEmailNotificationSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/notifications/email/confirm", wrapper.SubmitEmailNotificationConfirmation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/notifications/email/subscribe", wrapper.SubscribeToEmailNotifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notifications/email/unsubscribe", wrapper.UnsubscribeFromEmailNotifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/notifications/email/unsubscribe", wrapper.UnsubscribeFromEmailNotificationsOneClick)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/notifications/register", wrapper.RegisterForLiveNotifications)
 	})
@@ -6317,6 +10312,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/remotefollow", wrapper.RemoteFollow)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schedule", wrapper.GetSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/schedule.ics", wrapper.GetScheduleCalendar)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/socialplatforms", wrapper.GetAllSocialPlatforms)
 	})
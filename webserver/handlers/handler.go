@@ -47,6 +47,42 @@ func (*ServerInterfaceImpl) UpdateMessageVisibility(w http.ResponseWriter, r *ht
 	middleware.RequireUserModerationScopeAccesstoken(admin.UpdateMessageVisibility)(w, r)
 }
 
+func (*ServerInterfaceImpl) UpdateChatModes(w http.ResponseWriter, r *http.Request, params generated.UpdateChatModesParams) {
+	middleware.RequireUserModerationScopeAccesstoken(admin.UpdateChatModes)(w, r)
+}
+
+func (*ServerInterfaceImpl) UpdateUserTimeout(w http.ResponseWriter, r *http.Request, params generated.UpdateUserTimeoutParams) {
+	middleware.RequireUserModerationScopeAccesstoken(admin.UpdateUserTimeout)(w, r)
+}
+
+func (*ServerInterfaceImpl) SearchChatMessages(w http.ResponseWriter, r *http.Request, params generated.SearchChatMessagesParams) {
+	middleware.RequireUserModerationScopeAccesstoken(middleware.HandlePagination(admin.SearchChatMessages))(w, r)
+}
+
+func (*ServerInterfaceImpl) SearchChatMessagesOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireUserModerationScopeAccesstoken(middleware.HandlePagination(admin.SearchChatMessages))(w, r)
+}
+
+func (*ServerInterfaceImpl) GetHeldMessages(w http.ResponseWriter, r *http.Request, params generated.GetHeldMessagesParams) {
+	middleware.RequireUserModerationScopeAccesstoken(admin.GetHeldMessages)(w, r)
+}
+
+func (*ServerInterfaceImpl) ReviewHeldMessage(w http.ResponseWriter, r *http.Request, params generated.ReviewHeldMessageParams) {
+	middleware.RequireUserModerationScopeAccesstoken(admin.ReviewHeldMessage)(w, r)
+}
+
+func (*ServerInterfaceImpl) StartPoll(w http.ResponseWriter, r *http.Request, params generated.StartPollParams) {
+	middleware.RequireUserModerationScopeAccesstoken(admin.StartPoll)(w, r)
+}
+
+func (*ServerInterfaceImpl) EndPoll(w http.ResponseWriter, r *http.Request, params generated.EndPollParams) {
+	middleware.RequireUserModerationScopeAccesstoken(admin.EndPoll)(w, r)
+}
+
+func (*ServerInterfaceImpl) GetActivePoll(w http.ResponseWriter, r *http.Request, params generated.GetActivePollParams) {
+	middleware.RequireUserModerationScopeAccesstoken(admin.GetActivePoll)(w, r)
+}
+
 func (*ServerInterfaceImpl) UpdateUserEnabled(w http.ResponseWriter, r *http.Request, params generated.UpdateUserEnabledParams) {
 	middleware.RequireUserModerationScopeAccesstoken(admin.UpdateUserEnabled)(w, r)
 }
@@ -67,7 +103,7 @@ func (*ServerInterfaceImpl) GetVideoStreamOutputVariants(w http.ResponseWriter,
 	GetVideoStreamOutputVariants(w, r)
 }
 
-func (*ServerInterfaceImpl) Ping(w http.ResponseWriter, r *http.Request) {
+func (*ServerInterfaceImpl) Ping(w http.ResponseWriter, r *http.Request, params generated.PingParams) {
 	Ping(w, r)
 }
 
@@ -79,6 +115,14 @@ func (*ServerInterfaceImpl) GetFollowers(w http.ResponseWriter, r *http.Request,
 	middleware.HandlePagination(GetFollowers)(w, r)
 }
 
+func (*ServerInterfaceImpl) GetSchedule(w http.ResponseWriter, r *http.Request) {
+	GetSchedule(w, r)
+}
+
+func (*ServerInterfaceImpl) GetScheduleCalendar(w http.ResponseWriter, r *http.Request) {
+	GetScheduleCalendar(w, r)
+}
+
 func (*ServerInterfaceImpl) ReportPlaybackMetrics(w http.ResponseWriter, r *http.Request) {
 	ReportPlaybackMetrics(w, r)
 }
@@ -86,3 +130,23 @@ func (*ServerInterfaceImpl) ReportPlaybackMetrics(w http.ResponseWriter, r *http
 func (*ServerInterfaceImpl) RegisterForLiveNotifications(w http.ResponseWriter, r *http.Request, params generated.RegisterForLiveNotificationsParams) {
 	middleware.RequireUserAccessToken(RegisterForLiveNotifications)(w, r)
 }
+
+func (*ServerInterfaceImpl) SubscribeToEmailNotifications(w http.ResponseWriter, r *http.Request, params generated.SubscribeToEmailNotificationsParams) {
+	middleware.RequireUserAccessToken(SubscribeToEmailNotifications)(w, r)
+}
+
+func (*ServerInterfaceImpl) ConfirmEmailNotificationSubscription(w http.ResponseWriter, r *http.Request, params generated.ConfirmEmailNotificationSubscriptionParams) {
+	ConfirmEmailNotificationSubscription(w, r)
+}
+
+func (*ServerInterfaceImpl) SubmitEmailNotificationConfirmation(w http.ResponseWriter, r *http.Request, params generated.SubmitEmailNotificationConfirmationParams) {
+	SubmitEmailNotificationConfirmation(w, r)
+}
+
+func (*ServerInterfaceImpl) UnsubscribeFromEmailNotifications(w http.ResponseWriter, r *http.Request, params generated.UnsubscribeFromEmailNotificationsParams) {
+	UnsubscribeFromEmailNotifications(w, r)
+}
+
+func (*ServerInterfaceImpl) UnsubscribeFromEmailNotificationsOneClick(w http.ResponseWriter, r *http.Request, params generated.UnsubscribeFromEmailNotificationsOneClickParams) {
+	UnsubscribeFromEmailNotifications(w, r)
+}
@@ -44,6 +44,30 @@ func (*ServerInterfaceImpl) SendIntegrationChatMessageOptions(w http.ResponseWri
 	middleware.RequireExternalAPIAccessToken(models.ScopeCanSendChatMessages, admin.SendIntegrationChatMessage)(w, r)
 }
 
+func (*ServerInterfaceImpl) SendIntegrationDirectMessage(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireExternalAPIAccessToken(models.ScopeCanSendDirectMessages, admin.SendIntegrationDirectMessage)(w, r)
+}
+
+func (*ServerInterfaceImpl) SendIntegrationDirectMessageOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireExternalAPIAccessToken(models.ScopeCanSendDirectMessages, admin.SendIntegrationDirectMessage)(w, r)
+}
+
+func (*ServerInterfaceImpl) StartIntegrationPoll(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireExternalAPIAccessToken(models.ScopeCanManagePolls, admin.StartIntegrationPoll)(w, r)
+}
+
+func (*ServerInterfaceImpl) StartIntegrationPollOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireExternalAPIAccessToken(models.ScopeCanManagePolls, admin.StartIntegrationPoll)(w, r)
+}
+
+func (*ServerInterfaceImpl) EndIntegrationPoll(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireExternalAPIAccessToken(models.ScopeCanManagePolls, admin.EndIntegrationPoll)(w, r)
+}
+
+func (*ServerInterfaceImpl) EndIntegrationPollOptions(w http.ResponseWriter, r *http.Request) {
+	middleware.RequireExternalAPIAccessToken(models.ScopeCanManagePolls, admin.EndIntegrationPoll)(w, r)
+}
+
 func (*ServerInterfaceImpl) SendChatAction(w http.ResponseWriter, r *http.Request) {
 	middleware.RequireExternalAPIAccessToken(models.ScopeCanSendSystemMessages, admin.SendChatAction)(w, r)
 }
@@ -95,34 +119,34 @@ func (*ServerInterfaceImpl) ExternalGetStatus(w http.ResponseWriter, r *http.Req
 func (*ServerInterfaceImpl) GetPrometheusAPI(w http.ResponseWriter, r *http.Request) {
 	// might need to bring this out of the codegen
 	middleware.RequireAdminAuth(func(w http.ResponseWriter, r *http.Request) {
-		promhttp.Handler()
+		promhttp.Handler().ServeHTTP(w, r)
 	})(w, r)
 }
 
 func (*ServerInterfaceImpl) PostPrometheusAPI(w http.ResponseWriter, r *http.Request) {
 	// might need to bring this out of the codegen
 	middleware.RequireAdminAuth(func(w http.ResponseWriter, r *http.Request) {
-		promhttp.Handler()
+		promhttp.Handler().ServeHTTP(w, r)
 	})(w, r)
 }
 
 func (*ServerInterfaceImpl) PutPrometheusAPI(w http.ResponseWriter, r *http.Request) {
 	// might need to bring this out of the codegen
 	middleware.RequireAdminAuth(func(w http.ResponseWriter, r *http.Request) {
-		promhttp.Handler()
+		promhttp.Handler().ServeHTTP(w, r)
 	})(w, r)
 }
 
 func (*ServerInterfaceImpl) DeletePrometheusAPI(w http.ResponseWriter, r *http.Request) {
 	// might need to bring this out of the codegen
 	middleware.RequireAdminAuth(func(w http.ResponseWriter, r *http.Request) {
-		promhttp.Handler()
+		promhttp.Handler().ServeHTTP(w, r)
 	})(w, r)
 }
 
 func (*ServerInterfaceImpl) OptionsPrometheusAPI(w http.ResponseWriter, r *http.Request) {
 	// might need to bring this out of the codegen
 	middleware.RequireAdminAuth(func(w http.ResponseWriter, r *http.Request) {
-		promhttp.Handler()
+		promhttp.Handler().ServeHTTP(w, r)
 	})(w, r)
 }
@@ -2,7 +2,10 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"html"
 	"net/http"
+	"net/url"
 
 	"github.com/owncast/owncast/models"
 	"github.com/owncast/owncast/notifications"
@@ -50,3 +53,90 @@ func RegisterForLiveNotifications(u models.User, w http.ResponseWriter, r *http.
 		return
 	}
 }
+
+// SubscribeToEmailNotifications will email a confirmation link to an
+// address that wants to be emailed when the stream goes live.
+func SubscribeToEmailNotifications(u models.User, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		webutils.WriteSimpleResponse(w, false, r.Method+" not supported")
+		return
+	}
+
+	type request struct {
+		Email string `json:"email"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	var req request
+	if err := decoder.Decode(&req); err != nil {
+		webutils.WriteSimpleResponse(w, false, "unable to subscribe to email notifications")
+		return
+	}
+
+	if err := notifications.SubscribeEmail(req.Email, utils.GetIPAddressFromRequest(r)); err != nil {
+		// Only tell the requester about problems they can do something about.
+		message := "unable to subscribe to email notifications"
+		switch {
+		case errors.Is(err, notifications.ErrEmailSubscriptionsDisabled),
+			errors.Is(err, notifications.ErrInvalidEmailAddress),
+			errors.Is(err, notifications.ErrTooManySubscriptionRequests):
+			message = err.Error()
+		default:
+			log.Errorln("unable to subscribe", u.DisplayName, "to email notifications", err)
+		}
+		webutils.WriteSimpleResponse(w, false, message)
+		return
+	}
+
+	webutils.WriteSimpleResponse(w, true, "check your email to confirm your subscription")
+}
+
+// ConfirmEmailNotificationSubscription will show the page an emailed
+// confirmation link opens. Mail scanners follow links on their own, so
+// the subscription is only confirmed once the page's form is submitted.
+func ConfirmEmailNotificationSubscription(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	page := "<!DOCTYPE html><html><head><meta charset=\"utf-8\"><title>Email notifications</title></head><body>" +
+		"<form method=\"post\" action=\"?token=" + html.EscapeString(url.QueryEscape(token)) + "\">" +
+		"<p>Confirm that you want to be emailed when the stream goes live.</p>" +
+		"<button type=\"submit\">Confirm subscription</button></form></body></html>"
+
+	if err := webutils.WriteString(w, page, http.StatusOK); err != nil {
+		log.Errorln(err)
+	}
+}
+
+// SubmitEmailNotificationConfirmation will subscribe the address in an
+// emailed confirmation link.
+func SubmitEmailNotificationConfirmation(w http.ResponseWriter, r *http.Request) {
+	address, err := notifications.ConfirmEmailSubscription(r.URL.Query().Get("token"))
+	writeEmailSubscriptionPage(w, address+" will be emailed when the stream goes live.", err)
+}
+
+// UnsubscribeFromEmailNotifications will remove the address in an emailed
+// unsubscribe link. Mail clients supporting one-click unsubscribe POST to
+// the same link.
+func UnsubscribeFromEmailNotifications(w http.ResponseWriter, r *http.Request) {
+	address, err := notifications.UnsubscribeEmail(r.URL.Query().Get("token"))
+	writeEmailSubscriptionPage(w, address+" has been unsubscribed and won't be emailed again.", err)
+}
+
+// writeEmailSubscriptionPage will show the result of following an emailed
+// link, or why it failed.
+func writeEmailSubscriptionPage(w http.ResponseWriter, text string, err error) {
+	status := http.StatusOK
+	if err != nil {
+		status = http.StatusBadRequest
+		text = notifications.ErrInvalidSubscriptionToken.Error()
+		if err != notifications.ErrInvalidSubscriptionToken {
+			log.Errorln("unable to update email subscription", err)
+			status = http.StatusInternalServerError
+			text = "unable to update your subscription, please try again later"
+		}
+	}
+
+	page := "<!DOCTYPE html><html><head><meta charset=\"utf-8\"><title>Email notifications</title></head><body><p>" + html.EscapeString(text) + "</p></body></html>"
+	if err := webutils.WriteString(w, page, status); err != nil {
+		log.Errorln(err)
+	}
+}
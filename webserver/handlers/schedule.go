@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/owncast/owncast/core/schedule"
+	"github.com/owncast/owncast/persistence/configrepository"
+	"github.com/owncast/owncast/persistence/schedulerepository"
+	"github.com/owncast/owncast/webserver/router/middleware"
+	webutils "github.com/owncast/owncast/webserver/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// How far back the calendar feed includes past streams.
+const calendarHistory = 30 * 24 * time.Hour
+
+// GetSchedule will return the upcoming scheduled streams.
+func GetSchedule(w http.ResponseWriter, r *http.Request) {
+	middleware.EnableCors(w)
+
+	streams, err := schedule.GetUpcomingScheduledStreams()
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	webutils.WriteResponse(w, streams)
+}
+
+// GetScheduleCalendar will return the scheduled streams as an iCalendar
+// feed.
+func GetScheduleCalendar(w http.ResponseWriter, r *http.Request) {
+	middleware.EnableCors(w)
+
+	now := time.Now()
+	streams, err := schedulerepository.Get().GetUpcomingScheduledStreams(now.Add(-calendarHistory))
+	if err != nil {
+		webutils.InternalErrorHandler(w, err)
+		return
+	}
+
+	configRepository := configrepository.Get()
+	calendar := schedule.MakeCalendar(configRepository.GetServerName(), configRepository.GetServerURL(), streams, now)
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="schedule.ics"`)
+	if _, err := w.Write([]byte(calendar)); err != nil {
+		log.Errorln(err)
+	}
+}
@@ -32,6 +32,8 @@ func Start(enableVerboseLogging bool) error {
 		r.Use(chiMW.RequestLogger(&chiMW.DefaultLogFormatter{Logger: log.StandardLogger(), NoColor: true}))
 	}
 	r.Use(chiMW.Recoverer)
+	r.Use(middleware.RecordRequestDuration)
+	r.Use(middleware.TraceRequest)
 
 	addStaticFileEndpoints(r)
 